@@ -10,6 +10,7 @@ import (
 	"github.com/wombatlabs/coinsecd/infrastructure/config"
 	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
 	"github.com/wombatlabs/coinsecd/infrastructure/db/database/ldb"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database/memdb"
 	"github.com/wombatlabs/coinsecd/infrastructure/logger"
 	"github.com/wombatlabs/coinsecd/infrastructure/os/execenv"
 	"github.com/wombatlabs/coinsecd/infrastructure/os/limits"
@@ -18,6 +19,7 @@ import (
 	"github.com/wombatlabs/coinsecd/util/panics"
 	"github.com/wombatlabs/coinsecd/util/profiling"
 	"github.com/wombatlabs/coinsecd/version"
+	"github.com/pkg/errors"
 )
 
 const (
@@ -102,6 +104,31 @@ func (app *coinsecdApp) main(startedChan chan<- struct{}) error {
 		}
 	}
 
+	if app.cfg.RestoreDatabase != "" {
+		err := restoreDatabase(app.cfg)
+		if err != nil {
+			log.Errorf("Restoring the database backup failed: %+v", err)
+			return err
+		}
+	}
+
+	if app.cfg.DryRunMigration {
+		_, err := os.Stat(databasePath(app.cfg))
+		if os.IsNotExist(err) {
+			log.Infof("Dry run: there's no database at '%s' to migrate", databasePath(app.cfg))
+			return nil
+		}
+	}
+
+	if app.cfg.ExportUTXOSnapshotPath != "" {
+		_, err := os.Stat(databasePath(app.cfg))
+		if os.IsNotExist(err) {
+			err := errors.Errorf("there's no database at '%s' to export a UTXO snapshot of", databasePath(app.cfg))
+			log.Error(err)
+			return err
+		}
+	}
+
 	// Open the database
 	databaseContext, err := openDB(app.cfg)
 	if err != nil {
@@ -117,6 +144,19 @@ func (app *coinsecdApp) main(startedChan chan<- struct{}) error {
 		}
 	}()
 
+	if app.cfg.DryRunMigration {
+		log.Infof("Finished the dry run of the database migration")
+		return nil
+	}
+
+	if app.cfg.ExportUTXOSnapshotPath != "" {
+		err := exportUTXOSnapshot(app.cfg, databaseContext)
+		if err != nil {
+			log.Errorf("Exporting the UTXO snapshot failed: %+v", err)
+		}
+		return err
+	}
+
 	// Return now if an interrupt signal was triggered.
 	if signal.InterruptRequested(interrupt) {
 		return nil
@@ -172,9 +212,16 @@ func removeDatabase(cfg *config.Config) error {
 }
 
 func openDB(cfg *config.Config) (database.Database, error) {
+	if cfg.DBType == config.DBTypeMemory {
+		// An in-memory database always starts empty and at the current version,
+		// so it has neither a version file nor anything to migrate
+		log.Infof("Using an in-memory database. All data will be lost on shutdown")
+		return memdb.NewMemoryDB(), nil
+	}
+
 	dbPath := databasePath(cfg)
 
-	err := checkDatabaseVersion(dbPath)
+	recordedVersion, err := checkDatabaseVersion(dbPath)
 	if err != nil {
 		return nil, err
 	}
@@ -185,5 +232,14 @@ func openDB(cfg *config.Config) (database.Database, error) {
 		return nil, err
 	}
 
+	err = migrateDatabase(cfg, db, dbPath, recordedVersion)
+	if err != nil {
+		closeErr := db.Close()
+		if closeErr != nil {
+			log.Errorf("Failed to close the database: %s", closeErr)
+		}
+		return nil, err
+	}
+
 	return db, nil
 }
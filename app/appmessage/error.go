@@ -33,9 +33,35 @@ func messageError(f string, desc string) *MessageError {
 	return &MessageError{Func: f, Description: desc}
 }
 
+// RPCErrorCode identifies the kind of an RPCError, so that clients can handle
+// it without parsing its message
+type RPCErrorCode byte
+
+// RPCErrorCode constants
+// Not using iota, since in the .proto file those are hardcoded
+const (
+	RPCErrorCodeUnspecified RPCErrorCode = 0
+	RPCErrorCodeRateLimited RPCErrorCode = 1
+	RPCErrorCodeNotAllowed  RPCErrorCode = 2
+)
+
+var rpcErrorCodeToString = map[RPCErrorCode]string{
+	RPCErrorCodeUnspecified: "Unspecified",
+	RPCErrorCodeRateLimited: "Rate limited",
+	RPCErrorCodeNotAllowed:  "Not allowed",
+}
+
+func (code RPCErrorCode) String() string {
+	return rpcErrorCodeToString[code]
+}
+
 // RPCError represents an error arriving from the RPC
 type RPCError struct {
 	Message string
+	Code    RPCErrorCode
+
+	// RetryAfterMilliseconds is set for RPCErrorCodeRateLimited errors
+	RetryAfterMilliseconds uint64
 }
 
 func (err RPCError) Error() string {
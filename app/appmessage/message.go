@@ -163,6 +163,25 @@ const (
 	CmdGetMempoolEntriesByAddressesResponseMessage
 	CmdGetCoinSupplyRequestMessage
 	CmdGetCoinSupplyResponseMessage
+	CmdGetTransactionRequestMessage
+	CmdGetTransactionResponseMessage
+	CmdGetAddressHistoryRequestMessage
+	CmdGetAddressHistoryResponseMessage
+	CmdSubmitTransactionReplacementRequestMessage
+	CmdSubmitTransactionReplacementResponseMessage
+	CmdGetFeeEstimateRequestMessage
+	CmdGetFeeEstimateResponseMessage
+	CmdSubmitTransactionPackageRequestMessage
+	CmdSubmitTransactionPackageResponseMessage
+	CmdGetMempoolInfoRequestMessage
+	CmdGetMempoolInfoResponseMessage
+	CmdNotifyMempoolChangedRequestMessage
+	CmdNotifyMempoolChangedResponseMessage
+	CmdMempoolChangedNotificationMessage
+	CmdGetRPCUsageRequestMessage
+	CmdGetRPCUsageResponseMessage
+	CmdBackupDatabaseRequestMessage
+	CmdBackupDatabaseResponseMessage
 )
 
 // ProtocolMessageCommandToString maps all MessageCommands to their string representation
@@ -259,6 +278,8 @@ var RPCMessageCommandToString = map[MessageCommand]string{
 	CmdFinalityConflictResolvedNotificationMessage:                "FinalityConflictResolvedNotification",
 	CmdGetMempoolEntriesRequestMessage:                            "GetMempoolEntriesRequest",
 	CmdGetMempoolEntriesResponseMessage:                           "GetMempoolEntriesResponse",
+	CmdShutDownRequestMessage:                                     "ShutDownRequest",
+	CmdShutDownResponseMessage:                                    "ShutDownResponse",
 	CmdGetHeadersRequestMessage:                                   "GetHeadersRequest",
 	CmdGetHeadersResponseMessage:                                  "GetHeadersResponse",
 	CmdNotifyUTXOsChangedRequestMessage:                           "NotifyUTXOsChangedRequest",
@@ -300,6 +321,25 @@ var RPCMessageCommandToString = map[MessageCommand]string{
 	CmdGetMempoolEntriesByAddressesResponseMessage:                "GetMempoolEntriesByAddressesResponse",
 	CmdGetCoinSupplyRequestMessage:                                "GetCoinSupplyRequest",
 	CmdGetCoinSupplyResponseMessage:                               "GetCoinSupplyResponse",
+	CmdGetTransactionRequestMessage:                               "GetTransactionRequest",
+	CmdGetTransactionResponseMessage:                              "GetTransactionResponse",
+	CmdGetAddressHistoryRequestMessage:                            "GetAddressHistoryRequest",
+	CmdGetAddressHistoryResponseMessage:                           "GetAddressHistoryResponse",
+	CmdSubmitTransactionReplacementRequestMessage:                 "SubmitTransactionReplacementRequest",
+	CmdSubmitTransactionReplacementResponseMessage:                "SubmitTransactionReplacementResponse",
+	CmdGetFeeEstimateRequestMessage:                               "GetFeeEstimateRequest",
+	CmdGetFeeEstimateResponseMessage:                              "GetFeeEstimateResponse",
+	CmdSubmitTransactionPackageRequestMessage:                     "SubmitTransactionPackageRequest",
+	CmdSubmitTransactionPackageResponseMessage:                    "SubmitTransactionPackageResponse",
+	CmdGetMempoolInfoRequestMessage:                               "GetMempoolInfoRequest",
+	CmdGetMempoolInfoResponseMessage:                              "GetMempoolInfoResponse",
+	CmdNotifyMempoolChangedRequestMessage:                         "NotifyMempoolChangedRequest",
+	CmdNotifyMempoolChangedResponseMessage:                        "NotifyMempoolChangedResponse",
+	CmdMempoolChangedNotificationMessage:                          "MempoolChangedNotification",
+	CmdGetRPCUsageRequestMessage:                                  "GetRpcUsageRequest",
+	CmdGetRPCUsageResponseMessage:                                 "GetRpcUsageResponse",
+	CmdBackupDatabaseRequestMessage:                               "BackupDatabaseRequest",
+	CmdBackupDatabaseResponseMessage:                              "BackupDatabaseResponse",
 }
 
 // Message is an interface that describes a coinsec message. A type that
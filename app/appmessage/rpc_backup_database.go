@@ -0,0 +1,47 @@
+package appmessage
+
+// BackupDatabaseRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type BackupDatabaseRequestMessage struct {
+	baseMessage
+	TargetDirectory string
+}
+
+// Command returns the protocol command string for the message
+func (msg *BackupDatabaseRequestMessage) Command() MessageCommand {
+	return CmdBackupDatabaseRequestMessage
+}
+
+// NewBackupDatabaseRequestMessage returns a instance of the message
+func NewBackupDatabaseRequestMessage(targetDirectory string) *BackupDatabaseRequestMessage {
+	return &BackupDatabaseRequestMessage{
+		TargetDirectory: targetDirectory,
+	}
+}
+
+// BackupDatabaseResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type BackupDatabaseResponseMessage struct {
+	baseMessage
+	DatabaseVersion uint32
+	ActivePrefix    string
+	EntryCount      uint64
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *BackupDatabaseResponseMessage) Command() MessageCommand {
+	return CmdBackupDatabaseResponseMessage
+}
+
+// NewBackupDatabaseResponseMessage returns a instance of the message
+func NewBackupDatabaseResponseMessage(databaseVersion uint32, activePrefix string,
+	entryCount uint64) *BackupDatabaseResponseMessage {
+
+	return &BackupDatabaseResponseMessage{
+		DatabaseVersion: databaseVersion,
+		ActivePrefix:    activePrefix,
+		EntryCount:      entryCount,
+	}
+}
@@ -0,0 +1,57 @@
+package appmessage
+
+// GetAddressHistoryRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAddressHistoryRequestMessage struct {
+	baseMessage
+	Address string
+	Cursor  string
+	Limit   uint32
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAddressHistoryRequestMessage) Command() MessageCommand {
+	return CmdGetAddressHistoryRequestMessage
+}
+
+// NewGetAddressHistoryRequestMessage returns a instance of the message
+func NewGetAddressHistoryRequestMessage(address string, cursor string, limit uint32) *GetAddressHistoryRequestMessage {
+	return &GetAddressHistoryRequestMessage{
+		Address: address,
+		Cursor:  cursor,
+		Limit:   limit,
+	}
+}
+
+// GetAddressHistoryResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetAddressHistoryResponseMessage struct {
+	baseMessage
+	Entries    []*AddressHistoryEntry
+	NextCursor string
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetAddressHistoryResponseMessage) Command() MessageCommand {
+	return CmdGetAddressHistoryResponseMessage
+}
+
+// NewGetAddressHistoryResponseMessage returns a instance of the message
+func NewGetAddressHistoryResponseMessage(entries []*AddressHistoryEntry, nextCursor string) *GetAddressHistoryResponseMessage {
+	return &GetAddressHistoryResponseMessage{
+		Entries:    entries,
+		NextCursor: nextCursor,
+	}
+}
+
+// AddressHistoryEntry represents an accepted transaction
+// that paid to or spent from an address
+type AddressHistoryEntry struct {
+	TransactionID          string
+	AcceptingBlockHash     string
+	AcceptingBlockDAAScore uint64
+	IsPayingToAddress      bool
+	IsSpendingFromAddress  bool
+}
@@ -0,0 +1,57 @@
+package appmessage
+
+// GetMempoolInfoRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetMempoolInfoRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetMempoolInfoRequestMessage) Command() MessageCommand {
+	return CmdGetMempoolInfoRequestMessage
+}
+
+// NewGetMempoolInfoRequestMessage returns a instance of the message
+func NewGetMempoolInfoRequestMessage() *GetMempoolInfoRequestMessage {
+	return &GetMempoolInfoRequestMessage{}
+}
+
+// RPCFeeRateHistogramBucket holds the number and total mass of the mempool transactions
+// whose fee rate, in sompi/gram, is at least MinimumFeeRate and lower than the
+// MinimumFeeRate of the next bucket
+type RPCFeeRateHistogramBucket struct {
+	MinimumFeeRate   float64
+	TransactionCount uint64
+	TotalMass        uint64
+}
+
+// GetMempoolInfoResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetMempoolInfoResponseMessage struct {
+	baseMessage
+	TransactionCount uint64
+	OrphanCount      uint64
+	TotalMass        uint64
+	MinimumFeeRate   float64
+	FeeRateHistogram []*RPCFeeRateHistogramBucket
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetMempoolInfoResponseMessage) Command() MessageCommand {
+	return CmdGetMempoolInfoResponseMessage
+}
+
+// NewGetMempoolInfoResponseMessage returns a instance of the message
+func NewGetMempoolInfoResponseMessage(transactionCount uint64, orphanCount uint64, totalMass uint64,
+	minimumFeeRate float64, feeRateHistogram []*RPCFeeRateHistogramBucket) *GetMempoolInfoResponseMessage {
+
+	return &GetMempoolInfoResponseMessage{
+		TransactionCount: transactionCount,
+		OrphanCount:      orphanCount,
+		TotalMass:        totalMass,
+		MinimumFeeRate:   minimumFeeRate,
+		FeeRateHistogram: feeRateHistogram,
+	}
+}
@@ -0,0 +1,56 @@
+package appmessage
+
+// GetRPCUsageRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetRPCUsageRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetRPCUsageRequestMessage) Command() MessageCommand {
+	return CmdGetRPCUsageRequestMessage
+}
+
+// NewGetRPCUsageRequestMessage returns a instance of the message
+func NewGetRPCUsageRequestMessage() *GetRPCUsageRequestMessage {
+	return &GetRPCUsageRequestMessage{}
+}
+
+// RPCClientUsage holds how much of the RPC rate limit a single client uses
+type RPCClientUsage struct {
+	Client               string
+	AvailableWeight      float64
+	RequestCount         uint64
+	RejectedRequestCount uint64
+	ConsumedWeight       float64
+	LastRequestTimestamp int64
+}
+
+// GetRPCUsageResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetRPCUsageResponseMessage struct {
+	baseMessage
+	IsRateLimitEnabled bool
+	RatePerSecond      float64
+	Burst              float64
+	Clients            []*RPCClientUsage
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetRPCUsageResponseMessage) Command() MessageCommand {
+	return CmdGetRPCUsageResponseMessage
+}
+
+// NewGetRPCUsageResponseMessage returns a instance of the message
+func NewGetRPCUsageResponseMessage(isRateLimitEnabled bool, ratePerSecond float64, burst float64,
+	clients []*RPCClientUsage) *GetRPCUsageResponseMessage {
+
+	return &GetRPCUsageResponseMessage{
+		IsRateLimitEnabled: isRateLimitEnabled,
+		RatePerSecond:      ratePerSecond,
+		Burst:              burst,
+		Clients:            clients,
+	}
+}
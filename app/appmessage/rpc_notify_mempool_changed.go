@@ -0,0 +1,92 @@
+package appmessage
+
+// NotifyMempoolChangedRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type NotifyMempoolChangedRequestMessage struct {
+	baseMessage
+	Addresses []string
+}
+
+// Command returns the protocol command string for the message
+func (msg *NotifyMempoolChangedRequestMessage) Command() MessageCommand {
+	return CmdNotifyMempoolChangedRequestMessage
+}
+
+// NewNotifyMempoolChangedRequestMessage returns a instance of the message
+func NewNotifyMempoolChangedRequestMessage(addresses []string) *NotifyMempoolChangedRequestMessage {
+	return &NotifyMempoolChangedRequestMessage{
+		Addresses: addresses,
+	}
+}
+
+// NotifyMempoolChangedResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type NotifyMempoolChangedResponseMessage struct {
+	baseMessage
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *NotifyMempoolChangedResponseMessage) Command() MessageCommand {
+	return CmdNotifyMempoolChangedResponseMessage
+}
+
+// NewNotifyMempoolChangedResponseMessage returns a instance of the message
+func NewNotifyMempoolChangedResponseMessage() *NotifyMempoolChangedResponseMessage {
+	return &NotifyMempoolChangedResponseMessage{}
+}
+
+// MempoolChangeReason describes why a transaction was added to or removed from the mempool
+type MempoolChangeReason byte
+
+// MempoolChangeReason constants
+// Not using iota, since in the .proto file those are hardcoded
+const (
+	MempoolChangeReasonAdded       MempoolChangeReason = 0
+	MempoolChangeReasonAccepted    MempoolChangeReason = 1
+	MempoolChangeReasonDoubleSpent MempoolChangeReason = 2
+	MempoolChangeReasonReplaced    MempoolChangeReason = 3
+	MempoolChangeReasonExpired     MempoolChangeReason = 4
+	MempoolChangeReasonEvicted     MempoolChangeReason = 5
+	MempoolChangeReasonInvalidated MempoolChangeReason = 6
+)
+
+var mempoolChangeReasonToString = map[MempoolChangeReason]string{
+	MempoolChangeReasonAdded:       "Added",
+	MempoolChangeReasonAccepted:    "Accepted",
+	MempoolChangeReasonDoubleSpent: "Double spent",
+	MempoolChangeReasonReplaced:    "Replaced",
+	MempoolChangeReasonExpired:     "Expired",
+	MempoolChangeReasonEvicted:     "Evicted",
+	MempoolChangeReasonInvalidated: "Invalidated",
+}
+
+func (reason MempoolChangeReason) String() string {
+	return mempoolChangeReasonToString[reason]
+}
+
+// MempoolChange represents a transaction that was added to or removed from the mempool
+type MempoolChange struct {
+	TransactionID string
+	Transaction   *RPCTransaction
+	Reason        MempoolChangeReason
+}
+
+// MempoolChangedNotificationMessage is an appmessage corresponding to
+// its respective RPC message
+type MempoolChangedNotificationMessage struct {
+	baseMessage
+	Changes []*MempoolChange
+}
+
+// Command returns the protocol command string for the message
+func (msg *MempoolChangedNotificationMessage) Command() MessageCommand {
+	return CmdMempoolChangedNotificationMessage
+}
+
+// NewMempoolChangedNotificationMessage returns a instance of the message
+func NewMempoolChangedNotificationMessage(changes []*MempoolChange) *MempoolChangedNotificationMessage {
+	return &MempoolChangedNotificationMessage{
+		Changes: changes,
+	}
+}
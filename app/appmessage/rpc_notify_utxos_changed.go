@@ -5,6 +5,10 @@ package appmessage
 type NotifyUTXOsChangedRequestMessage struct {
 	baseMessage
 	Addresses []string
+
+	// Resume requests the notifications sent after ResumeAfterSequence
+	Resume              bool
+	ResumeAfterSequence uint64
 }
 
 // Command returns the protocol command string for the message
@@ -23,7 +27,10 @@ func NewNotifyUTXOsChangedRequestMessage(addresses []string) *NotifyUTXOsChanged
 // its respective RPC message
 type NotifyUTXOsChangedResponseMessage struct {
 	baseMessage
-	Error *RPCError
+	Sequence            uint64
+	SequenceGap         bool
+	MissedNotifications []*UTXOsChangedNotificationMessage
+	Error               *RPCError
 }
 
 // Command returns the protocol command string for the message
@@ -40,8 +47,9 @@ func NewNotifyUTXOsChangedResponseMessage() *NotifyUTXOsChangedResponseMessage {
 // its respective RPC message
 type UTXOsChangedNotificationMessage struct {
 	baseMessage
-	Added   []*UTXOsByAddressesEntry
-	Removed []*UTXOsByAddressesEntry
+	Added    []*UTXOsByAddressesEntry
+	Removed  []*UTXOsByAddressesEntry
+	Sequence uint64
 }
 
 // UTXOsByAddressesEntry represents a UTXO of some address
@@ -5,6 +5,10 @@ package appmessage
 type NotifyVirtualSelectedParentChainChangedRequestMessage struct {
 	baseMessage
 	IncludeAcceptedTransactionIDs bool
+
+	// Resume requests the notifications sent after ResumeAfterSequence
+	Resume              bool
+	ResumeAfterSequence uint64
 }
 
 // Command returns the protocol command string for the message
@@ -25,7 +29,10 @@ func NewNotifyVirtualSelectedParentChainChangedRequestMessage(
 // its respective RPC message
 type NotifyVirtualSelectedParentChainChangedResponseMessage struct {
 	baseMessage
-	Error *RPCError
+	Sequence            uint64
+	SequenceGap         bool
+	MissedNotifications []*VirtualSelectedParentChainChangedNotificationMessage
+	Error               *RPCError
 }
 
 // Command returns the protocol command string for the message
@@ -45,6 +52,7 @@ type VirtualSelectedParentChainChangedNotificationMessage struct {
 	RemovedChainBlockHashes []string
 	AddedChainBlockHashes   []string
 	AcceptedTransactionIDs  []*AcceptedTransactionIDs
+	Sequence                uint64
 }
 
 // Command returns the protocol command string for the message
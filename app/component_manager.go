@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"path/filepath"
 	"sync/atomic"
 
 	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
@@ -11,10 +12,14 @@ import (
 	"github.com/wombatlabs/coinsecd/app/protocol"
 	"github.com/wombatlabs/coinsecd/app/rpc"
 	"github.com/wombatlabs/coinsecd/domain"
+	"github.com/wombatlabs/coinsecd/domain/addresshistoryindex"
 	"github.com/wombatlabs/coinsecd/domain/consensus"
+	"github.com/wombatlabs/coinsecd/domain/txindex"
 	"github.com/wombatlabs/coinsecd/domain/utxoindex"
 	"github.com/wombatlabs/coinsecd/infrastructure/config"
 	infrastructuredatabase "github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/wombatlabs/coinsecd/infrastructure/health"
+	"github.com/wombatlabs/coinsecd/infrastructure/metrics"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/addressmanager"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/connmanager"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter"
@@ -22,6 +27,10 @@ import (
 	"github.com/wombatlabs/coinsecd/util/panics"
 )
 
+// mempoolFileName is the name of the file in the app directory the mempool is
+// saved to when --persistmempool is set
+const mempoolFileName = "mempool.dat"
+
 // ComponentManager is a wrapper for all the coinsecd services
 type ComponentManager struct {
 	cfg               *config.Config
@@ -30,6 +39,8 @@ type ComponentManager struct {
 	rpcManager        *rpc.Manager
 	connectionManager *connmanager.ConnectionManager
 	netAdapter        *netadapter.NetAdapter
+	metricsServer     *metrics.Server
+	healthServer      *health.Server
 
 	started, shutdown int32
 }
@@ -43,11 +54,34 @@ func (a *ComponentManager) Start() {
 
 	log.Trace("Starting coinsecd")
 
+	if a.cfg.PersistMempool {
+		a.loadMempool()
+	}
+
 	err := a.netAdapter.Start()
 	if err != nil {
 		panics.Exit(log, fmt.Sprintf("Error starting the net adapter: %+v", err))
 	}
 
+	err = a.rpcManager.Start()
+	if err != nil {
+		panics.Exit(log, fmt.Sprintf("Error starting the RPC manager: %+v", err))
+	}
+
+	if a.metricsServer != nil {
+		err = a.metricsServer.Start()
+		if err != nil {
+			panics.Exit(log, fmt.Sprintf("Error starting the metrics server: %+v", err))
+		}
+	}
+
+	if a.healthServer != nil {
+		err = a.healthServer.Start()
+		if err != nil {
+			panics.Exit(log, fmt.Sprintf("Error starting the health server: %+v", err))
+		}
+	}
+
 	a.connectionManager.Start()
 }
 
@@ -63,36 +97,93 @@ func (a *ComponentManager) Stop() {
 
 	a.connectionManager.Stop()
 
-	err := a.netAdapter.Stop()
+	if a.healthServer != nil {
+		err := a.healthServer.Stop()
+		if err != nil {
+			log.Errorf("Error stopping the health server: %+v", err)
+		}
+	}
+
+	if a.metricsServer != nil {
+		err := a.metricsServer.Stop()
+		if err != nil {
+			log.Errorf("Error stopping the metrics server: %+v", err)
+		}
+	}
+
+	err := a.rpcManager.Stop()
+	if err != nil {
+		log.Errorf("Error stopping the RPC manager: %+v", err)
+	}
+
+	err = a.netAdapter.Stop()
 	if err != nil {
 		log.Errorf("Error stopping the net adapter: %+v", err)
 	}
 
+	if a.cfg.PersistMempool {
+		a.saveMempool()
+	}
+
 	a.protocolManager.Close()
 	close(a.protocolManager.Context().Domain().ConsensusEventsChannel())
 
 	return
 }
 
+func (a *ComponentManager) mempoolFilePath() string {
+	return filepath.Join(a.cfg.AppDir, mempoolFileName)
+}
+
+func (a *ComponentManager) loadMempool() {
+	path := a.mempoolFilePath()
+	loadedCount, discardedCount, err := a.protocolManager.Context().Domain().MiningManager().LoadMempool(path)
+	if err != nil {
+		log.Warnf("Error loading the mempool from %s: %+v", path, err)
+		return
+	}
+	log.Infof("Loaded %d transactions to the mempool from %s (%d discarded)", loadedCount, path, discardedCount)
+}
+
+func (a *ComponentManager) saveMempool() {
+	path := a.mempoolFilePath()
+	savedCount, err := a.protocolManager.Context().Domain().MiningManager().SaveMempool(path)
+	if err != nil {
+		log.Errorf("Error saving the mempool to %s: %+v", path, err)
+		return
+	}
+	log.Infof("Saved %d mempool transactions to %s", savedCount, path)
+}
+
 // NewComponentManager returns a new ComponentManager instance.
 // Use Start() to begin all services within this ComponentManager
 func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database, interrupt chan<- struct{}) (
 	*ComponentManager, error) {
 
-	consensusConfig := consensus.Config{
-		Params:                          *cfg.ActiveNetParams,
-		IsArchival:                      cfg.IsArchivalNode,
-		EnableSanityCheckPruningUTXOSet: cfg.EnableSanityCheckPruningUTXOSet,
-	}
+	consensusConfig := newConsensusConfig(cfg)
 	mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
 	mempoolConfig.MaximumOrphanTransactionCount = cfg.MaxOrphanTxs
 	mempoolConfig.MinimumRelayTransactionFee = cfg.MinRelayTxFee
+	mempoolConfig.MaximumMempoolMass = cfg.MaxMempoolMass
+	mempoolConfig.MaximumAncestorCount = cfg.MaxMempoolAncestors
+	mempoolConfig.MaximumAncestorMass = cfg.MaxMempoolAncestorMass
+	mempoolConfig.MaximumDescendantCount = cfg.MaxMempoolDescendants
+	mempoolConfig.MaximumDescendantMass = cfg.MaxMempoolDescendantMass
 
-	domain, err := domain.New(&consensusConfig, mempoolConfig, db)
+	domain, err := domain.New(consensusConfig, mempoolConfig, db)
 	if err != nil {
 		return nil, err
 	}
 
+	// The snapshot is imported before the indexes are created, so that they
+	// are reset to the imported state
+	if cfg.ImportSnapshot != "" {
+		err = importUTXOSnapshot(cfg, domain)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	netAdapter, err := netadapter.NewNetAdapter(cfg)
 	if err != nil {
 		return nil, err
@@ -113,6 +204,26 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 		log.Infof("UTXO index started")
 	}
 
+	var txIndex *txindex.TXIndex
+	if cfg.TXIndex {
+		txIndex, err = txindex.New(domain, db)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("TX index started")
+	}
+
+	var addressHistoryIndex *addresshistoryindex.AddressHistoryIndex
+	if cfg.AddressHistoryIndex {
+		addressHistoryIndex, err = addresshistoryindex.New(domain, db)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Address history index started")
+	}
+
 	connectionManager, err := connmanager.New(cfg, netAdapter, addressManager)
 	if err != nil {
 		return nil, err
@@ -121,7 +232,22 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 	if err != nil {
 		return nil, err
 	}
-	rpcManager := setupRPC(cfg, domain, netAdapter, protocolManager, connectionManager, addressManager, utxoIndex, domain.ConsensusEventsChannel(), interrupt)
+	rpcManager := setupRPC(cfg, domain, netAdapter, protocolManager, connectionManager, addressManager, utxoIndex, txIndex,
+		addressHistoryIndex, db, domain.ConsensusEventsChannel(), interrupt)
+
+	var metricsServer *metrics.Server
+	if len(cfg.MetricsListeners) > 0 {
+		// The node's collector is registered in a registry of its own, since
+		// several nodes may run in the same process in tests
+		registry := metrics.NewRegistry()
+		registry.Register(metrics.DefaultRegistry, newNodeMetricsCollector(protocolManager, netAdapter, db))
+		metricsServer = metrics.NewServer(cfg.MetricsListeners, registry)
+	}
+
+	var healthServer *health.Server
+	if len(cfg.HealthListeners) > 0 {
+		healthServer = newHealthServer(cfg, db, protocolManager, utxoIndex)
+	}
 
 	return &ComponentManager{
 		cfg:               cfg,
@@ -130,10 +256,20 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 		connectionManager: connectionManager,
 		netAdapter:        netAdapter,
 		addressManager:    addressManager,
+		metricsServer:     metricsServer,
+		healthServer:      healthServer,
 	}, nil
 
 }
 
+func newConsensusConfig(cfg *config.Config) *consensus.Config {
+	return &consensus.Config{
+		Params:                          *cfg.ActiveNetParams,
+		IsArchival:                      cfg.IsArchivalNode,
+		EnableSanityCheckPruningUTXOSet: cfg.EnableSanityCheckPruningUTXOSet,
+	}
+}
+
 func setupRPC(
 	cfg *config.Config,
 	domain domain.Domain,
@@ -142,6 +278,9 @@ func setupRPC(
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	txIndex *txindex.TXIndex,
+	addressHistoryIndex *addresshistoryindex.AddressHistoryIndex,
+	db infrastructuredatabase.Database,
 	consensusEventsChan chan externalapi.ConsensusEvent,
 	shutDownChan chan<- struct{},
 ) *rpc.Manager {
@@ -154,11 +293,16 @@ func setupRPC(
 		connectionManager,
 		addressManager,
 		utxoIndex,
+		txIndex,
+		addressHistoryIndex,
+		db,
+		currentDatabaseVersion,
 		consensusEventsChan,
 		shutDownChan,
 	)
 	protocolManager.SetOnNewBlockTemplateHandler(rpcManager.NotifyNewBlockTemplate)
 	protocolManager.SetOnPruningPointUTXOSetOverrideHandler(rpcManager.NotifyPruningPointUTXOSetOverride)
+	domain.MiningManager().SetOnMempoolChangedHandler(rpcManager.NotifyMempoolChanged)
 
 	return rpcManager
 }
@@ -0,0 +1,21 @@
+package app
+
+import (
+	"time"
+
+	"github.com/wombatlabs/coinsecd/app/dbbackup"
+	"github.com/wombatlabs/coinsecd/infrastructure/config"
+)
+
+// restoreDatabase restores the database from the backup given with --restore-db. The
+// backup is validated before the database is created, and an existing database is
+// never overwritten
+func restoreDatabase(cfg *config.Config) error {
+	manifest, err := dbbackup.Restore(cfg.RestoreDatabase, databasePath(cfg), currentDatabaseVersion)
+	if err != nil {
+		return err
+	}
+	log.Infof("Restored the database backup taken at %s, with database version %d and active prefix %s",
+		time.UnixMilli(manifest.Timestamp).UTC().Format(time.RFC3339), manifest.DatabaseVersion, manifest.ActivePrefix)
+	return nil
+}
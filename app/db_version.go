@@ -4,51 +4,69 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"strings"
 
-	"github.com/pkg/errors"
+	"github.com/wombatlabs/coinsecd/infrastructure/config"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/migration"
 )
 
 const currentDatabaseVersion = 1
 
-func checkDatabaseVersion(dbPath string) (err error) {
+// databaseMigrations holds the upgrades between database versions. Whenever
+// currentDatabaseVersion is bumped, the upgrade from the previous version must
+// be registered in it
+var databaseMigrations = migration.NewRegistry()
+
+// checkDatabaseVersion returns the version recorded in the version file of the database
+// at dbPath. If the file doesn't exist the database is assumed to be new, and the file
+// is created with the current version
+func checkDatabaseVersion(dbPath string) (int, error) {
 	versionFileName := versionFilePath(dbPath)
 
 	versionBytes, err := os.ReadFile(versionFileName)
 	if err != nil {
-		if os.IsNotExist(err) { // If version file doesn't exist, we assume that the database is new
-			return createDatabaseVersionFile(dbPath, versionFileName)
+		if os.IsNotExist(err) {
+			err := os.MkdirAll(dbPath, 0700)
+			if err != nil {
+				return 0, err
+			}
+			return currentDatabaseVersion, writeDatabaseVersionFile(versionFileName, currentDatabaseVersion)
 		}
-		return err
+		return 0, err
 	}
 
-	databaseVersion, err := strconv.Atoi(string(versionBytes))
+	return strconv.Atoi(strings.TrimSpace(string(versionBytes)))
+}
+
+// migrateDatabase upgrades db from recordedVersion to currentDatabaseVersion, and then
+// records the new version in the version file. With --dry-run-migration nothing is written
+func migrateDatabase(cfg *config.Config, db database.Database, dbPath string, recordedVersion int) error {
+	version, err := databaseMigrations.Migrate(db, recordedVersion, currentDatabaseVersion, cfg.DryRunMigration)
 	if err != nil {
 		return err
 	}
-
-	if databaseVersion != currentDatabaseVersion {
-		// TODO: Once there's more then one database version, it might make sense to add upgrade logic at this point
-		return errors.Errorf("Invalid database version %d. Expected version: %d", databaseVersion, currentDatabaseVersion)
+	if cfg.DryRunMigration {
+		if version == currentDatabaseVersion {
+			log.Infof("Dry run: the database is already in version %d, so there's nothing to migrate", version)
+		}
+		return nil
 	}
-
-	return nil
+	if version == recordedVersion {
+		return nil
+	}
+	return writeDatabaseVersionFile(versionFilePath(dbPath), version)
 }
 
-func createDatabaseVersionFile(dbPath string, versionFileName string) error {
-	err := os.MkdirAll(dbPath, 0700)
+// writeDatabaseVersionFile writes the version file through a temporary file, so that
+// a crash never leaves it half written
+func writeDatabaseVersionFile(versionFileName string, version int) error {
+	temporaryFileName := versionFileName + ".tmp"
+	err := os.WriteFile(temporaryFileName, []byte(strconv.Itoa(version)), 0600)
 	if err != nil {
 		return err
 	}
-
-	versionFile, err := os.Create(versionFileName)
-	if err != nil {
-		return nil
-	}
-	defer versionFile.Close()
-
-	versionString := strconv.Itoa(currentDatabaseVersion)
-	_, err = versionFile.Write([]byte(versionString))
-	return err
+	return os.Rename(temporaryFileName, versionFileName)
 }
 
 func versionFilePath(dbPath string) string {
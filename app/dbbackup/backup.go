@@ -0,0 +1,199 @@
+package dbbackup
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/domain/prefixmanager"
+	"github.com/wombatlabs/coinsecd/domain/prefixmanager/prefix"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database/ldb"
+	"github.com/pkg/errors"
+)
+
+const (
+	// The cache of the databases that are written during a backup or a restore is
+	// kept small, since every entry is written to them only once
+	copyCacheSizeMiB = 16
+
+	maxBatchEntryCount = 10_000
+	maxBatchSize       = 16 * 1024 * 1024
+)
+
+// Backup writes a consistent copy of the active consensus and of the indexes of db
+// into targetDirectory, along with a version file holding databaseVersion. The
+// copy is taken from a snapshot of db, so the node may keep running while it's
+// written. targetDirectory must not exist, or be empty
+func Backup(db database.Database, databaseVersion int, targetDirectory string) (*Manifest, error) {
+	levelDB, ok := db.(*ldb.LevelDB)
+	if !ok {
+		return nil, errors.New("only LevelDB databases can be backed up")
+	}
+
+	wasCreated, err := createEmptyDirectory(targetDirectory)
+	if err != nil {
+		return nil, err
+	}
+	isComplete := false
+	defer func() {
+		if isComplete {
+			return
+		}
+		// A directory that existed, such as a mount point, is only emptied
+		var removeErr error
+		if wasCreated {
+			removeErr = os.RemoveAll(targetDirectory)
+		} else {
+			removeErr = removeDirectoryContents(targetDirectory)
+		}
+		if removeErr != nil {
+			log.Warnf("Error removing the incomplete backup in %s: %s", targetDirectory, removeErr)
+		}
+	}()
+
+	snapshot, err := levelDB.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer snapshot.Release()
+
+	activePrefix, err := snapshotActivePrefix(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Backing up the database into %s", targetDirectory)
+	targetDB, err := ldb.NewLevelDB(filepath.Join(targetDirectory, databaseDirectoryName), copyCacheSizeMiB)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{
+		FormatVersion:   formatVersion,
+		DatabaseVersion: databaseVersion,
+		ActivePrefix:    hex.EncodeToString(activePrefix.Serialize()),
+		Timestamp:       time.Now().UnixMilli(),
+	}
+	for _, keyPrefix := range backupKeyPrefixes(activePrefix) {
+		checksum, err := copyPrefix(snapshot, targetDB, keyPrefix)
+		if err != nil {
+			targetDB.Close()
+			return nil, err
+		}
+		manifest.Prefixes = append(manifest.Prefixes, checksum.prefixManifest(keyPrefix))
+	}
+	err = targetDB.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeVersionFile(targetDirectory, databaseVersion)
+	if err != nil {
+		return nil, err
+	}
+	err = writeManifest(targetDirectory, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	isComplete = true
+	log.Infof("Finished backing up %d database entries into %s", manifest.EntryCount(), targetDirectory)
+	return manifest, nil
+}
+
+// createEmptyDirectory creates directory if it doesn't exist, and makes sure it's
+// empty if it does. It returns whether it created the directory
+func createEmptyDirectory(directory string) (wasCreated bool, err error) {
+	entries, err := os.ReadDir(directory)
+	if err == nil {
+		if len(entries) > 0 {
+			return false, errors.Errorf("%s is not empty", directory)
+		}
+		return false, nil
+	}
+	if !os.IsNotExist(err) {
+		return false, errors.WithStack(err)
+	}
+	err = os.MkdirAll(directory, 0700)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	return true, nil
+}
+
+func removeDirectoryContents(directory string) error {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for _, entry := range entries {
+		err := os.RemoveAll(filepath.Join(directory, entry.Name()))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+func snapshotActivePrefix(snapshot *ldb.Snapshot) (*prefix.Prefix, error) {
+	activePrefixBytes, err := snapshot.Get(prefixmanager.ActivePrefixKey())
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return nil, errors.New("the database has no active consensus")
+		}
+		return nil, err
+	}
+	return prefix.Deserialize(activePrefixBytes)
+}
+
+// copyPrefix copies all the entries of snapshot whose keys start with keyPrefix
+// into targetDB in batches, and returns their checksum
+func copyPrefix(snapshot *ldb.Snapshot, targetDB *ldb.LevelDB, keyPrefix []byte) (*entryChecksum, error) {
+	checksum := newEntryChecksum()
+	rootBucket := database.MakeBucket(nil)
+
+	dbTx, err := targetDB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// dbTx changes when a batch is committed, so it's rolled back through the closure
+		if dbTx != nil {
+			dbTx.RollbackUnlessClosed()
+		}
+	}()
+
+	batchEntryCount, batchSize := 0, 0
+	err = snapshot.ForEach(keyPrefix, func(key []byte, value []byte) error {
+		checksum.add(key, value)
+		err := dbTx.Put(rootBucket.Key(key), value)
+		if err != nil {
+			return err
+		}
+		batchEntryCount++
+		batchSize += len(key) + len(value)
+		if batchEntryCount < maxBatchEntryCount && batchSize < maxBatchSize {
+			return nil
+		}
+
+		err = dbTx.Commit()
+		if err != nil {
+			return err
+		}
+		dbTx, err = targetDB.Begin()
+		if err != nil {
+			return err
+		}
+		batchEntryCount, batchSize = 0, 0
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = dbTx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return checksum, nil
+}
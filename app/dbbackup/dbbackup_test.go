@@ -0,0 +1,208 @@
+package dbbackup
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/domain/prefixmanager"
+	"github.com/wombatlabs/coinsecd/domain/prefixmanager/prefix"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database/ldb"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database/memdb"
+)
+
+var (
+	activeConsensusKey   = database.MakeBucket([]byte{0}).Bucket([]byte("blocks")).Key([]byte("block"))
+	inactiveConsensusKey = database.MakeBucket([]byte{1}).Bucket([]byte("blocks")).Key([]byte("block"))
+	utxoIndexKey         = database.MakeBucket([]byte("utxo-index")).Key([]byte("utxo"))
+	utxoIndexStateKey    = database.MakeBucket(nil).Key([]byte("utxo-index-virtual-parents"))
+	addressManagerKey    = database.MakeBucket([]byte("not-banned-addresses")).Key([]byte("address"))
+
+	backedUpKeys    = []*database.Key{activeConsensusKey, utxoIndexKey, utxoIndexStateKey}
+	notBackedUpKeys = []*database.Key{inactiveConsensusKey, addressManagerKey}
+)
+
+func prepareDatabaseForTest(t *testing.T) *ldb.LevelDB {
+	db, err := ldb.NewLevelDB(filepath.Join(t.TempDir(), "db"), 8)
+	if err != nil {
+		t.Fatalf("NewLevelDB: %s", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	activePrefix, err := prefix.Deserialize([]byte{0})
+	if err != nil {
+		t.Fatalf("Deserialize: %s", err)
+	}
+	err = prefixmanager.SetPrefixAsActive(db, activePrefix)
+	if err != nil {
+		t.Fatalf("SetPrefixAsActive: %s", err)
+	}
+	err = prefixmanager.SetPrefixAsInactive(db, activePrefix.Flip())
+	if err != nil {
+		t.Fatalf("SetPrefixAsInactive: %s", err)
+	}
+	for _, key := range append(backedUpKeys, notBackedUpKeys...) {
+		err := db.Put(key, key.Bytes())
+		if err != nil {
+			t.Fatalf("Put: %s", err)
+		}
+	}
+	return db
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	db := prepareDatabaseForTest(t)
+	backupDirectory := filepath.Join(t.TempDir(), "backup")
+
+	manifest, err := Backup(db, 3, backupDirectory)
+	if err != nil {
+		t.Fatalf("Backup: %+v", err)
+	}
+	// The active prefix key is backed up as well
+	if manifest.EntryCount() != uint64(len(backedUpKeys)+1) {
+		t.Fatalf("Expected %d entries in the backup, but got %d", len(backedUpKeys)+1, manifest.EntryCount())
+	}
+	if manifest.ActivePrefix != "00" || manifest.DatabaseVersion != 3 {
+		t.Fatalf("Unexpected manifest %+v", manifest)
+	}
+
+	_, err = Backup(db, 3, backupDirectory)
+	if err == nil {
+		t.Fatalf("Backup into a non-empty directory unexpectedly succeeded")
+	}
+
+	databasePath := filepath.Join(t.TempDir(), "restored")
+	_, err = Restore(backupDirectory, databasePath, 2)
+	if err == nil {
+		t.Fatalf("Restore of a backup with a newer database version unexpectedly succeeded")
+	}
+	restoredManifest, err := Restore(backupDirectory, databasePath, 3)
+	if err != nil {
+		t.Fatalf("Restore: %+v", err)
+	}
+	if restoredManifest.EntryCount() != manifest.EntryCount() {
+		t.Fatalf("Restored %d entries instead of %d", restoredManifest.EntryCount(), manifest.EntryCount())
+	}
+	version, err := readVersionFile(databasePath)
+	if err != nil {
+		t.Fatalf("readVersionFile: %+v", err)
+	}
+	if version != 3 {
+		t.Fatalf("Unexpected restored database version %d", version)
+	}
+
+	restoredDB, err := ldb.NewLevelDB(databasePath, 8)
+	if err != nil {
+		t.Fatalf("NewLevelDB: %s", err)
+	}
+	defer restoredDB.Close()
+	for _, key := range backedUpKeys {
+		value, err := restoredDB.Get(key)
+		if err != nil {
+			t.Fatalf("Get %s from the restored database: %s", key, err)
+		}
+		if !bytes.Equal(value, key.Bytes()) {
+			t.Fatalf("Unexpected restored value %x for key %s", value, key)
+		}
+	}
+	for _, key := range notBackedUpKeys {
+		has, err := restoredDB.Has(key)
+		if err != nil {
+			t.Fatalf("Has: %s", err)
+		}
+		if has {
+			t.Fatalf("Key %s was restored even though it's not part of backups", key)
+		}
+	}
+	activePrefix, hasActivePrefix, err := prefixmanager.ActivePrefix(restoredDB)
+	if err != nil {
+		t.Fatalf("ActivePrefix: %s", err)
+	}
+	if !hasActivePrefix || !bytes.Equal(activePrefix.Serialize(), []byte{0}) {
+		t.Fatalf("The active prefix wasn't restored")
+	}
+
+	_, err = Restore(backupDirectory, databasePath, 3)
+	if err == nil {
+		t.Fatalf("Restore over an existing database unexpectedly succeeded")
+	}
+}
+
+func TestRestoreCorruptedBackup(t *testing.T) {
+	db := prepareDatabaseForTest(t)
+	backupDirectory := filepath.Join(t.TempDir(), "backup")
+	manifest, err := Backup(db, 1, backupDirectory)
+	if err != nil {
+		t.Fatalf("Backup: %+v", err)
+	}
+
+	manifest.Prefixes[1].EntryCount++
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		t.Fatalf("Marshal: %s", err)
+	}
+	err = os.WriteFile(filepath.Join(backupDirectory, manifestFileName), manifestBytes, 0600)
+	if err != nil {
+		t.Fatalf("WriteFile: %s", err)
+	}
+
+	databasePath := filepath.Join(t.TempDir(), "restored")
+	_, err = Restore(backupDirectory, databasePath, 1)
+	if err == nil {
+		t.Fatalf("Restore of a corrupted backup unexpectedly succeeded")
+	}
+	for _, path := range []string{databasePath, databasePath + ".restoring"} {
+		_, err = os.Stat(path)
+		if !os.IsNotExist(err) {
+			t.Fatalf("A failed restore left %s behind", path)
+		}
+	}
+}
+
+func TestBackupMemoryDB(t *testing.T) {
+	db := memdb.NewMemoryDB()
+	defer db.Close()
+
+	backupDirectory := filepath.Join(t.TempDir(), "backup")
+	_, err := Backup(db, 1, backupDirectory)
+	if err == nil {
+		t.Fatalf("Backup of an in-memory database unexpectedly succeeded")
+	}
+}
+
+func TestFailedBackupCleanup(t *testing.T) {
+	// A database without an active consensus fails to be backed up
+	db, err := ldb.NewLevelDB(filepath.Join(t.TempDir(), "db"), 8)
+	if err != nil {
+		t.Fatalf("NewLevelDB: %s", err)
+	}
+	defer db.Close()
+
+	// A directory that the backup created is removed
+	backupDirectory := filepath.Join(t.TempDir(), "backup")
+	_, err = Backup(db, 1, backupDirectory)
+	if err == nil {
+		t.Fatalf("Backup of a database without an active consensus unexpectedly succeeded")
+	}
+	_, err = os.Stat(backupDirectory)
+	if !os.IsNotExist(err) {
+		t.Fatalf("A failed backup left %s behind", backupDirectory)
+	}
+
+	// A directory that existed is kept, and only emptied
+	existingDirectory := t.TempDir()
+	_, err = Backup(db, 1, existingDirectory)
+	if err == nil {
+		t.Fatalf("Backup of a database without an active consensus unexpectedly succeeded")
+	}
+	entries, err := os.ReadDir(existingDirectory)
+	if err != nil {
+		t.Fatalf("A failed backup removed the existing directory %s: %s", existingDirectory, err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("A failed backup left %d entries in %s", len(entries), existingDirectory)
+	}
+}
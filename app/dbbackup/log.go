@@ -0,0 +1,7 @@
+package dbbackup
+
+import (
+	"github.com/wombatlabs/coinsecd/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("BKUP")
@@ -0,0 +1,159 @@
+package dbbackup
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/wombatlabs/coinsecd/domain/prefixmanager"
+	"github.com/wombatlabs/coinsecd/domain/prefixmanager/prefix"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+const (
+	// formatVersion is the version of the layout of backup directories
+	formatVersion = 1
+
+	databaseDirectoryName = "db"
+	versionFileName       = "version"
+	manifestFileName      = "manifest.json"
+)
+
+// indexKeyPrefixes are the key prefixes of the data of the optional indexes. Each
+// of them covers both the index's bucket and the keys that track the index's state,
+// such as "utxo-index-virtual-parents"
+var indexKeyPrefixes = [][]byte{
+	[]byte("utxo-index"),
+	[]byte("tx-index"),
+	[]byte("address-history-index"),
+}
+
+// backupKeyPrefixes returns the key prefixes that a backup of a database whose
+// active consensus is in activePrefix consists of. The staging consensus, if
+// there is one, is left out, and so is the address manager's data
+func backupKeyPrefixes(activePrefix *prefix.Prefix) [][]byte {
+	keyPrefixes := [][]byte{
+		prefixmanager.ActivePrefixKey().Bytes(),
+		database.MakeBucket(activePrefix.Serialize()).Path(),
+	}
+	return append(keyPrefixes, indexKeyPrefixes...)
+}
+
+// Manifest describes the contents of a backup. It's written after everything else,
+// so a backup directory without a manifest holds an incomplete backup
+type Manifest struct {
+	FormatVersion   int               `json:"formatVersion"`
+	DatabaseVersion int               `json:"databaseVersion"`
+	ActivePrefix    string            `json:"activePrefix"`
+	Timestamp       int64             `json:"timestamp"`
+	Prefixes        []*PrefixManifest `json:"prefixes"`
+}
+
+// PrefixManifest describes the entries of a backup under a single key prefix
+type PrefixManifest struct {
+	Prefix     string `json:"prefix"`
+	EntryCount uint64 `json:"entryCount"`
+	Checksum   string `json:"checksum"`
+}
+
+// EntryCount returns the total number of database entries in the backup
+func (m *Manifest) EntryCount() uint64 {
+	entryCount := uint64(0)
+	for _, prefixManifest := range m.Prefixes {
+		entryCount += prefixManifest.EntryCount
+	}
+	return entryCount
+}
+
+// ReadManifest reads the manifest of the backup in backupDirectory
+func ReadManifest(backupDirectory string) (*Manifest, error) {
+	manifestBytes, err := os.ReadFile(filepath.Join(backupDirectory, manifestFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Errorf("%s has no %s, so it's not a complete database backup",
+				backupDirectory, manifestFileName)
+		}
+		return nil, errors.WithStack(err)
+	}
+	manifest := &Manifest{}
+	err = json.Unmarshal(manifestBytes, manifest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing the manifest of %s", backupDirectory)
+	}
+	return manifest, nil
+}
+
+func writeManifest(backupDirectory string, manifest *Manifest) error {
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return writeFileAtomically(filepath.Join(backupDirectory, manifestFileName), manifestBytes)
+}
+
+// readVersionFile reads a database version file, in the same format that the node
+// keeps next to its database
+func readVersionFile(directory string) (int, error) {
+	versionBytes, err := os.ReadFile(filepath.Join(directory, versionFileName))
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	version, err := strconv.Atoi(strings.TrimSpace(string(versionBytes)))
+	if err != nil {
+		return 0, errors.Wrapf(err, "error parsing the version file of %s", directory)
+	}
+	return version, nil
+}
+
+func writeVersionFile(directory string, version int) error {
+	return writeFileAtomically(filepath.Join(directory, versionFileName), []byte(strconv.Itoa(version)))
+}
+
+// writeFileAtomically writes a file through a temporary file, so that a crash
+// never leaves it half written
+func writeFileAtomically(fileName string, data []byte) error {
+	temporaryFileName := fileName + ".tmp"
+	err := os.WriteFile(temporaryFileName, data, 0600)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.Rename(temporaryFileName, fileName))
+}
+
+// entryChecksum accumulates the number and a hash of the entries under a key prefix
+type entryChecksum struct {
+	entryCount uint64
+	hash       hash.Hash
+}
+
+func newEntryChecksum() *entryChecksum {
+	return &entryChecksum{hash: sha256.New()}
+}
+
+// add adds an entry to the checksum. Keys and values are length-prefixed, so that
+// moving bytes between a key and its value changes the checksum
+func (c *entryChecksum) add(key []byte, value []byte) {
+	var lengthBytes [4]byte
+	binary.LittleEndian.PutUint32(lengthBytes[:], uint32(len(key)))
+	c.hash.Write(lengthBytes[:])
+	c.hash.Write(key)
+	binary.LittleEndian.PutUint32(lengthBytes[:], uint32(len(value)))
+	c.hash.Write(lengthBytes[:])
+	c.hash.Write(value)
+	c.entryCount++
+}
+
+func (c *entryChecksum) prefixManifest(keyPrefix []byte) *PrefixManifest {
+	return &PrefixManifest{
+		Prefix:     hex.EncodeToString(keyPrefix),
+		EntryCount: c.entryCount,
+		Checksum:   hex.EncodeToString(c.hash.Sum(nil)),
+	}
+}
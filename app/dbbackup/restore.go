@@ -0,0 +1,139 @@
+package dbbackup
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database/ldb"
+	"github.com/pkg/errors"
+)
+
+// Restore validates the backup in backupDirectory and restores it into a new
+// database at databasePath, along with its version file. The backup is rejected
+// if it was written by a newer node, whose database version is above
+// maxDatabaseVersion, or if any of its entries don't match its manifest.
+// databasePath is only created once the whole backup was validated
+func Restore(backupDirectory string, databasePath string, maxDatabaseVersion int) (*Manifest, error) {
+	_, err := os.Stat(databasePath)
+	if err == nil {
+		return nil, errors.Errorf("can't restore a backup into %s since a database already exists there",
+			databasePath)
+	}
+	if !os.IsNotExist(err) {
+		return nil, errors.WithStack(err)
+	}
+
+	manifest, err := validateManifest(backupDirectory, maxDatabaseVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	backupDB, err := ldb.NewReadOnlyLevelDB(filepath.Join(backupDirectory, databaseDirectoryName))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening the database of the backup in %s", backupDirectory)
+	}
+	defer backupDB.Close()
+	snapshot, err := backupDB.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer snapshot.Release()
+
+	activePrefix, err := snapshotActivePrefix(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if hex.EncodeToString(activePrefix.Serialize()) != manifest.ActivePrefix {
+		return nil, errors.Errorf("the active prefix of the backup is %x, but its manifest says it's %s",
+			activePrefix.Serialize(), manifest.ActivePrefix)
+	}
+	keyPrefixes := backupKeyPrefixes(activePrefix)
+	if len(manifest.Prefixes) != len(keyPrefixes) {
+		return nil, errors.Errorf("the manifest of the backup lists %d key prefixes instead of %d",
+			len(manifest.Prefixes), len(keyPrefixes))
+	}
+
+	// The database is restored next to databasePath and moved into it once it's
+	// complete, so that a failed restore never leaves a partial database behind
+	restoringPath := databasePath + ".restoring"
+	err = os.RemoveAll(restoringPath)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	isComplete := false
+	defer func() {
+		if !isComplete {
+			removeErr := os.RemoveAll(restoringPath)
+			if removeErr != nil {
+				log.Warnf("Error removing the partially restored database in %s: %s", restoringPath, removeErr)
+			}
+		}
+	}()
+
+	log.Infof("Restoring the database backup in %s into %s", backupDirectory, databasePath)
+	targetDB, err := ldb.NewLevelDB(restoringPath, copyCacheSizeMiB)
+	if err != nil {
+		return nil, err
+	}
+	for i, keyPrefix := range keyPrefixes {
+		expected := manifest.Prefixes[i]
+		checksum, err := copyPrefix(snapshot, targetDB, keyPrefix)
+		if err != nil {
+			targetDB.Close()
+			return nil, err
+		}
+		actual := checksum.prefixManifest(keyPrefix)
+		if *actual != *expected {
+			targetDB.Close()
+			return nil, errors.Errorf("the backup is corrupted: key prefix %s has %d entries with "+
+				"checksum %s, but its manifest lists key prefix %s with %d entries and checksum %s",
+				actual.Prefix, actual.EntryCount, actual.Checksum,
+				expected.Prefix, expected.EntryCount, expected.Checksum)
+		}
+	}
+	err = targetDB.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeVersionFile(restoringPath, manifest.DatabaseVersion)
+	if err != nil {
+		return nil, err
+	}
+	err = os.Rename(restoringPath, databasePath)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	isComplete = true
+	log.Infof("Finished restoring %d database entries into %s", manifest.EntryCount(), databasePath)
+	return manifest, nil
+}
+
+// validateManifest reads the manifest of the backup in backupDirectory, and makes
+// sure that the node can restore it
+func validateManifest(backupDirectory string, maxDatabaseVersion int) (*Manifest, error) {
+	manifest, err := ReadManifest(backupDirectory)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.FormatVersion != formatVersion {
+		return nil, errors.Errorf("the backup in %s has format version %d, but only version %d is supported",
+			backupDirectory, manifest.FormatVersion, formatVersion)
+	}
+	if manifest.DatabaseVersion < 1 || manifest.DatabaseVersion > maxDatabaseVersion {
+		return nil, errors.Errorf("the backup in %s has database version %d, but this node supports "+
+			"versions 1 to %d", backupDirectory, manifest.DatabaseVersion, maxDatabaseVersion)
+	}
+
+	version, err := readVersionFile(backupDirectory)
+	if err != nil {
+		return nil, err
+	}
+	if version != manifest.DatabaseVersion {
+		return nil, errors.Errorf("the version file of the backup in %s says %d, but its manifest says %d",
+			backupDirectory, version, manifest.DatabaseVersion)
+	}
+	return manifest, nil
+}
@@ -0,0 +1,87 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/app/protocol"
+	"github.com/wombatlabs/coinsecd/domain/utxoindex"
+	"github.com/wombatlabs/coinsecd/infrastructure/config"
+	infrastructuredatabase "github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/wombatlabs/coinsecd/infrastructure/health"
+)
+
+// healthCheckKey is the key that the database health check writes to and deletes
+var healthCheckKey = infrastructuredatabase.MakeBucket([]byte("health-check")).Key([]byte("write"))
+
+// newHealthServer creates a health server whose liveness checks make sure the process
+// and the database are working, and whose readiness checks make sure the node is synced
+// and connected enough to be relied on
+func newHealthServer(cfg *config.Config, db infrastructuredatabase.Database, protocolManager *protocol.Manager,
+	utxoIndex *utxoindex.UTXOIndex) *health.Server {
+
+	startTime := time.Now()
+	processCheck := &health.Check{Name: "process", Func: func() (bool, string) {
+		return true, fmt.Sprintf("up for %s", time.Since(startTime).Round(time.Second))
+	}}
+	databaseCheck := &health.Check{Name: "database", Func: func() (bool, string) {
+		return checkDatabaseWritable(db)
+	}}
+	livenessChecks := []*health.Check{processCheck, databaseCheck}
+
+	flowContext := protocolManager.Context()
+	readinessChecks := []*health.Check{
+		{Name: "synced", Func: func() (bool, string) {
+			isNearlySynced, err := flowContext.Domain().Consensus().IsNearlySynced()
+			if err != nil {
+				return false, err.Error()
+			}
+			if !isNearlySynced {
+				return false, "the node is not synced"
+			}
+			return true, "the node is synced"
+		}},
+		{Name: "peers", Func: func() (bool, string) {
+			peerCount := len(flowContext.Peers())
+			detail := fmt.Sprintf("%d peers connected, %d required", peerCount, cfg.HealthMinPeers)
+			return peerCount >= cfg.HealthMinPeers, detail
+		}},
+		{Name: "virtual", Func: func() (bool, string) {
+			isVirtualResolved, err := flowContext.Domain().Consensus().IsVirtualResolved()
+			if err != nil {
+				return false, err.Error()
+			}
+			if !isVirtualResolved {
+				return false, "the virtual is not resolved yet"
+			}
+			return true, "the virtual is resolved"
+		}},
+	}
+	if utxoIndex != nil {
+		readinessChecks = append(readinessChecks, &health.Check{Name: "utxoindex", Func: func() (bool, string) {
+			isSynced, err := utxoIndex.IsSynced()
+			if err != nil {
+				return false, err.Error()
+			}
+			if !isSynced {
+				return false, "the UTXO index is not synced with the virtual"
+			}
+			return true, "the UTXO index is synced"
+		}})
+	}
+
+	return health.NewServer(cfg.HealthListeners, livenessChecks, readinessChecks)
+}
+
+// checkDatabaseWritable writes a key to the database and deletes it
+func checkDatabaseWritable(db infrastructuredatabase.Database) (bool, string) {
+	err := db.Put(healthCheckKey, []byte(time.Now().UTC().Format(time.RFC3339)))
+	if err != nil {
+		return false, fmt.Sprintf("error writing to the database: %s", err)
+	}
+	err = db.Delete(healthCheckKey)
+	if err != nil {
+		return false, fmt.Sprintf("error deleting from the database: %s", err)
+	}
+	return true, "the database is writable"
+}
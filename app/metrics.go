@@ -0,0 +1,235 @@
+package app
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/app/protocol"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/utxolrucache"
+	infrastructuredatabase "github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database/ldb"
+	"github.com/wombatlabs/coinsecd/infrastructure/metrics"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
+)
+
+// nodeMetricsCollector collects the metrics of the node's state on every scrape
+type nodeMetricsCollector struct {
+	protocolManager *protocol.Manager
+	netAdapter      *netadapter.NetAdapter
+	db              infrastructuredatabase.Database
+}
+
+func newNodeMetricsCollector(protocolManager *protocol.Manager, netAdapter *netadapter.NetAdapter,
+	db infrastructuredatabase.Database) *nodeMetricsCollector {
+
+	return &nodeMetricsCollector{
+		protocolManager: protocolManager,
+		netAdapter:      netAdapter,
+		db:              db,
+	}
+}
+
+// Collect returns the current families of the node's metrics. Metrics that fail to
+// be collected are skipped, so that a single failure doesn't fail the whole scrape
+func (c *nodeMetricsCollector) Collect() []*metrics.Family {
+	var families []*metrics.Family
+	families = append(families, c.collectConsensus()...)
+	families = append(families, c.collectIBD()...)
+	families = append(families, c.collectMempool()...)
+	families = append(families, c.collectPeers()...)
+	families = append(families, c.collectP2PTraffic()...)
+	families = append(families, c.collectUTXOCache()...)
+	families = append(families, c.collectLevelDB()...)
+	return families
+}
+
+func (c *nodeMetricsCollector) collectConsensus() []*metrics.Family {
+	consensus := c.protocolManager.Context().Domain().Consensus()
+
+	syncInfo, err := consensus.GetSyncInfo()
+	if err != nil {
+		log.Warnf("Error collecting the sync info metrics: %s", err)
+		return nil
+	}
+	virtualInfo, err := consensus.GetVirtualInfo()
+	if err != nil {
+		log.Warnf("Error collecting the virtual metrics: %s", err)
+		return nil
+	}
+
+	return []*metrics.Family{
+		metrics.NewGaugeFamily("coinsecd_headers", "Number of block headers in the DAG",
+			float64(syncInfo.HeaderCount)),
+		metrics.NewGaugeFamily("coinsecd_blocks", "Number of blocks with bodies in the DAG",
+			float64(syncInfo.BlockCount)),
+		metrics.NewGaugeFamily("coinsecd_virtual_daa_score", "DAA score of the virtual block",
+			float64(virtualInfo.DAAScore)),
+		metrics.NewGaugeFamily("coinsecd_virtual_blue_score", "Blue score of the virtual block",
+			float64(virtualInfo.BlueScore)),
+	}
+}
+
+func (c *nodeMetricsCollector) collectIBD() []*metrics.Family {
+	ibdProgress, isIBDRunning := c.protocolManager.Context().IBDProgress()
+
+	ibdRunning := metrics.NewGaugeFamily("coinsecd_ibd_running", "Whether the node is in IBD", 0)
+	if !isIBDRunning {
+		return []*metrics.Family{ibdRunning}
+	}
+	ibdRunning.Samples[0].Value = 1
+
+	ibdProgressPercent := &metrics.Family{Name: "coinsecd_ibd_progress_percent",
+		Help: "Progress of the current phase of IBD", Type: metrics.TypeGauge}
+	ibdProcessed := &metrics.Family{Name: "coinsecd_ibd_processed",
+		Help: "Number of objects processed in the current phase of IBD", Type: metrics.TypeGauge}
+	if ibdProgress.ObjectName != "" {
+		objectsLabel := metrics.Label{Name: "objects", Value: ibdProgress.ObjectName}
+		ibdProgressPercent.AddSample(float64(ibdProgress.ProgressPercent), objectsLabel)
+		ibdProcessed.AddSample(float64(ibdProgress.Processed), objectsLabel)
+	}
+	return []*metrics.Family{ibdRunning, ibdProgressPercent, ibdProcessed}
+}
+
+func (c *nodeMetricsCollector) collectMempool() []*metrics.Family {
+	mempoolInfo := c.protocolManager.Context().Domain().MiningManager().GetMempoolInfo()
+	orphanBlockCount := c.protocolManager.Context().OrphanCount()
+
+	return []*metrics.Family{
+		metrics.NewGaugeFamily("coinsecd_mempool_transactions", "Number of transactions in the mempool",
+			float64(mempoolInfo.TransactionCount)),
+		metrics.NewGaugeFamily("coinsecd_mempool_mass", "Total mass of the transactions in the mempool",
+			float64(mempoolInfo.TotalMass)),
+		metrics.NewGaugeFamily("coinsecd_mempool_orphan_transactions", "Number of orphan transactions in the mempool",
+			float64(mempoolInfo.OrphanCount)),
+		metrics.NewGaugeFamily("coinsecd_orphan_blocks", "Number of orphan blocks waiting for their parents",
+			float64(orphanBlockCount)),
+	}
+}
+
+func (c *nodeMetricsCollector) collectPeers() []*metrics.Family {
+	inboundCount, outboundCount := 0, 0
+	for _, peer := range c.protocolManager.Context().Peers() {
+		if peer.IsOutbound() {
+			outboundCount++
+		} else {
+			inboundCount++
+		}
+	}
+
+	peers := &metrics.Family{Name: "coinsecd_peers", Help: "Number of connected peers, per direction",
+		Type: metrics.TypeGauge}
+	peers.AddSample(float64(inboundCount), metrics.Label{Name: "direction", Value: "inbound"})
+	peers.AddSample(float64(outboundCount), metrics.Label{Name: "direction", Value: "outbound"})
+	return []*metrics.Family{peers}
+}
+
+func (c *nodeMetricsCollector) collectP2PTraffic() []*metrics.Family {
+	traffic := c.netAdapter.P2PTraffic()
+	if traffic == nil {
+		return nil
+	}
+
+	receivedMessages := &metrics.Family{Name: "coinsecd_p2p_received_messages_total",
+		Help: "Number of P2P messages received, per command", Type: metrics.TypeCounter}
+	receivedBytes := &metrics.Family{Name: "coinsecd_p2p_received_bytes_total",
+		Help: "Size of the P2P messages received, per command", Type: metrics.TypeCounter}
+	addTrafficSamples(receivedMessages, receivedBytes, traffic.Incoming())
+
+	sentMessages := &metrics.Family{Name: "coinsecd_p2p_sent_messages_total",
+		Help: "Number of P2P messages sent, per command", Type: metrics.TypeCounter}
+	sentBytes := &metrics.Family{Name: "coinsecd_p2p_sent_bytes_total",
+		Help: "Size of the P2P messages sent, per command", Type: metrics.TypeCounter}
+	addTrafficSamples(sentMessages, sentBytes, traffic.Outgoing())
+
+	return []*metrics.Family{receivedMessages, receivedBytes, sentMessages, sentBytes}
+}
+
+func addTrafficSamples(messages *metrics.Family, bytes *metrics.Family,
+	traffic map[appmessage.MessageCommand]router.MessageTraffic) {
+
+	commandNames := make([]string, 0, len(traffic))
+	trafficByCommandName := make(map[string]router.MessageTraffic, len(traffic))
+	for command, messageTraffic := range traffic {
+		commandName, ok := appmessage.ProtocolMessageCommandToString[command]
+		if !ok {
+			commandName = command.String()
+		}
+		commandNames = append(commandNames, commandName)
+		trafficByCommandName[commandName] = messageTraffic
+	}
+	sort.Strings(commandNames)
+
+	for _, commandName := range commandNames {
+		commandLabel := metrics.Label{Name: "command", Value: commandName}
+		messages.AddSample(float64(trafficByCommandName[commandName].MessageCount), commandLabel)
+		bytes.AddSample(float64(trafficByCommandName[commandName].ByteCount), commandLabel)
+	}
+}
+
+func (c *nodeMetricsCollector) collectUTXOCache() []*metrics.Family {
+	hits, misses := utxolrucache.Stats()
+	return []*metrics.Family{
+		metrics.NewCounterFamily("coinsecd_utxo_cache_hits_total",
+			"Number of virtual UTXO set lookups that were found in the cache", float64(hits)),
+		metrics.NewCounterFamily("coinsecd_utxo_cache_misses_total",
+			"Number of virtual UTXO set lookups that were not found in the cache", float64(misses)),
+	}
+}
+
+func (c *nodeMetricsCollector) collectLevelDB() []*metrics.Family {
+	levelDB, ok := c.db.(*ldb.LevelDB)
+	if !ok {
+		return nil
+	}
+	stats, err := levelDB.Stats()
+	if err != nil {
+		log.Warnf("Error collecting the LevelDB metrics: %s", err)
+		return nil
+	}
+
+	writePaused := float64(0)
+	if stats.WritePaused {
+		writePaused = 1
+	}
+	families := []*metrics.Family{
+		metrics.NewCounterFamily("coinsecd_leveldb_read_bytes_total", "Bytes read from storage by LevelDB",
+			float64(stats.IORead)),
+		metrics.NewCounterFamily("coinsecd_leveldb_written_bytes_total", "Bytes written to storage by LevelDB",
+			float64(stats.IOWrite)),
+		metrics.NewGaugeFamily("coinsecd_leveldb_block_cache_bytes", "Size of the LevelDB block cache",
+			float64(stats.BlockCacheSize)),
+		metrics.NewGaugeFamily("coinsecd_leveldb_open_tables", "Number of open LevelDB tables",
+			float64(stats.OpenedTablesCount)),
+		metrics.NewGaugeFamily("coinsecd_leveldb_alive_snapshots", "Number of open LevelDB snapshots",
+			float64(stats.AliveSnapshots)),
+		metrics.NewGaugeFamily("coinsecd_leveldb_alive_iterators", "Number of open LevelDB iterators",
+			float64(stats.AliveIterators)),
+		metrics.NewCounterFamily("coinsecd_leveldb_write_delays_total",
+			"Number of writes LevelDB delayed because of compactions", float64(stats.WriteDelayCount)),
+		metrics.NewCounterFamily("coinsecd_leveldb_write_delay_seconds_total",
+			"Time writes were delayed by LevelDB because of compactions", stats.WriteDelayDuration.Seconds()),
+		metrics.NewGaugeFamily("coinsecd_leveldb_write_paused", "Whether LevelDB currently pauses writes",
+			writePaused),
+	}
+
+	levelSizes := &metrics.Family{Name: "coinsecd_leveldb_level_size_bytes", Help: "Size of each LevelDB level",
+		Type: metrics.TypeGauge}
+	for level, size := range stats.LevelSizes {
+		levelSizes.AddSample(float64(size), metrics.Label{Name: "level", Value: strconv.Itoa(level)})
+	}
+	levelTables := &metrics.Family{Name: "coinsecd_leveldb_level_tables",
+		Help: "Number of tables in each LevelDB level", Type: metrics.TypeGauge}
+	for level, tableCount := range stats.LevelTablesCounts {
+		levelTables.AddSample(float64(tableCount), metrics.Label{Name: "level", Value: strconv.Itoa(level)})
+	}
+	compactions := &metrics.Family{Name: "coinsecd_leveldb_compactions_total",
+		Help: "Number of LevelDB compactions, per type", Type: metrics.TypeCounter}
+	compactions.AddSample(float64(stats.MemComp), metrics.Label{Name: "type", Value: "memory"})
+	compactions.AddSample(float64(stats.Level0Comp), metrics.Label{Name: "type", Value: "level0"})
+	compactions.AddSample(float64(stats.NonLevel0Comp), metrics.Label{Name: "type", Value: "non_level0"})
+	compactions.AddSample(float64(stats.SeekComp), metrics.Label{Name: "type", Value: "seek"})
+
+	return append(families, levelSizes, levelTables, compactions)
+}
@@ -143,6 +143,7 @@ func (f *FlowContext) TrySetIBDRunning(ibdPeer *peerpkg.Peer) bool {
 		return false
 	}
 	f.ibdPeer = ibdPeer
+	f.ibdProgress = IBDProgress{}
 	log.Infof("IBD started with peer %s", ibdPeer)
 
 	return true
@@ -158,6 +159,39 @@ func (f *FlowContext) UnsetIBDRunning() {
 	}
 
 	f.ibdPeer = nil
+	f.ibdProgress = IBDProgress{}
+}
+
+// IBDProgress describes how far along the current phase of IBD is
+type IBDProgress struct {
+	// ObjectName is the name of the objects synced in the current phase, e.g. "blocks"
+	ObjectName      string
+	Processed       int
+	ProgressPercent int
+}
+
+// SetIBDProgress records the progress of the current phase of IBD
+func (f *FlowContext) SetIBDProgress(objectName string, processed int, progressPercent int) {
+	f.ibdPeerMutex.Lock()
+	defer f.ibdPeerMutex.Unlock()
+
+	if f.ibdPeer == nil {
+		return
+	}
+	f.ibdProgress = IBDProgress{
+		ObjectName:      objectName,
+		Processed:       processed,
+		ProgressPercent: progressPercent,
+	}
+}
+
+// IBDProgress returns the progress of the current phase of IBD, and
+// false if the node is not in IBD
+func (f *FlowContext) IBDProgress() (IBDProgress, bool) {
+	f.ibdPeerMutex.RLock()
+	defer f.ibdPeerMutex.RUnlock()
+
+	return f.ibdProgress, f.ibdPeer != nil
 }
 
 // IBDPeer returns the current IBD peer or null if the node is not
@@ -50,11 +50,16 @@ type FlowContext struct {
 	sharedRequestedBlocks *SharedRequestedBlocks
 
 	ibdPeer      *peerpkg.Peer
+	ibdProgress  IBDProgress
 	ibdPeerMutex sync.RWMutex
 
 	peers      map[id.ID]*peerpkg.Peer
 	peersMutex sync.RWMutex
 
+	misbehaviorScores            map[string]*peerpkg.MisbehaviorScore
+	misbehaviorScoreHolderCounts map[string]int
+	misbehaviorScoresMutex       sync.Mutex
+
 	orphans      map[externalapi.DomainHash]*externalapi.DomainBlock
 	orphansMutex sync.RWMutex
 
@@ -78,6 +83,8 @@ func New(cfg *config.Config, domain domain.Domain, addressManager *addressmanage
 		sharedRequestedTransactions:      NewSharedRequestedTransactions(),
 		sharedRequestedBlocks:            NewSharedRequestedBlocks(),
 		peers:                            make(map[id.ID]*peerpkg.Peer),
+		misbehaviorScores:                make(map[string]*peerpkg.MisbehaviorScore),
+		misbehaviorScoreHolderCounts:     make(map[string]int),
 		orphans:                          make(map[externalapi.DomainHash]*externalapi.DomainBlock),
 		timeStarted:                      mstime.Now().UnixMilliseconds(),
 		transactionIDsToPropagate:        []*externalapi.DomainTransactionID{},
@@ -0,0 +1,75 @@
+package flowcontext
+
+import (
+	peerpkg "github.com/wombatlabs/coinsecd/app/protocol/peer"
+	"github.com/wombatlabs/coinsecd/app/protocol/protocolerrors"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter"
+)
+
+// MisbehaviorScore returns the misbehavior score of the host behind the given connection, and holds
+// it for the connection until ReleaseMisbehaviorScore is called. Scores are kept per IP so that a
+// misbehaving peer can't reset its score by reconnecting
+func (f *FlowContext) MisbehaviorScore(netConnection *netadapter.NetConnection) *peerpkg.MisbehaviorScore {
+	return f.acquireMisbehaviorScore(misbehaviorScoreHost(netConnection))
+}
+
+// ReleaseMisbehaviorScore releases the misbehavior score that MisbehaviorScore returned for the
+// given connection, allowing it to be forgotten once it fully decays
+func (f *FlowContext) ReleaseMisbehaviorScore(netConnection *netadapter.NetConnection) {
+	f.releaseMisbehaviorScore(misbehaviorScoreHost(netConnection))
+}
+
+// AddMisbehavior adds the weight of the given misbehavior to the misbehavior score of the host
+// behind the given connection, and returns the resulting score
+func (f *FlowContext) AddMisbehavior(netConnection *netadapter.NetConnection,
+	misbehavior *protocolerrors.Misbehavior) uint32 {
+
+	return f.addMisbehavior(misbehaviorScoreHost(netConnection), misbehavior.Weight)
+}
+
+func misbehaviorScoreHost(netConnection *netadapter.NetConnection) string {
+	return netConnection.NetAddress().IP.String()
+}
+
+func (f *FlowContext) acquireMisbehaviorScore(host string) *peerpkg.MisbehaviorScore {
+	f.misbehaviorScoresMutex.Lock()
+	defer f.misbehaviorScoresMutex.Unlock()
+
+	f.misbehaviorScoreHolderCounts[host]++
+	return f.misbehaviorScoreNoLock(host)
+}
+
+func (f *FlowContext) releaseMisbehaviorScore(host string) {
+	f.misbehaviorScoresMutex.Lock()
+	defer f.misbehaviorScoresMutex.Unlock()
+
+	f.misbehaviorScoreHolderCounts[host]--
+	if f.misbehaviorScoreHolderCounts[host] <= 0 {
+		delete(f.misbehaviorScoreHolderCounts, host)
+	}
+}
+
+func (f *FlowContext) addMisbehavior(host string, weight uint32) uint32 {
+	f.misbehaviorScoresMutex.Lock()
+	defer f.misbehaviorScoresMutex.Unlock()
+
+	return f.misbehaviorScoreNoLock(host).Increase(weight)
+}
+
+func (f *FlowContext) misbehaviorScoreNoLock(host string) *peerpkg.MisbehaviorScore {
+	if score, ok := f.misbehaviorScores[host]; ok {
+		return score
+	}
+
+	// Forget hosts whose score has fully decayed, so that the map doesn't grow indefinitely.
+	// Scores that connections hold are kept, since their peers keep accumulating into them
+	for otherHost, otherScore := range f.misbehaviorScores {
+		if f.misbehaviorScoreHolderCounts[otherHost] == 0 && otherScore.Score() == 0 {
+			delete(f.misbehaviorScores, otherHost)
+		}
+	}
+
+	score := peerpkg.NewMisbehaviorScore()
+	f.misbehaviorScores[host] = score
+	return score
+}
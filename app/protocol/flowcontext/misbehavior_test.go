@@ -0,0 +1,42 @@
+package flowcontext
+
+import (
+	"testing"
+
+	peerpkg "github.com/wombatlabs/coinsecd/app/protocol/peer"
+)
+
+func TestMisbehaviorScoreOfConnectedPeer(t *testing.T) {
+	f := New(nil, nil, nil, nil, nil)
+
+	// The peer's score is at 0, like a score that has fully decayed
+	peer := peerpkg.NewWithMisbehaviorScore(nil, f.acquireMisbehaviorScore("1.1.1.1"))
+	if score := peer.MisbehaviorScore().Score(); score != 0 {
+		t.Fatalf("Expected a new score to be 0 but got %d", score)
+	}
+
+	// Another connection forgets the scores that have fully decayed
+	otherScore := f.acquireMisbehaviorScore("2.2.2.2")
+	f.releaseMisbehaviorScore("2.2.2.2")
+
+	score := f.addMisbehavior("1.1.1.1", 10)
+	if score != 10 {
+		t.Fatalf("Expected score 10 but got %d", score)
+	}
+	if peer.MisbehaviorScore().Score() == 0 {
+		t.Fatalf("Expected the misbehavior to be added to the score the peer holds, but its score is still 0")
+	}
+
+	// A released score that has fully decayed is forgotten once another host connects
+	f.acquireMisbehaviorScore("3.3.3.3")
+	if f.acquireMisbehaviorScore("2.2.2.2") == otherScore {
+		t.Fatalf("Expected the released score at 0 to be forgotten")
+	}
+
+	// A released score that hasn't decayed is restored when its host reconnects
+	f.releaseMisbehaviorScore("1.1.1.1")
+	f.acquireMisbehaviorScore("4.4.4.4")
+	if f.acquireMisbehaviorScore("1.1.1.1") != peer.MisbehaviorScore() {
+		t.Fatalf("Expected the score of a reconnecting host to be restored")
+	}
+}
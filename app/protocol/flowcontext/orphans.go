@@ -41,6 +41,14 @@ func (f *FlowContext) evictRandomOrphan() {
 	log.Debugf("Evicted %s from the orphan collection", toEvict)
 }
 
+// OrphanCount returns the number of blocks in the orphan collection
+func (f *FlowContext) OrphanCount() int {
+	f.orphansMutex.RLock()
+	defer f.orphansMutex.RUnlock()
+
+	return len(f.orphans)
+}
+
 // IsOrphan returns whether the given blockHash belongs to an orphan block
 func (f *FlowContext) IsOrphan(blockHash *externalapi.DomainHash) bool {
 	f.orphansMutex.RLock()
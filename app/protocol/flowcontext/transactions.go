@@ -6,6 +6,7 @@ import (
 	"github.com/wombatlabs/coinsecd/app/appmessage"
 	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
 	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	miningmanagermodel "github.com/wombatlabs/coinsecd/domain/miningmanager/model"
 )
 
 // TransactionIDPropagationInterval is the interval between transaction IDs propagations
@@ -22,6 +23,46 @@ func (f *FlowContext) AddTransaction(tx *externalapi.DomainTransaction, allowOrp
 	return f.EnqueueTransactionIDsForPropagation(acceptedTransactionIDs)
 }
 
+// AddTransactionReplacement adds transaction to the mempool in place of the mempool
+// transactions it double spends, and propagates it. The replaced transactions are returned.
+func (f *FlowContext) AddTransactionReplacement(tx *externalapi.DomainTransaction) (
+	replacedTransactions []*externalapi.DomainTransaction, err error) {
+
+	acceptedTransactions, replacedTransactions, err := f.Domain().MiningManager().
+		ValidateAndInsertTransactionWithReplacement(tx, true, false, miningmanagermodel.RBFPolicyMandatory)
+	if err != nil {
+		return nil, err
+	}
+
+	f.RemoveTransactionIDsFromPropagation(consensushashing.TransactionIDs(replacedTransactions))
+
+	acceptedTransactionIDs := consensushashing.TransactionIDs(acceptedTransactions)
+	err = f.EnqueueTransactionIDsForPropagation(acceptedTransactionIDs)
+	if err != nil {
+		return nil, err
+	}
+	return replacedTransactions, nil
+}
+
+// AddTransactionPackage adds the given package of transactions, sorted parents first, to the
+// mempool and propagates the transactions that were accepted. Either all the transactions in
+// the package are accepted or none is.
+func (f *FlowContext) AddTransactionPackage(transactions []*externalapi.DomainTransaction) (
+	acceptedTransactions []*externalapi.DomainTransaction, err error) {
+
+	acceptedTransactions, err = f.Domain().MiningManager().ValidateAndInsertTransactionPackage(transactions, true)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptedTransactionIDs := consensushashing.TransactionIDs(acceptedTransactions)
+	err = f.EnqueueTransactionIDsForPropagation(acceptedTransactionIDs)
+	if err != nil {
+		return nil, err
+	}
+	return acceptedTransactions, nil
+}
+
 func (f *FlowContext) shouldRebroadcastTransactions() bool {
 	const rebroadcastInterval = 30 * time.Second
 	return time.Since(f.lastRebroadcastTime) > rebroadcastInterval
@@ -53,6 +94,31 @@ func (f *FlowContext) EnqueueTransactionIDsForPropagation(transactionIDs []*exte
 	return f.maybePropagateTransactions()
 }
 
+// RemoveTransactionIDsFromPropagation removes the given transaction IDs from the set of IDs
+// waiting to be propagated. It's used for transactions that were replaced in the mempool
+// before being propagated, so that peers aren't offered transactions that are gone
+func (f *FlowContext) RemoveTransactionIDsFromPropagation(transactionIDs []*externalapi.DomainTransactionID) {
+	if len(transactionIDs) == 0 {
+		return
+	}
+
+	f.transactionIDPropagationLock.Lock()
+	defer f.transactionIDPropagationLock.Unlock()
+
+	transactionIDsToRemove := make(map[externalapi.DomainTransactionID]struct{}, len(transactionIDs))
+	for _, transactionID := range transactionIDs {
+		transactionIDsToRemove[*transactionID] = struct{}{}
+	}
+
+	remainingTransactionIDs := f.transactionIDsToPropagate[:0]
+	for _, transactionID := range f.transactionIDsToPropagate {
+		if _, ok := transactionIDsToRemove[*transactionID]; !ok {
+			remainingTransactionIDs = append(remainingTransactionIDs, transactionID)
+		}
+	}
+	f.transactionIDsToPropagate = remainingTransactionIDs
+}
+
 func (f *FlowContext) maybePropagateTransactions() error {
 	if time.Since(f.lastTransactionIDPropagationTime) < TransactionIDPropagationInterval &&
 		len(f.transactionIDsToPropagate) < appmessage.MaxInvPerTxInvMsg {
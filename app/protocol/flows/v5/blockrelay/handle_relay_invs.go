@@ -85,7 +85,7 @@ func (flow *handleRelayInvsFlow) start() error {
 		}
 		if blockInfo.Exists && blockInfo.BlockStatus != externalapi.StatusHeaderOnly {
 			if blockInfo.BlockStatus == externalapi.StatusInvalid {
-				return protocolerrors.Errorf(true, "sent inv of an invalid block %s",
+				return protocolerrors.Misbehavingf(protocolerrors.MisbehaviorInvalidBlock, "sent inv of an invalid block %s",
 					inv.Hash)
 			}
 			log.Debugf("Block %s already exists. continuing...", inv.Hash)
@@ -296,7 +296,7 @@ func (flow *handleRelayInvsFlow) requestBlock(requestHash *externalapi.DomainHas
 	block := appmessage.MsgBlockToDomainBlock(msgBlock)
 	blockHash := consensushashing.BlockHash(block)
 	if !blockHash.Equal(requestHash) {
-		return nil, false, protocolerrors.Errorf(true, "got unrequested block %s", blockHash)
+		return nil, false, protocolerrors.Misbehavingf(protocolerrors.MisbehaviorUnrequestedMessage, "got unrequested block %s", blockHash)
 	}
 
 	return block, false, nil
@@ -339,7 +339,7 @@ func (flow *handleRelayInvsFlow) processBlock(block *externalapi.DomainBlock) ([
 		if !errors.Is(err, ruleerrors.ErrDuplicateBlock) {
 			log.Warnf("Rejected block %s from %s: %s", blockHash, flow.peer, err)
 		}
-		return nil, protocolerrors.Wrapf(true, err, "got invalid block %s from relay", blockHash)
+		return nil, protocolerrors.WrapMisbehavingf(protocolerrors.MisbehaviorInvalidBlock, err, "got invalid block %s from relay", blockHash)
 	}
 	return nil, nil
 }
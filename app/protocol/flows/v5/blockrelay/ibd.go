@@ -27,6 +27,7 @@ type IBDContext interface {
 	IsIBDRunning() bool
 	TrySetIBDRunning(ibdPeer *peerpkg.Peer) bool
 	UnsetIBDRunning()
+	SetIBDProgress(objectName string, processed int, progressPercent int)
 	IsRecoverableError(err error) bool
 }
 
@@ -359,7 +360,7 @@ func (flow *handleIBDFlow) syncPruningPointFutureHeaders(consensus externalapi.C
 	if err != nil {
 		return err
 	}
-	progressReporter := newIBDProgressReporter(highestSharedBlockHeader.DAAScore(), highBlockDAAScoreHint, "block headers")
+	progressReporter := newIBDProgressReporter(flow, highestSharedBlockHeader.DAAScore(), highBlockDAAScoreHint, "block headers")
 
 	// Keep a short queue of BlockHeadersMessages so that there's
 	// never a moment when the node is not validating and inserting
@@ -526,7 +527,7 @@ func (flow *handleIBDFlow) processHeader(consensus externalapi.Consensus, msgBlo
 			log.Debugf("Skipping block header %s as it is a duplicate", blockHash)
 		} else {
 			log.Infof("Rejected block header %s from %s during IBD: %s", blockHash, flow.peer, err)
-			return protocolerrors.Wrapf(true, err, "got invalid block header %s during IBD", blockHash)
+			return protocolerrors.WrapMisbehavingf(protocolerrors.MisbehaviorInvalidBlock, err, "got invalid block header %s during IBD", blockHash)
 		}
 	}
 
@@ -643,7 +644,7 @@ func (flow *handleIBDFlow) syncMissingBlockBodies(highHash *externalapi.DomainHa
 	if err != nil {
 		return err
 	}
-	progressReporter := newIBDProgressReporter(lowBlockHeader.DAAScore(), highBlockHeader.DAAScore(), "blocks")
+	progressReporter := newIBDProgressReporter(flow, lowBlockHeader.DAAScore(), highBlockHeader.DAAScore(), "blocks")
 	highestProcessedDAAScore := lowBlockHeader.DAAScore()
 
 	// If the IBD is small, we want to update the virtual after each block in order to avoid complications and possible bugs.
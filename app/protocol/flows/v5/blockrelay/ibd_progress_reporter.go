@@ -1,6 +1,7 @@
 package blockrelay
 
 type ibdProgressReporter struct {
+	context                     IBDContext
 	lowDAAScore                 uint64
 	highDAAScore                uint64
 	objectName                  string
@@ -9,12 +10,13 @@ type ibdProgressReporter struct {
 	processed                   int
 }
 
-func newIBDProgressReporter(lowDAAScore uint64, highDAAScore uint64, objectName string) *ibdProgressReporter {
+func newIBDProgressReporter(context IBDContext, lowDAAScore uint64, highDAAScore uint64, objectName string) *ibdProgressReporter {
 	if highDAAScore <= lowDAAScore {
 		// Avoid a zero or negative diff
 		highDAAScore = lowDAAScore + 1
 	}
 	return &ibdProgressReporter{
+		context:                     context,
 		lowDAAScore:                 lowDAAScore,
 		highDAAScore:                highDAAScore,
 		objectName:                  objectName,
@@ -42,4 +44,5 @@ func (ipr *ibdProgressReporter) reportProgress(processedDelta int, highestProces
 		log.Infof("IBD: Processed %d %s (%d%%)", ipr.processed, ipr.objectName, progressPercent)
 		ipr.lastReportedProgressPercent = progressPercent
 	}
+	ipr.context.SetIBDProgress(ipr.objectName, ipr.processed, progressPercent)
 }
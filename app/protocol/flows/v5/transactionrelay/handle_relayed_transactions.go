@@ -9,6 +9,7 @@ import (
 	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
 	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
 	"github.com/wombatlabs/coinsecd/domain/miningmanager/mempool"
+	miningmanagermodel "github.com/wombatlabs/coinsecd/domain/miningmanager/model"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
 	"github.com/pkg/errors"
@@ -22,6 +23,7 @@ type TransactionsRelayContext interface {
 	SharedRequestedTransactions() *flowcontext.SharedRequestedTransactions
 	OnTransactionAddedToMempool()
 	EnqueueTransactionIDsForPropagation(transactionIDs []*externalapi.DomainTransactionID) error
+	RemoveTransactionIDsFromPropagation(transactionIDs []*externalapi.DomainTransactionID)
 	IsNearlySynced() (bool, error)
 }
 
@@ -170,7 +172,7 @@ func (flow *handleRelayedTransactionsFlow) receiveTransactions(requestedTransact
 		}
 		if msgTxNotFound != nil {
 			if !msgTxNotFound.ID.Equal(expectedID) {
-				return protocolerrors.Errorf(true, "expected transaction %s, but got %s",
+				return protocolerrors.Misbehavingf(protocolerrors.MisbehaviorUnrequestedMessage, "expected transaction %s, but got %s",
 					expectedID, msgTxNotFound.ID)
 			}
 
@@ -179,12 +181,12 @@ func (flow *handleRelayedTransactionsFlow) receiveTransactions(requestedTransact
 		tx := appmessage.MsgTxToDomainTransaction(msgTx)
 		txID := consensushashing.TransactionID(tx)
 		if !txID.Equal(expectedID) {
-			return protocolerrors.Errorf(true, "expected transaction %s, but got %s",
+			return protocolerrors.Misbehavingf(protocolerrors.MisbehaviorUnrequestedMessage, "expected transaction %s, but got %s",
 				expectedID, txID)
 		}
 
-		acceptedTransactions, err :=
-			flow.Domain().MiningManager().ValidateAndInsertTransaction(tx, false, true)
+		acceptedTransactions, replacedTransactions, err := flow.Domain().MiningManager().
+			ValidateAndInsertTransactionWithReplacement(tx, false, true, miningmanagermodel.RBFPolicyAllowed)
 		if err != nil {
 			ruleErr := &mempool.RuleError{}
 			if !errors.As(err, ruleErr) {
@@ -204,6 +206,7 @@ func (flow *handleRelayedTransactionsFlow) receiveTransactions(requestedTransact
 
 			return protocolerrors.Errorf(true, "rejected transaction %s: %s", txID, ruleErr)
 		}
+		flow.RemoveTransactionIDsFromPropagation(consensushashing.TransactionIDs(replacedTransactions))
 		err = flow.broadcastAcceptedTransactions(consensushashing.TransactionIDs(acceptedTransactions))
 		if err != nil {
 			return err
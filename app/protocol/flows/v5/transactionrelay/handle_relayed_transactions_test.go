@@ -44,6 +44,9 @@ func (m *mocTransactionsRelayContext) EnqueueTransactionIDsForPropagation(transa
 	return nil
 }
 
+func (m *mocTransactionsRelayContext) RemoveTransactionIDsFromPropagation(transactionIDs []*externalapi.DomainTransactionID) {
+}
+
 func (m *mocTransactionsRelayContext) OnTransactionAddedToMempool() {
 }
 
@@ -68,6 +68,22 @@ func (m *Manager) AddTransaction(tx *externalapi.DomainTransaction, allowOrphan
 	return m.context.AddTransaction(tx, allowOrphan)
 }
 
+// AddTransactionReplacement adds transaction to the mempool in place of the mempool
+// transactions it double spends, and propagates it. The replaced transactions are returned.
+func (m *Manager) AddTransactionReplacement(tx *externalapi.DomainTransaction) (
+	replacedTransactions []*externalapi.DomainTransaction, err error) {
+
+	return m.context.AddTransactionReplacement(tx)
+}
+
+// AddTransactionPackage adds the given package of transactions, sorted parents first, to the
+// mempool and propagates the transactions that were accepted.
+func (m *Manager) AddTransactionPackage(transactions []*externalapi.DomainTransaction) (
+	acceptedTransactions []*externalapi.DomainTransaction, err error) {
+
+	return m.context.AddTransactionPackage(transactions)
+}
+
 // AddBlock adds the given block to the DAG and propagates it.
 func (m *Manager) AddBlock(block *externalapi.DomainBlock) error {
 	return m.context.AddBlock(block)
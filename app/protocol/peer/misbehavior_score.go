@@ -0,0 +1,69 @@
+package peer
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// misbehaviorScoreHalfLife is the time it takes a misbehavior score to decay to half its value
+const misbehaviorScoreHalfLife = 10 * time.Minute
+
+// MisbehaviorScore accumulates the weighted penalties of a peer's
+// misbehavior. The score decays exponentially over time, so that
+// occasional misbehavior is forgiven while persistent misbehavior
+// eventually crosses the ban threshold
+type MisbehaviorScore struct {
+	score      float64
+	lastUpdate time.Time
+	mutex      sync.Mutex
+}
+
+// NewMisbehaviorScore returns a new zeroed MisbehaviorScore
+func NewMisbehaviorScore() *MisbehaviorScore {
+	return &MisbehaviorScore{}
+}
+
+// Increase adds the given weight to the score and returns the resulting score
+func (ms *MisbehaviorScore) Increase(weight uint32) uint32 {
+	return ms.increaseAt(weight, time.Now())
+}
+
+// Score returns the current, decayed, score
+func (ms *MisbehaviorScore) Score() uint32 {
+	return ms.scoreAt(time.Now())
+}
+
+func (ms *MisbehaviorScore) increaseAt(weight uint32, now time.Time) uint32 {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	ms.score = ms.decayedScore(now) + float64(weight)
+	ms.lastUpdate = now
+	return toUint32(ms.score)
+}
+
+func (ms *MisbehaviorScore) scoreAt(now time.Time) uint32 {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	return toUint32(ms.decayedScore(now))
+}
+
+func (ms *MisbehaviorScore) decayedScore(now time.Time) float64 {
+	if ms.score == 0 {
+		return 0
+	}
+	elapsed := now.Sub(ms.lastUpdate)
+	if elapsed <= 0 {
+		return ms.score
+	}
+	return ms.score * math.Exp2(-float64(elapsed)/float64(misbehaviorScoreHalfLife))
+}
+
+func toUint32(score float64) uint32 {
+	if score >= math.MaxUint32 {
+		return math.MaxUint32
+	}
+	return uint32(score)
+}
@@ -0,0 +1,32 @@
+package peer
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMisbehaviorScore(t *testing.T) {
+	misbehaviorScore := NewMisbehaviorScore()
+	now := time.Now()
+
+	if score := misbehaviorScore.scoreAt(now); score != 0 {
+		t.Fatalf("Expected a new score to be 0 but got %d", score)
+	}
+
+	if score := misbehaviorScore.increaseAt(40, now); score != 40 {
+		t.Fatalf("Expected score 40 but got %d", score)
+	}
+	if score := misbehaviorScore.increaseAt(40, now); score != 80 {
+		t.Fatalf("Expected penalties to accumulate to 80 but got %d", score)
+	}
+
+	if score := misbehaviorScore.scoreAt(now.Add(misbehaviorScoreHalfLife)); score != 40 {
+		t.Fatalf("Expected the score to decay to 40 after one half-life but got %d", score)
+	}
+	if score := misbehaviorScore.increaseAt(10, now.Add(2*misbehaviorScoreHalfLife)); score != 30 {
+		t.Fatalf("Expected the score to decay to 20 and increase to 30 but got %d", score)
+	}
+	if score := misbehaviorScore.scoreAt(now.Add(100 * misbehaviorScoreHalfLife)); score != 0 {
+		t.Fatalf("Expected the score to decay to 0 but got %d", score)
+	}
+}
@@ -33,14 +33,23 @@ type Peer struct {
 	lastPingDuration time.Duration // Time for last ping to return
 
 	ibdRequestChannel chan *externalapi.DomainBlock // A channel used to communicate IBD requests between flows
+
+	misbehaviorScore *MisbehaviorScore
 }
 
 // New returns a new Peer
 func New(connection *netadapter.NetConnection) *Peer {
+	return NewWithMisbehaviorScore(connection, NewMisbehaviorScore())
+}
+
+// NewWithMisbehaviorScore returns a new Peer that continues accumulating the given misbehavior
+// score. This allows the score to outlive a single connection from the same host
+func NewWithMisbehaviorScore(connection *netadapter.NetConnection, misbehaviorScore *MisbehaviorScore) *Peer {
 	return &Peer{
 		connection:        connection,
 		connectionStarted: time.Now(),
 		ibdRequestChannel: make(chan *externalapi.DomainBlock),
+		misbehaviorScore:  misbehaviorScore,
 	}
 }
 
@@ -49,6 +58,11 @@ func (p *Peer) Connection() *netadapter.NetConnection {
 	return p.connection
 }
 
+// MisbehaviorScore returns the misbehavior score of the peer
+func (p *Peer) MisbehaviorScore() *MisbehaviorScore {
+	return p.misbehaviorScore
+}
+
 // SubnetworkID returns the subnetwork the peer is associated with.
 // It is nil in full nodes.
 func (p *Peer) SubnetworkID() *externalapi.DomainSubnetworkID {
@@ -53,6 +53,8 @@ func (m *Manager) routerInitializer(router *routerpkg.Router, netConnection *net
 			}
 		})
 
+		// HandleHandshake holds the misbehavior score of the connection's host for the peer
+		defer m.context.ReleaseMisbehaviorScore(netConnection)
 		peer, err := handshake.HandleHandshake(m.context, netConnection, receiveVersionRoute,
 			sendVersionRoute, router.OutgoingRoute())
 
@@ -106,18 +108,8 @@ func (m *Manager) routerInitializer(router *routerpkg.Router, netConnection *net
 
 func (m *Manager) handleError(err error, netConnection *netadapter.NetConnection, outgoingRoute *routerpkg.Route) {
 	if protocolErr := (protocolerrors.ProtocolError{}); errors.As(err, &protocolErr) {
-		if m.context.Config().EnableBanning && protocolErr.ShouldBan {
-			log.Warnf("Banning %s (reason: %s)", netConnection, protocolErr.Cause)
-
-			err := m.context.ConnectionManager().Ban(netConnection)
-			if err != nil && !errors.Is(err, connmanager.ErrCannotBanPermanent) {
-				panic(err)
-			}
-
-			err = outgoingRoute.Enqueue(appmessage.NewMsgReject(protocolErr.Error()))
-			if err != nil && !errors.Is(err, routerpkg.ErrRouteClosed) {
-				panic(err)
-			}
+		if protocolErr.ShouldBan && protocolErr.Misbehavior != nil {
+			m.addMisbehavior(netConnection, outgoingRoute, protocolErr.Misbehavior, protocolErr.Cause)
 		}
 		log.Infof("Disconnecting from %s (reason: %s)", netConnection, protocolErr.Cause)
 		netConnection.Disconnect()
@@ -125,6 +117,7 @@ func (m *Manager) handleError(err error, netConnection *netadapter.NetConnection
 	}
 	if errors.Is(err, routerpkg.ErrTimeout) {
 		log.Warnf("Got timeout from %s. Disconnecting...", netConnection)
+		m.addMisbehavior(netConnection, outgoingRoute, protocolerrors.MisbehaviorSlowResponse, err)
 		netConnection.Disconnect()
 		return
 	}
@@ -134,6 +127,30 @@ func (m *Manager) handleError(err error, netConnection *netadapter.NetConnection
 	panic(err)
 }
 
+// addMisbehavior adds the given misbehavior to the score of the peer behind netConnection,
+// and bans the peer if banning is enabled and the score has reached the ban threshold
+func (m *Manager) addMisbehavior(netConnection *netadapter.NetConnection, outgoingRoute *routerpkg.Route,
+	misbehavior *protocolerrors.Misbehavior, reason error) {
+
+	score := m.context.AddMisbehavior(netConnection, misbehavior)
+	log.Debugf("Misbehavior score of %s increased by %d to %d (%s)", netConnection, misbehavior.Weight, score, misbehavior)
+
+	if !m.context.Config().EnableBanning || score < m.context.Config().BanThreshold {
+		return
+	}
+	log.Warnf("Banning %s (reason: %s, misbehavior score: %d)", netConnection, reason, score)
+
+	err := m.context.ConnectionManager().Ban(netConnection)
+	if err != nil && !errors.Is(err, connmanager.ErrCannotBanPermanent) {
+		panic(err)
+	}
+
+	err = outgoingRoute.Enqueue(appmessage.NewMsgReject(reason.Error()))
+	if err != nil && !errors.Is(err, routerpkg.ErrRouteClosed) {
+		panic(err)
+	}
+}
+
 // RegisterFlow registers a flow to the given router.
 func (m *Manager) RegisterFlow(name string, router *routerpkg.Router, messageTypes []appmessage.MessageCommand, isStopping *uint32,
 	errChan chan error, initializeFunc common.FlowInitializeFunc) *common.Flow {
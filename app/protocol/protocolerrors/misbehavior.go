@@ -0,0 +1,30 @@
+package protocolerrors
+
+// Misbehavior is a kind of peer misbehavior, along with the
+// penalty it adds to the misbehavior score of the peer
+type Misbehavior struct {
+	Name   string
+	Weight uint32
+}
+
+func (m *Misbehavior) String() string {
+	return m.Name
+}
+
+var (
+	// MisbehaviorProtocolViolation is any unclassified violation of the protocol that
+	// calls for banning. It's heavy enough to cross the default ban threshold by itself
+	MisbehaviorProtocolViolation = &Misbehavior{Name: "protocol violation", Weight: 100}
+
+	// MisbehaviorInvalidBlock is sending a block or header that breaks the consensus rules
+	MisbehaviorInvalidBlock = &Misbehavior{Name: "invalid block", Weight: 100}
+
+	// MisbehaviorUnrequestedMessage is sending data that wasn't requested
+	MisbehaviorUnrequestedMessage = &Misbehavior{Name: "unrequested message", Weight: 50}
+
+	// MisbehaviorAddressFlood is sending more addresses than allowed
+	MisbehaviorAddressFlood = &Misbehavior{Name: "address flood", Weight: 50}
+
+	// MisbehaviorSlowResponse is failing to respond to a request in time
+	MisbehaviorSlowResponse = &Misbehavior{Name: "slow response", Weight: 10}
+)
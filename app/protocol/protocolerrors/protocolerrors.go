@@ -9,7 +9,12 @@ import (
 // of the peer-to-peer protocol
 type ProtocolError struct {
 	ShouldBan bool
-	Cause     error
+
+	// Misbehavior is the kind of misbehavior that the violation is counted as
+	// towards the peer's misbehavior score. It's nil for violations that don't
+	// count towards the score
+	Misbehavior *Misbehavior
+	Cause       error
 }
 
 func (e ProtocolError) Error() string {
@@ -21,12 +26,20 @@ func (e ProtocolError) Unwrap() error {
 	return e.Cause
 }
 
+func misbehaviorForShouldBan(shouldBan bool) *Misbehavior {
+	if shouldBan {
+		return MisbehaviorProtocolViolation
+	}
+	return nil
+}
+
 // Errorf formats according to a format specifier and returns the string
 // as a ProtocolError.
 func Errorf(shouldBan bool, format string, args ...interface{}) error {
 	return ProtocolError{
-		ShouldBan: shouldBan,
-		Cause:     errors.Errorf(format, args...),
+		ShouldBan:   shouldBan,
+		Misbehavior: misbehaviorForShouldBan(shouldBan),
+		Cause:       errors.Errorf(format, args...),
 	}
 }
 
@@ -34,24 +47,47 @@ func Errorf(shouldBan bool, format string, args ...interface{}) error {
 // New also records the stack trace at the point it was called.
 func New(shouldBan bool, message string) error {
 	return ProtocolError{
-		ShouldBan: shouldBan,
-		Cause:     errors.New(message),
+		ShouldBan:   shouldBan,
+		Misbehavior: misbehaviorForShouldBan(shouldBan),
+		Cause:       errors.New(message),
 	}
 }
 
 // Wrap wraps the given error and returns it as a ProtocolError.
 func Wrap(shouldBan bool, err error, message string) error {
 	return ProtocolError{
-		ShouldBan: shouldBan,
-		Cause:     errors.Wrap(err, message),
+		ShouldBan:   shouldBan,
+		Misbehavior: misbehaviorForShouldBan(shouldBan),
+		Cause:       errors.Wrap(err, message),
 	}
 }
 
 // Wrapf wraps the given error with the given format and returns it as a ProtocolError.
 func Wrapf(shouldBan bool, err error, format string, args ...interface{}) error {
 	return ProtocolError{
-		ShouldBan: shouldBan,
-		Cause:     errors.Wrapf(err, format, args...),
+		ShouldBan:   shouldBan,
+		Misbehavior: misbehaviorForShouldBan(shouldBan),
+		Cause:       errors.Wrapf(err, format, args...),
+	}
+}
+
+// Misbehavingf formats according to a format specifier and returns the string
+// as a ProtocolError that counts as the given misbehavior.
+func Misbehavingf(misbehavior *Misbehavior, format string, args ...interface{}) error {
+	return ProtocolError{
+		ShouldBan:   true,
+		Misbehavior: misbehavior,
+		Cause:       errors.Errorf(format, args...),
+	}
+}
+
+// WrapMisbehavingf wraps the given error with the given format and returns it as
+// a ProtocolError that counts as the given misbehavior.
+func WrapMisbehavingf(misbehavior *Misbehavior, err error, format string, args ...interface{}) error {
+	return ProtocolError{
+		ShouldBan:   true,
+		Misbehavior: misbehavior,
+		Cause:       errors.Wrapf(err, format, args...),
 	}
 }
 
@@ -63,5 +99,5 @@ func ConvertToBanningProtocolErrorIfRuleError(err error, format string, args ...
 		return err
 	}
 
-	return Wrapf(true, err, format, args...)
+	return WrapMisbehavingf(MisbehaviorInvalidBlock, err, format, args...)
 }
@@ -0,0 +1,69 @@
+package rpc
+
+import (
+	"strings"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcauth"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// rpcMethod returns the name of the RPC method of the given request command, as it's matched against roles
+func rpcMethod(requestCommand appmessage.MessageCommand) string {
+	return strings.TrimSuffix(appmessage.RPCMessageCommandToString[requestCommand], "Request")
+}
+
+// authorize returns nil if identity is allowed to send the given request, and otherwise
+// returns the response to send instead. A nil identity means that authentication is
+// disabled, and is allowed to send everything
+func authorize(identity *rpcauth.Identity, request appmessage.Message) (appmessage.Message, error) {
+	if identity == nil {
+		return nil, nil
+	}
+	method := rpcMethod(request.Command())
+	if identity.IsAllowed(method) {
+		return nil, nil
+	}
+
+	log.Warnf("RPC client %s is not allowed to call %s", identity, method)
+	rpcError := appmessage.RPCErrorf("%s is not allowed to call %s", identity, method)
+	rpcError.Code = appmessage.RPCErrorCodeNotAllowed
+	return newErrorResponse(request, rpcError)
+}
+
+// newErrorResponse builds the response of the given request with only its error set. Every
+// response message has an error field, but it isn't part of the appmessage.Message interface,
+// so the response is built through the reflection of the protowire messages
+func newErrorResponse(request appmessage.Message, rpcError *appmessage.RPCError) (appmessage.Message, error) {
+	requestMessage, err := protowire.FromAppMessage(request)
+	if err != nil {
+		return nil, err
+	}
+	requestReflection := requestMessage.ProtoReflect()
+	requestField := requestReflection.WhichOneof(payloadOneof)
+	if requestField == nil {
+		return nil, errors.Errorf("%s has no payload", request.Command())
+	}
+
+	responseFieldName := strings.TrimSuffix(string(requestField.Name()), "Request") + "Response"
+	responseField := payloadOneof.Fields().ByName(protoreflect.Name(responseFieldName))
+	if responseField == nil {
+		return nil, errors.Errorf("%s has no response", request.Command())
+	}
+	responsePayload := requestReflection.NewField(responseField).Message()
+	errorField := responsePayload.Descriptor().Fields().ByName("error")
+	if errorField == nil {
+		return nil, errors.Errorf("the response of %s has no error field", request.Command())
+	}
+	responsePayload.Set(errorField, protoreflect.ValueOfMessage((&protowire.RPCError{
+		Message:                rpcError.Message,
+		Code:                   protowire.RPCError_Code(rpcError.Code),
+		RetryAfterMilliseconds: rpcError.RetryAfterMilliseconds,
+	}).ProtoReflect()))
+
+	responseMessage := &protowire.CoinsecdMessage{}
+	responseMessage.ProtoReflect().Set(responseField, protoreflect.ValueOfMessage(responsePayload))
+	return responseMessage.ToAppMessage()
+}
@@ -0,0 +1,114 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/app/rpc/rpccontext"
+	"github.com/wombatlabs/coinsecd/infrastructure/config"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcauth"
+)
+
+func newTestAuthenticator(t *testing.T) *rpcauth.Authenticator {
+	tokensFilePath := filepath.Join(t.TempDir(), "tokens.json")
+	err := os.WriteFile(tokensFilePath, []byte(`{"tokens": [
+		{"name": "explorer", "token": "readonly-token", "role": "readonly"},
+		{"name": "operator", "token": "admin-token", "role": "admin"}
+	]}`), 0600)
+	if err != nil {
+		t.Fatalf("WriteFile: %s", err)
+	}
+	authenticator, err := rpcauth.NewAuthenticator(tokensFilePath, "")
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %s", err)
+	}
+	return authenticator
+}
+
+func TestAuthorize(t *testing.T) {
+	identity, err := newTestAuthenticator(t).Authenticate("readonly-token")
+	if err != nil {
+		t.Fatalf("Authenticate: %s", err)
+	}
+
+	checkedCommands := 0
+	for command := range handlers {
+		// The method names of the commands and of the JSON-RPC methods only differ in case
+		method := rpcMethod(command)
+		var jsonRPCMethod string
+		for candidate := range jsonRPCMethods {
+			if strings.EqualFold(candidate, method) {
+				jsonRPCMethod = candidate
+			}
+		}
+		if jsonRPCMethod == "" {
+			t.Fatalf("No JSON-RPC method matches %s (%s)", method, command)
+		}
+
+		request, err := parseJSONRPCParams(jsonRPCMethods[jsonRPCMethod], nil)
+		if err != nil {
+			// Some requests can't be converted without their fields
+			continue
+		}
+		checkedCommands++
+
+		response, err := authorize(identity, request)
+		if err != nil {
+			t.Fatalf("authorize %s: %s", method, err)
+		}
+		if identity.IsAllowed(method) {
+			if response != nil {
+				t.Fatalf("Expected %s to be allowed", method)
+			}
+			continue
+		}
+		if response == nil {
+			t.Fatalf("Expected %s to be rejected", method)
+		}
+		_, rpcErr := formatJSONRPCResult(response)
+		if rpcErr == nil || !strings.Contains(rpcErr.Message, "not allowed to call "+method) {
+			t.Fatalf("The response to %s doesn't carry the expected error: %v", method, rpcErr)
+		}
+	}
+	if checkedCommands == 0 {
+		t.Fatalf("No command was checked")
+	}
+}
+
+func TestJSONRPCServerAuthentication(t *testing.T) {
+	server := newJSONRPCServer(&rpccontext.Context{Config: config.DefaultConfig()}, nil, 0, newTestAuthenticator(t))
+
+	postWithToken := func(token string, body string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		request.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			request.Header.Set("Authorization", rpcauth.BearerAuthorization(token))
+		}
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	const getCurrentNetwork = `{"jsonrpc": "2.0", "method": "GetCurrentNetwork", "id": 1}`
+	for _, token := range []string{"", "wrong-token"} {
+		recorder := postWithToken(token, getCurrentNetwork)
+		if recorder.Code != http.StatusUnauthorized {
+			t.Fatalf("Token %q: unexpected status code. Want: %d, got: %d", token, http.StatusUnauthorized, recorder.Code)
+		}
+	}
+
+	response := decodeJSONRPCResponse(t, postWithToken("readonly-token", getCurrentNetwork))
+	if response.Error != nil {
+		t.Fatalf("Unexpected error: %s", response.Error.Message)
+	}
+
+	response = decodeJSONRPCResponse(t, postWithToken("readonly-token",
+		`{"jsonrpc": "2.0", "method": "ShutDown", "id": 1}`))
+	if response.Error == nil || response.Error.Code != jsonRPCNotAllowedCode {
+		t.Fatalf("Expected ShutDown to be rejected for the read-only token, got: %v", response.Error)
+	}
+}
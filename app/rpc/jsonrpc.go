@@ -0,0 +1,445 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/app/rpc/rpccontext"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server/grpcserver"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcauth"
+	"github.com/wombatlabs/coinsecd/util/panics"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const jsonRPCVersion = "2.0"
+
+// The error codes defined by the JSON-RPC 2.0 specification
+const (
+	jsonRPCParseErrorCode     = -32700
+	jsonRPCInvalidRequestCode = -32600
+	jsonRPCMethodNotFoundCode = -32601
+	jsonRPCInvalidParamsCode  = -32602
+	jsonRPCInternalErrorCode  = -32603
+
+	// jsonRPCServerErrorCode is returned when the request was handled, but the
+	// response carries an RPC error
+	jsonRPCServerErrorCode = -32000
+
+	// jsonRPCNotAllowedCode is returned when the role of the client doesn't allow the method
+	jsonRPCNotAllowedCode = -32001
+
+	// jsonRPCRateLimitedCode is returned when the client exceeded its rate limit
+	jsonRPCRateLimitedCode = -32002
+)
+
+// jsonRPCErrorCodes maps the codes of RPC errors to the codes of the JSON-RPC errors they're returned as
+var jsonRPCErrorCodes = map[protowire.RPCError_Code]int{
+	protowire.RPCError_UNSPECIFIED:  jsonRPCServerErrorCode,
+	protowire.RPCError_NOT_ALLOWED:  jsonRPCNotAllowedCode,
+	protowire.RPCError_RATE_LIMITED: jsonRPCRateLimitedCode,
+}
+
+type jsonRPCRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+
+	// ID is nil if the request is a notification, and "null" if it's explicitly null
+	ID json.RawMessage `json:"id"`
+}
+
+type jsonRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonRPCError   `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+
+	// Data is the RPCError in protobuf's JSON encoding, if the error came with a code
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// remoteIPContextKey is the key of the client's IP in the contexts of JSON-RPC requests
+type remoteIPContextKey struct{}
+
+var payloadOneof = (&protowire.CoinsecdMessage{}).ProtoReflect().Descriptor().Oneofs().ByName("payload")
+
+// jsonRPCMethods maps every JSON-RPC method name to the CoinsecdMessage payload field of its
+// request. Method names are the request names without the "RequestMessage" suffix, same as
+// in coinsecctl
+var jsonRPCMethods = buildJSONRPCMethods()
+
+func buildJSONRPCMethods() map[string]protoreflect.FieldDescriptor {
+	methods := make(map[string]protoreflect.FieldDescriptor)
+	payloadFields := payloadOneof.Fields()
+	for i := 0; i < payloadFields.Len(); i++ {
+		payloadField := payloadFields.Get(i)
+		messageName := string(payloadField.Message().Name())
+		if !strings.HasSuffix(messageName, "RequestMessage") {
+			continue
+		}
+		methods[strings.TrimSuffix(messageName, "RequestMessage")] = payloadField
+	}
+	return methods
+}
+
+// isNotificationMethod returns whether the given method subscribes to notifications
+// or unsubscribes from them, which requires a long-lived connection
+func isNotificationMethod(method string) bool {
+	return strings.HasPrefix(method, "Notify") || strings.HasPrefix(method, "StopNotifying")
+}
+
+// jsonRPCServer serves JSON-RPC 2.0 requests over HTTP, and dispatches them
+// to the same handlers as the RPC server
+type jsonRPCServer struct {
+	context            *rpccontext.Context
+	listeningAddresses []string
+	server             *http.Server
+
+	// authenticator is nil if RPC authentication is disabled
+	authenticator *rpcauth.Authenticator
+
+	// allowedOrigins are the origins of the web pages that may send requests. Requests
+	// from any other web page are rejected, so that a page the operator visits can't
+	// make their browser call the node
+	allowedOrigins map[string]struct{}
+
+	// concurrentRequestsSemaphore is nil if the number of concurrent requests is unlimited
+	concurrentRequestsSemaphore chan struct{}
+}
+
+func newJSONRPCServer(context *rpccontext.Context, listeningAddresses []string, maxConcurrentRequests int,
+	authenticator *rpcauth.Authenticator) *jsonRPCServer {
+
+	server := &jsonRPCServer{
+		context:            context,
+		listeningAddresses: listeningAddresses,
+		authenticator:      authenticator,
+		allowedOrigins:     make(map[string]struct{}),
+	}
+	for _, origin := range context.Config.JSONRPCAllowedOrigins {
+		server.allowedOrigins[origin] = struct{}{}
+	}
+	if maxConcurrentRequests > 0 {
+		server.concurrentRequestsSemaphore = make(chan struct{}, maxConcurrentRequests)
+	}
+	server.server = &http.Server{Handler: server}
+	return server
+}
+
+// start starts listening on all the server's addresses. If tlsConfig is not nil,
+// the server only accepts HTTPS connections
+func (s *jsonRPCServer) start(tlsConfig *tls.Config) error {
+	for _, listenAddress := range s.listeningAddresses {
+		listener, err := net.Listen("tcp", listenAddress)
+		if err != nil {
+			return errors.Wrapf(err, "JSON-RPC error listening on %s", listenAddress)
+		}
+		if tlsConfig != nil {
+			listener = tls.NewListener(listener, tlsConfig)
+		}
+
+		listenAddress := listenAddress
+		spawn("jsonRPCServer.start-Serve", func() {
+			err := s.server.Serve(listener)
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				panics.Exit(log, fmt.Sprintf("error serving JSON-RPC on %s: %+v", listenAddress, err))
+			}
+		})
+
+		log.Infof("JSON-RPC Server listening on %s", listener.Addr())
+	}
+	return nil
+}
+
+func (s *jsonRPCServer) stop() error {
+	const stopTimeout = 2 * time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+	defer cancel()
+
+	err := s.server.Shutdown(ctx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		log.Warnf("Could not gracefully stop the JSON-RPC server: timed out after %s", stopTimeout)
+		return s.server.Close()
+	}
+	return err
+}
+
+// ServeHTTP handles a single JSON-RPC request or a batch of them
+func (s *jsonRPCServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	// Browsers set the Origin header on every cross-origin request, so requests that
+	// carry it come from a web page and are only served if its origin is allowed
+	if origin := request.Header.Get("Origin"); origin != "" {
+		if _, ok := s.allowedOrigins[origin]; !ok {
+			log.Warnf("Rejected a JSON-RPC request from %s: origin %s is not allowed", request.RemoteAddr, origin)
+			http.Error(writer, fmt.Sprintf("origin %s is not allowed", origin), http.StatusForbidden)
+			return
+		}
+		writer.Header().Set("Access-Control-Allow-Origin", origin)
+		writer.Header().Set("Vary", "Origin")
+
+		// Allowed web pages have to be preflighted, since they send
+		// requests with a JSON body and an Authorization header
+		if request.Method == http.MethodOptions {
+			writer.Header().Set("Access-Control-Allow-Methods", http.MethodPost)
+			writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+			writer.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+
+	if request.Method != http.MethodPost {
+		writer.Header().Set("Allow", http.MethodPost)
+		http.Error(writer, "JSON-RPC requests must be sent with POST", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if s.authenticator != nil {
+		identity, err := s.authenticator.AuthenticateAuthorization(request.Header.Get(rpcauth.AuthorizationMetadataKey))
+		if err != nil {
+			log.Warnf("Rejected a JSON-RPC request from %s: %s", request.RemoteAddr, err)
+			writer.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(writer, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		request = request.WithContext(rpcauth.NewContext(request.Context(), identity))
+	}
+	if remoteIP, _, err := net.SplitHostPort(request.RemoteAddr); err == nil {
+		request = request.WithContext(context.WithValue(request.Context(), remoteIPContextKey{}, remoteIP))
+	}
+
+	// Requiring a JSON body also rules out the requests that browsers send
+	// cross-origin without a preflight, such as text/plain form posts
+	mediaType, _, err := mime.ParseMediaType(request.Header.Get("Content-Type"))
+	if err != nil || mediaType != "application/json" {
+		http.Error(writer, "JSON-RPC requests must have the application/json content type",
+			http.StatusUnsupportedMediaType)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(writer, request.Body, grpcserver.RPCMaxMessageSize))
+	if err != nil {
+		http.Error(writer, fmt.Sprintf("error reading the request: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var response interface{}
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		response = s.handleBatch(request.Context(), body)
+	} else {
+		// A nil *jsonRPCResponse has to become a nil interface, so that nothing is written
+		if singleResponse := s.handleSingle(request.Context(), body); singleResponse != nil {
+			response = singleResponse
+		}
+	}
+
+	// Notifications aren't answered
+	if response == nil {
+		writer.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(writer).Encode(response)
+	if err != nil {
+		log.Warnf("Error writing JSON-RPC response to %s: %s", request.RemoteAddr, err)
+	}
+}
+
+func (s *jsonRPCServer) handleBatch(ctx context.Context, body []byte) interface{} {
+	var batch []json.RawMessage
+	err := json.Unmarshal(body, &batch)
+	if err != nil {
+		return newJSONRPCErrorResponse(nil, jsonRPCParseErrorCode, err.Error())
+	}
+	if len(batch) == 0 {
+		return newJSONRPCErrorResponse(nil, jsonRPCInvalidRequestCode, "empty batch")
+	}
+
+	responses := make([]*jsonRPCResponse, 0, len(batch))
+	for _, requestBytes := range batch {
+		response := s.handleSingle(ctx, requestBytes)
+		if response != nil {
+			responses = append(responses, response)
+		}
+	}
+	if len(responses) == 0 {
+		return nil
+	}
+	return responses
+}
+
+// handleSingle handles a single JSON-RPC request. It returns nil if the request is a notification
+func (s *jsonRPCServer) handleSingle(ctx context.Context, requestBytes []byte) *jsonRPCResponse {
+	var request jsonRPCRequest
+	err := json.Unmarshal(requestBytes, &request)
+	if err != nil {
+		return newJSONRPCErrorResponse(nil, jsonRPCParseErrorCode, err.Error())
+	}
+	if request.JSONRPC != jsonRPCVersion || request.Method == "" {
+		return newJSONRPCErrorResponse(request.ID, jsonRPCInvalidRequestCode,
+			fmt.Sprintf("requests must have a method, and jsonrpc set to %s", jsonRPCVersion))
+	}
+
+	result, rpcErr := s.handleRequest(ctx, &request)
+	if request.ID == nil {
+		return nil
+	}
+	if rpcErr != nil {
+		return &jsonRPCResponse{JSONRPC: jsonRPCVersion, Error: rpcErr, ID: request.ID}
+	}
+	return &jsonRPCResponse{JSONRPC: jsonRPCVersion, Result: result, ID: request.ID}
+}
+
+func (s *jsonRPCServer) handleRequest(ctx context.Context, request *jsonRPCRequest) (json.RawMessage, *jsonRPCError) {
+	log.Debugf("Incoming JSON-RPC request %s", request.Method)
+
+	payloadField, ok := jsonRPCMethods[request.Method]
+	if !ok {
+		return nil, &jsonRPCError{Code: jsonRPCMethodNotFoundCode, Message: fmt.Sprintf("unknown method %s", request.Method)}
+	}
+	if isNotificationMethod(request.Method) {
+		return nil, &jsonRPCError{Code: jsonRPCMethodNotFoundCode,
+			Message: fmt.Sprintf("method %s is not supported over HTTP, since notifications require a "+
+				"long-lived connection", request.Method)}
+	}
+
+	requestMessage, err := parseJSONRPCParams(payloadField, request.Params)
+	if err != nil {
+		return nil, &jsonRPCError{Code: jsonRPCInvalidParamsCode, Message: err.Error()}
+	}
+	handler, ok := handlers[requestMessage.Command()]
+	if !ok {
+		return nil, &jsonRPCError{Code: jsonRPCMethodNotFoundCode, Message: fmt.Sprintf("unknown method %s", request.Method)}
+	}
+	identity := rpcauth.IdentityFromContext(ctx)
+	rejectionMessage, err := authorize(identity, requestMessage)
+	if err != nil {
+		return nil, &jsonRPCError{Code: jsonRPCInternalErrorCode, Message: err.Error()}
+	}
+	if rejectionMessage == nil {
+		remoteIP, _ := ctx.Value(remoteIPContextKey{}).(string)
+		if rpcError := checkRateLimit(s.context, rateLimitClient(identity, remoteIP), requestMessage.Command()); rpcError != nil {
+			rejectionMessage, err = newErrorResponse(requestMessage, rpcError)
+			if err != nil {
+				return nil, &jsonRPCError{Code: jsonRPCInternalErrorCode, Message: err.Error()}
+			}
+		}
+	}
+	if rejectionMessage != nil {
+		return formatJSONRPCResult(rejectionMessage)
+	}
+
+	if s.concurrentRequestsSemaphore != nil {
+		select {
+		case s.concurrentRequestsSemaphore <- struct{}{}:
+			defer func() { <-s.concurrentRequestsSemaphore }()
+		case <-ctx.Done():
+			return nil, &jsonRPCError{Code: jsonRPCInternalErrorCode, Message: "the request was canceled"}
+		}
+	}
+
+	// None of the handlers that are reachable here use the router, since it's
+	// only needed to register notification listeners
+	start := time.Now()
+	responseMessage, err := handler(s.context, nil, requestMessage)
+	if err != nil {
+		log.Warnf("Error handling JSON-RPC request %s: %+v", request.Method, err)
+		return nil, &jsonRPCError{Code: jsonRPCInternalErrorCode, Message: err.Error()}
+	}
+	observeRPCRequest(requestMessage.Command(), start)
+
+	return formatJSONRPCResult(responseMessage)
+}
+
+// parseJSONRPCParams converts the params of a JSON-RPC request, which are the fields of the
+// request message in protobuf's JSON encoding, into an appmessage
+func parseJSONRPCParams(payloadField protoreflect.FieldDescriptor, params json.RawMessage) (appmessage.Message, error) {
+	params = bytes.TrimSpace(params)
+	if len(params) == 0 || bytes.Equal(params, []byte("null")) {
+		params = []byte("{}")
+	}
+	if params[0] != '{' {
+		return nil, errors.New("params must be an object with the request's fields")
+	}
+
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(payloadField.Message().FullName())
+	if err != nil {
+		return nil, err
+	}
+	requestPayload := messageType.New()
+	err = protojson.Unmarshal(params, requestPayload.Interface())
+	if err != nil {
+		return nil, err
+	}
+
+	coinsecdMessage := &protowire.CoinsecdMessage{}
+	coinsecdMessage.ProtoReflect().Set(payloadField, protoreflect.ValueOfMessage(requestPayload))
+	return coinsecdMessage.ToAppMessage()
+}
+
+// formatJSONRPCResult converts the given response message into protobuf's JSON encoding.
+// A response that carries an RPC error is converted into a JSON-RPC error instead
+func formatJSONRPCResult(responseMessage appmessage.Message) (json.RawMessage, *jsonRPCError) {
+	coinsecdMessage, err := protowire.FromAppMessage(responseMessage)
+	if err != nil {
+		return nil, &jsonRPCError{Code: jsonRPCInternalErrorCode, Message: err.Error()}
+	}
+	coinsecdMessageReflection := coinsecdMessage.ProtoReflect()
+	responsePayload := coinsecdMessageReflection.Get(coinsecdMessageReflection.WhichOneof(payloadOneof)).Message()
+
+	errorField := responsePayload.Descriptor().Fields().ByName("error")
+	if errorField != nil && responsePayload.Has(errorField) {
+		rpcError := responsePayload.Get(errorField).Message().Interface().(*protowire.RPCError)
+		return nil, newJSONRPCErrorFromRPCError(rpcError)
+	}
+
+	result, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(responsePayload.Interface())
+	if err != nil {
+		return nil, &jsonRPCError{Code: jsonRPCInternalErrorCode, Message: err.Error()}
+	}
+	return result, nil
+}
+
+func newJSONRPCErrorFromRPCError(rpcError *protowire.RPCError) *jsonRPCError {
+	code, ok := jsonRPCErrorCodes[rpcError.Code]
+	if !ok {
+		code = jsonRPCServerErrorCode
+	}
+	jsonError := &jsonRPCError{Code: code, Message: rpcError.Message}
+	if rpcError.Code != protowire.RPCError_UNSPECIFIED {
+		data, err := protojson.Marshal(rpcError)
+		if err == nil {
+			jsonError.Data = data
+		}
+	}
+	return jsonError
+}
+
+func newJSONRPCErrorResponse(id json.RawMessage, code int, message string) *jsonRPCResponse {
+	return &jsonRPCResponse{
+		JSONRPC: jsonRPCVersion,
+		Error:   &jsonRPCError{Code: code, Message: message},
+		ID:      id,
+	}
+}
@@ -0,0 +1,227 @@
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/app/rpc/rpccontext"
+	"github.com/wombatlabs/coinsecd/infrastructure/config"
+)
+
+func postJSONRPC(server *jsonRPCServer, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func decodeJSONRPCResponse(t *testing.T, recorder *httptest.ResponseRecorder) *jsonRPCResponse {
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Unexpected status code. Want: %d, got: %d", http.StatusOK, recorder.Code)
+	}
+	var response jsonRPCResponse
+	err := json.Unmarshal(recorder.Body.Bytes(), &response)
+	if err != nil {
+		t.Fatalf("Error decoding response %s: %s", recorder.Body, err)
+	}
+	return &response
+}
+
+func TestJSONRPCServer(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.SafeRPC = true
+	server := newJSONRPCServer(&rpccontext.Context{Config: cfg}, nil, 1, nil)
+
+	// A request that's handled successfully
+	response := decodeJSONRPCResponse(t, postJSONRPC(server,
+		`{"jsonrpc": "2.0", "method": "GetCurrentNetwork", "id": 1}`))
+	if response.Error != nil {
+		t.Fatalf("Unexpected error: %s", response.Error.Message)
+	}
+	if string(response.ID) != "1" {
+		t.Fatalf("Unexpected ID. Want: 1, got: %s", response.ID)
+	}
+	var result struct {
+		CurrentNetwork string `json:"currentNetwork"`
+	}
+	err := json.Unmarshal(response.Result, &result)
+	if err != nil {
+		t.Fatalf("Error decoding result %s: %s", response.Result, err)
+	}
+	if result.CurrentNetwork != cfg.ActiveNetParams.Net.String() {
+		t.Fatalf("Unexpected network. Want: %s, got: %s", cfg.ActiveNetParams.Net, result.CurrentNetwork)
+	}
+
+	tests := []struct {
+		name         string
+		body         string
+		expectedCode int
+	}{
+		{
+			name:         "RPC error in the response",
+			body:         `{"jsonrpc": "2.0", "method": "ShutDown", "id": "a"}`,
+			expectedCode: jsonRPCServerErrorCode,
+		},
+		{
+			name:         "notification method",
+			body:         `{"jsonrpc": "2.0", "method": "NotifyBlockAdded", "id": "a"}`,
+			expectedCode: jsonRPCMethodNotFoundCode,
+		},
+		{
+			name:         "unknown method",
+			body:         `{"jsonrpc": "2.0", "method": "GetNothing", "id": "a"}`,
+			expectedCode: jsonRPCMethodNotFoundCode,
+		},
+		{
+			name:         "positional params",
+			body:         `{"jsonrpc": "2.0", "method": "GetBlock", "params": ["a"], "id": "a"}`,
+			expectedCode: jsonRPCInvalidParamsCode,
+		},
+		{
+			name:         "unknown param",
+			body:         `{"jsonrpc": "2.0", "method": "GetBlock", "params": {"nothing": 1}, "id": "a"}`,
+			expectedCode: jsonRPCInvalidParamsCode,
+		},
+		{
+			name:         "missing version",
+			body:         `{"method": "GetCurrentNetwork", "id": "a"}`,
+			expectedCode: jsonRPCInvalidRequestCode,
+		},
+		{
+			name:         "malformed JSON",
+			body:         `{"jsonrpc": "2.0", "method": `,
+			expectedCode: jsonRPCParseErrorCode,
+		},
+	}
+	for _, test := range tests {
+		response := decodeJSONRPCResponse(t, postJSONRPC(server, test.body))
+		if response.Error == nil {
+			t.Fatalf("%s: expected an error, got result %s", test.name, response.Result)
+		}
+		if response.Error.Code != test.expectedCode {
+			t.Fatalf("%s: unexpected error code. Want: %d, got: %d (%s)",
+				test.name, test.expectedCode, response.Error.Code, response.Error.Message)
+		}
+	}
+}
+
+func TestJSONRPCServerBatch(t *testing.T) {
+	server := newJSONRPCServer(&rpccontext.Context{Config: config.DefaultConfig()}, nil, 0, nil)
+
+	recorder := postJSONRPC(server, `[
+		{"jsonrpc": "2.0", "method": "GetCurrentNetwork", "id": 1},
+		{"jsonrpc": "2.0", "method": "GetCurrentNetwork"},
+		{"jsonrpc": "2.0", "method": "GetNothing", "id": 2}
+	]`)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Unexpected status code. Want: %d, got: %d", http.StatusOK, recorder.Code)
+	}
+	var responses []*jsonRPCResponse
+	err := json.Unmarshal(recorder.Body.Bytes(), &responses)
+	if err != nil {
+		t.Fatalf("Error decoding responses %s: %s", recorder.Body, err)
+	}
+	if len(responses) != 2 {
+		t.Fatalf("Unexpected number of responses. Want: 2, got: %d", len(responses))
+	}
+	if string(responses[0].ID) != "1" || responses[0].Error != nil {
+		t.Fatalf("Unexpected first response: %s", recorder.Body)
+	}
+	if string(responses[1].ID) != "2" || responses[1].Error == nil {
+		t.Fatalf("Unexpected second response: %s", recorder.Body)
+	}
+
+	// A batch of notifications only is not answered
+	recorder = postJSONRPC(server, `[{"jsonrpc": "2.0", "method": "GetCurrentNetwork"}]`)
+	if recorder.Code != http.StatusNoContent {
+		t.Fatalf("Unexpected status code. Want: %d, got: %d", http.StatusNoContent, recorder.Code)
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("Unexpected status code. Want: %d, got: %d", http.StatusMethodNotAllowed, recorder.Code)
+	}
+}
+
+func TestJSONRPCServerCrossOrigin(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.JSONRPCAllowedOrigins = []string{"https://explorer.example.com"}
+	server := newJSONRPCServer(&rpccontext.Context{Config: cfg}, nil, 0, nil)
+	const body = `{"jsonrpc": "2.0", "method": "GetCurrentNetwork", "id": 1}`
+
+	tests := []struct {
+		name         string
+		method       string
+		contentType  string
+		origin       string
+		expectedCode int
+	}{
+		{
+			name:         "plain text body",
+			method:       http.MethodPost,
+			contentType:  "text/plain",
+			expectedCode: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:         "no content type",
+			method:       http.MethodPost,
+			expectedCode: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:         "JSON body with a charset",
+			method:       http.MethodPost,
+			contentType:  "application/json; charset=utf-8",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "disallowed origin",
+			method:       http.MethodPost,
+			contentType:  "application/json",
+			origin:       "https://attacker.example.com",
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "preflight of a disallowed origin",
+			method:       http.MethodOptions,
+			origin:       "https://attacker.example.com",
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "preflight of an allowed origin",
+			method:       http.MethodOptions,
+			origin:       "https://explorer.example.com",
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name:         "allowed origin",
+			method:       http.MethodPost,
+			contentType:  "application/json",
+			origin:       "https://explorer.example.com",
+			expectedCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		request := httptest.NewRequest(test.method, "/", strings.NewReader(body))
+		if test.contentType != "" {
+			request.Header.Set("Content-Type", test.contentType)
+		}
+		if test.origin != "" {
+			request.Header.Set("Origin", test.origin)
+		}
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, request)
+		if recorder.Code != test.expectedCode {
+			t.Fatalf("%s: unexpected status code. Want: %d, got: %d", test.name, test.expectedCode, recorder.Code)
+		}
+		if test.origin != "" && test.expectedCode != http.StatusForbidden &&
+			recorder.Header().Get("Access-Control-Allow-Origin") != test.origin {
+			t.Fatalf("%s: expected the origin to be allowed in the response headers", test.name)
+		}
+	}
+}
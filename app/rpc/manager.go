@@ -1,23 +1,31 @@
 package rpc
 
 import (
+	"crypto/tls"
+
 	"github.com/wombatlabs/coinsecd/app/appmessage"
 	"github.com/wombatlabs/coinsecd/app/protocol"
 	"github.com/wombatlabs/coinsecd/app/rpc/rpccontext"
 	"github.com/wombatlabs/coinsecd/domain"
+	"github.com/wombatlabs/coinsecd/domain/addresshistoryindex"
 	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	miningmanagermodel "github.com/wombatlabs/coinsecd/domain/miningmanager/model"
+	"github.com/wombatlabs/coinsecd/domain/txindex"
 	"github.com/wombatlabs/coinsecd/domain/utxoindex"
 	"github.com/wombatlabs/coinsecd/infrastructure/config"
+	infrastructuredatabase "github.com/wombatlabs/coinsecd/infrastructure/db/database"
 	"github.com/wombatlabs/coinsecd/infrastructure/logger"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/addressmanager"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/connmanager"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server/grpcserver"
 	"github.com/pkg/errors"
 )
 
 // Manager is an RPC manager
 type Manager struct {
-	context *rpccontext.Context
+	context       *rpccontext.Context
+	jsonRPCServer *jsonRPCServer
 }
 
 // NewManager creates a new RPC Manager
@@ -29,6 +37,10 @@ func NewManager(
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	txIndex *txindex.TXIndex,
+	addressHistoryIndex *addresshistoryindex.AddressHistoryIndex,
+	database infrastructuredatabase.Database,
+	databaseVersion int,
 	consensusEventsChan chan externalapi.ConsensusEvent,
 	shutDownChan chan<- struct{}) *Manager {
 
@@ -41,16 +53,49 @@ func NewManager(
 			connectionManager,
 			addressManager,
 			utxoIndex,
+			txIndex,
+			addressHistoryIndex,
+			database,
+			databaseVersion,
 			shutDownChan,
 		),
 	}
 	netAdapter.SetRPCRouterInitializer(manager.routerInitializer)
+	if len(cfg.JSONRPCListeners) > 0 {
+		manager.jsonRPCServer = newJSONRPCServer(manager.context, cfg.JSONRPCListeners, cfg.RPCMaxConcurrentReqs,
+			netAdapter.RPCAuthenticator())
+	}
 
 	manager.initConsensusEventsHandler(consensusEventsChan)
 
 	return &manager
 }
 
+// Start starts the RPC services that aren't served by the net adapter
+func (m *Manager) Start() error {
+	if m.jsonRPCServer == nil {
+		return nil
+	}
+	var tlsConfig *tls.Config
+	if m.context.Config.RPCTLS {
+		var err error
+		tlsConfig, err = grpcserver.NewRPCServerTLSConfig(m.context.Config.RPCCert, m.context.Config.RPCKey,
+			m.context.Config.RPCClientCA)
+		if err != nil {
+			return err
+		}
+	}
+	return m.jsonRPCServer.start(tlsConfig)
+}
+
+// Stop stops the RPC services that aren't served by the net adapter
+func (m *Manager) Stop() error {
+	if m.jsonRPCServer == nil {
+		return nil
+	}
+	return m.jsonRPCServer.stop()
+}
+
 func (m *Manager) initConsensusEventsHandler(consensusEventsChan chan externalapi.ConsensusEvent) {
 	spawn("consensusEventsHandler", func() {
 		for {
@@ -113,6 +158,20 @@ func (m *Manager) notifyVirtualChange(virtualChangeSet *externalapi.VirtualChang
 		}
 	}
 
+	if m.context.Config.TXIndex {
+		err := m.updateTXIndex(virtualChangeSet)
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.context.Config.AddressHistoryIndex {
+		err := m.updateAddressHistoryIndex(virtualChangeSet)
+		if err != nil {
+			return err
+		}
+	}
+
 	err := m.notifyVirtualSelectedParentBlueScoreChanged(virtualChangeSet.VirtualSelectedParentBlueScore)
 	if err != nil {
 		return err
@@ -145,6 +204,24 @@ func (m *Manager) NotifyNewBlockTemplate() error {
 	return m.context.NotificationManager.NotifyNewBlockTemplate(notification)
 }
 
+// NotifyMempoolChanged notifies the manager that transactions have been
+// added to or removed from the mempool
+func (m *Manager) NotifyMempoolChanged(changes []*miningmanagermodel.MempoolChange) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.NotifyMempoolChanged")
+	defer onEnd()
+
+	// Converting the transactions is expensive, so it's skipped when nobody listens
+	if !m.context.NotificationManager.HasMempoolChangedListeners() {
+		return nil
+	}
+
+	rpcChanges, err := m.context.ConvertMempoolChangesToRPCMempoolChanges(changes)
+	if err != nil {
+		return err
+	}
+	return m.context.NotificationManager.NotifyMempoolChanged(changes, rpcChanges)
+}
+
 // NotifyPruningPointUTXOSetOverride notifies the manager whenever the UTXO index
 // resets due to pruning point change via IBD.
 func (m *Manager) NotifyPruningPointUTXOSetOverride() error {
@@ -158,6 +235,20 @@ func (m *Manager) NotifyPruningPointUTXOSetOverride() error {
 		}
 	}
 
+	if m.context.Config.TXIndex {
+		err := m.context.TXIndex.Reset()
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.context.Config.AddressHistoryIndex {
+		err := m.context.AddressHistoryIndex.Reset()
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -191,6 +282,20 @@ func (m *Manager) notifyUTXOsChanged(virtualChangeSet *externalapi.VirtualChange
 	return m.context.NotificationManager.NotifyUTXOsChanged(utxoIndexChanges)
 }
 
+func (m *Manager) updateTXIndex(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.updateTXIndex")
+	defer onEnd()
+
+	return m.context.TXIndex.Update(virtualChangeSet)
+}
+
+func (m *Manager) updateAddressHistoryIndex(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.updateAddressHistoryIndex")
+	defer onEnd()
+
+	return m.context.AddressHistoryIndex.Update(virtualChangeSet)
+}
+
 func (m *Manager) notifyPruningPointUTXOSetOverride() error {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.notifyPruningPointUTXOSetOverride")
 	defer onEnd()
@@ -234,5 +339,6 @@ func (m *Manager) notifyVirtualSelectedParentChainChanged(virtualChangeSet *exte
 		return m.context.NotificationManager.NotifyVirtualSelectedParentChainChanged(notification)
 	}
 
+	m.context.NotificationManager.SkipVirtualSelectedParentChainChanged()
 	return nil
 }
@@ -0,0 +1,27 @@
+package rpc
+
+import (
+	"time"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/infrastructure/metrics"
+)
+
+var (
+	rpcRequestCount = metrics.NewCounterVec("coinsecd_rpc_requests_total",
+		"Number of RPC requests handled, per command", "command")
+	rpcRequestDuration = metrics.NewHistogramVec("coinsecd_rpc_request_duration_seconds",
+		"Time it took to handle RPC requests, per command", metrics.DefaultBuckets, "command")
+)
+
+func init() {
+	metrics.Register(rpcRequestCount, rpcRequestDuration)
+}
+
+// observeRPCRequest records a request of the given command whose handling started at start.
+// Requests that are rejected before reaching their handler are not recorded
+func observeRPCRequest(command appmessage.MessageCommand, start time.Time) {
+	method := rpcMethod(command)
+	rpcRequestCount.Inc(method)
+	rpcRequestDuration.Observe(time.Since(start).Seconds(), method)
+}
@@ -0,0 +1,41 @@
+package rpc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/app/rpc/rpccontext"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcauth"
+)
+
+// rateLimitClient returns the key that the rate limit of a client is kept under. Clients
+// that authenticated share the limit of their token, no matter where they connect from
+func rateLimitClient(identity *rpcauth.Identity, remoteIP string) string {
+	if identity != nil {
+		return "token:" + identity.Name
+	}
+	return "ip:" + remoteIP
+}
+
+// checkRateLimit returns nil if the given client may send a request with the given
+// command, and otherwise returns the error to reject it with
+func checkRateLimit(context *rpccontext.Context, client string, command appmessage.MessageCommand) *appmessage.RPCError {
+	if context.RateLimiter == nil {
+		return nil
+	}
+	retryAfter, ok := context.RateLimiter.Allow(client, command)
+	if ok {
+		return nil
+	}
+
+	// Round up, so that clients that wait retryAfterMilliseconds are allowed
+	retryAfterMilliseconds := uint64((retryAfter + time.Millisecond - 1) / time.Millisecond)
+	method := rpcMethod(command)
+	log.Debugf("RPC client %s exceeded its rate limit with %s", client, method)
+	return &appmessage.RPCError{
+		Message:                fmt.Sprintf("rate limit exceeded: %s may retry %s in %dms", client, method, retryAfterMilliseconds),
+		Code:                   appmessage.RPCErrorCodeRateLimited,
+		RetryAfterMilliseconds: retryAfterMilliseconds,
+	}
+}
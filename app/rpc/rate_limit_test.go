@@ -0,0 +1,79 @@
+package rpc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/app/rpc/rpccontext"
+	"github.com/wombatlabs/coinsecd/infrastructure/config"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestJSONRPCServerRateLimit(t *testing.T) {
+	// The rate is low enough for no weight to be regained during the test
+	context := &rpccontext.Context{
+		Config:      config.DefaultConfig(),
+		RateLimiter: rpccontext.NewRateLimiter(0.001, 2, nil),
+	}
+	server := newJSONRPCServer(context, nil, 0, nil)
+
+	const getCurrentNetwork = `{"jsonrpc": "2.0", "method": "GetCurrentNetwork", "id": 1}`
+	for i := 0; i < 2; i++ {
+		response := decodeJSONRPCResponse(t, postJSONRPC(server, getCurrentNetwork))
+		if response.Error != nil {
+			t.Fatalf("Unexpected error in request #%d: %s", i, response.Error.Message)
+		}
+	}
+
+	response := decodeJSONRPCResponse(t, postJSONRPC(server, getCurrentNetwork))
+	if response.Error == nil || response.Error.Code != jsonRPCRateLimitedCode {
+		t.Fatalf("Expected the request to be rate limited, got: %v", response.Error)
+	}
+	if !strings.Contains(response.Error.Message, "rate limit exceeded") {
+		t.Fatalf("Unexpected error message: %s", response.Error.Message)
+	}
+	rpcError := &protowire.RPCError{}
+	err := protojson.Unmarshal(response.Error.Data, rpcError)
+	if err != nil {
+		t.Fatalf("Error decoding the error data %s: %s", response.Error.Data, err)
+	}
+	if rpcError.Code != protowire.RPCError_RATE_LIMITED || rpcError.RetryAfterMilliseconds == 0 {
+		t.Fatalf("Unexpected error data: %s", response.Error.Data)
+	}
+
+	usage := context.RateLimiter.Usage()
+	if len(usage) != 1 || usage[0].Client != "ip:192.0.2.1" {
+		t.Fatalf("Expected the client to be identified by its IP, got: %v", usage)
+	}
+	if usage[0].RequestCount != 2 || usage[0].RejectedRequestCount != 1 {
+		t.Fatalf("Unexpected usage: %+v", usage[0])
+	}
+}
+
+func TestRateLimitResponse(t *testing.T) {
+	context := &rpccontext.Context{RateLimiter: rpccontext.NewRateLimiter(1, 1, nil)}
+	request := appmessage.NewGetBlockRequestMessage("", false)
+
+	if rpcError := checkRateLimit(context, "ip:127.0.0.1", request.Command()); rpcError != nil {
+		t.Fatalf("Unexpected rejection: %s", rpcError)
+	}
+	rpcError := checkRateLimit(context, "ip:127.0.0.1", request.Command())
+	if rpcError == nil {
+		t.Fatalf("Expected the request to be rejected")
+	}
+
+	response, err := newErrorResponse(request, rpcError)
+	if err != nil {
+		t.Fatalf("newErrorResponse: %s", err)
+	}
+	getBlockResponse, ok := response.(*appmessage.GetBlockResponseMessage)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", response)
+	}
+	if getBlockResponse.Error.Code != appmessage.RPCErrorCodeRateLimited ||
+		getBlockResponse.Error.RetryAfterMilliseconds != rpcError.RetryAfterMilliseconds {
+		t.Fatalf("The response doesn't carry the rate limit error: %+v", getBlockResponse.Error)
+	}
+}
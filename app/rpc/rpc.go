@@ -1,6 +1,8 @@
 package rpc
 
 import (
+	"time"
+
 	"github.com/wombatlabs/coinsecd/app/appmessage"
 	"github.com/wombatlabs/coinsecd/app/rpc/rpccontext"
 	"github.com/wombatlabs/coinsecd/app/rpc/rpchandlers"
@@ -51,6 +53,15 @@ var handlers = map[appmessage.MessageCommand]handler{
 	appmessage.CmdNotifyNewBlockTemplateRequestMessage:                      rpchandlers.HandleNotifyNewBlockTemplate,
 	appmessage.CmdGetCoinSupplyRequestMessage:                               rpchandlers.HandleGetCoinSupply,
 	appmessage.CmdGetMempoolEntriesByAddressesRequestMessage:                rpchandlers.HandleGetMempoolEntriesByAddresses,
+	appmessage.CmdGetTransactionRequestMessage:                              rpchandlers.HandleGetTransaction,
+	appmessage.CmdGetAddressHistoryRequestMessage:                           rpchandlers.HandleGetAddressHistory,
+	appmessage.CmdSubmitTransactionReplacementRequestMessage:                rpchandlers.HandleSubmitTransactionReplacement,
+	appmessage.CmdGetFeeEstimateRequestMessage:                              rpchandlers.HandleGetFeeEstimate,
+	appmessage.CmdSubmitTransactionPackageRequestMessage:                    rpchandlers.HandleSubmitTransactionPackage,
+	appmessage.CmdGetMempoolInfoRequestMessage:                              rpchandlers.HandleGetMempoolInfo,
+	appmessage.CmdNotifyMempoolChangedRequestMessage:                        rpchandlers.HandleNotifyMempoolChanged,
+	appmessage.CmdGetRPCUsageRequestMessage:                                 rpchandlers.HandleGetRPCUsage,
+	appmessage.CmdBackupDatabaseRequestMessage:                              rpchandlers.HandleBackupDatabase,
 }
 
 func (m *Manager) routerInitializer(router *router.Router, netConnection *netadapter.NetConnection) {
@@ -67,12 +78,16 @@ func (m *Manager) routerInitializer(router *router.Router, netConnection *netada
 	spawn("routerInitializer-handleIncomingMessages", func() {
 		defer m.context.NotificationManager.RemoveListener(router)
 
-		err := m.handleIncomingMessages(router, incomingRoute)
+		err := m.handleIncomingMessages(router, incomingRoute, netConnection)
 		m.handleError(err, netConnection)
 	})
 }
 
-func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *router.Route) error {
+func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *router.Route,
+	netConnection *netadapter.NetConnection) error {
+
+	identity := netConnection.Identity()
+	client := rateLimitClient(identity, netConnection.NetAddress().IP.String())
 	outgoingRoute := router.OutgoingRoute()
 	for {
 		request, err := incomingRoute.Dequeue()
@@ -83,10 +98,27 @@ func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *r
 		if !ok {
 			return err
 		}
-		response, err := handler(m.context, router, request)
+		response, err := authorize(identity, request)
 		if err != nil {
 			return err
 		}
+		if response == nil {
+			if rpcError := checkRateLimit(m.context, client, request.Command()); rpcError != nil {
+				response, err = newErrorResponse(request, rpcError)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		// The response is only set at this point if the request was rejected
+		if response == nil {
+			start := time.Now()
+			response, err = handler(m.context, router, request)
+			if err != nil {
+				return err
+			}
+			observeRPCRequest(request.Command(), start)
+		}
 		err = outgoingRoute.Enqueue(response)
 		if err != nil {
 			return err
@@ -3,8 +3,11 @@ package rpccontext
 import (
 	"github.com/wombatlabs/coinsecd/app/protocol"
 	"github.com/wombatlabs/coinsecd/domain"
+	"github.com/wombatlabs/coinsecd/domain/addresshistoryindex"
+	"github.com/wombatlabs/coinsecd/domain/txindex"
 	"github.com/wombatlabs/coinsecd/domain/utxoindex"
 	"github.com/wombatlabs/coinsecd/infrastructure/config"
+	infrastructuredatabase "github.com/wombatlabs/coinsecd/infrastructure/db/database"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/addressmanager"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/connmanager"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter"
@@ -12,16 +15,23 @@ import (
 
 // Context represents the RPC context
 type Context struct {
-	Config            *config.Config
-	NetAdapter        *netadapter.NetAdapter
-	Domain            domain.Domain
-	ProtocolManager   *protocol.Manager
-	ConnectionManager *connmanager.ConnectionManager
-	AddressManager    *addressmanager.AddressManager
-	UTXOIndex         *utxoindex.UTXOIndex
-	ShutDownChan      chan<- struct{}
+	Config              *config.Config
+	NetAdapter          *netadapter.NetAdapter
+	Domain              domain.Domain
+	ProtocolManager     *protocol.Manager
+	ConnectionManager   *connmanager.ConnectionManager
+	AddressManager      *addressmanager.AddressManager
+	UTXOIndex           *utxoindex.UTXOIndex
+	TXIndex             *txindex.TXIndex
+	AddressHistoryIndex *addresshistoryindex.AddressHistoryIndex
+	Database            infrastructuredatabase.Database
+	DatabaseVersion     int
+	ShutDownChan        chan<- struct{}
 
 	NotificationManager *NotificationManager
+
+	// RateLimiter is nil if RPC rate limiting is disabled
+	RateLimiter *RateLimiter
 }
 
 // NewContext creates a new RPC context
@@ -32,19 +42,30 @@ func NewContext(cfg *config.Config,
 	connectionManager *connmanager.ConnectionManager,
 	addressManager *addressmanager.AddressManager,
 	utxoIndex *utxoindex.UTXOIndex,
+	txIndex *txindex.TXIndex,
+	addressHistoryIndex *addresshistoryindex.AddressHistoryIndex,
+	database infrastructuredatabase.Database,
+	databaseVersion int,
 	shutDownChan chan<- struct{}) *Context {
 
 	context := &Context{
-		Config:            cfg,
-		NetAdapter:        netAdapter,
-		Domain:            domain,
-		ProtocolManager:   protocolManager,
-		ConnectionManager: connectionManager,
-		AddressManager:    addressManager,
-		UTXOIndex:         utxoIndex,
-		ShutDownChan:      shutDownChan,
+		Config:              cfg,
+		NetAdapter:          netAdapter,
+		Domain:              domain,
+		ProtocolManager:     protocolManager,
+		ConnectionManager:   connectionManager,
+		AddressManager:      addressManager,
+		UTXOIndex:           utxoIndex,
+		TXIndex:             txIndex,
+		AddressHistoryIndex: addressHistoryIndex,
+		Database:            database,
+		DatabaseVersion:     databaseVersion,
+		ShutDownChan:        shutDownChan,
+	}
+	context.NotificationManager = NewNotificationManager(cfg.ActiveNetParams, cfg.RPCNotificationReplaySize)
+	if cfg.RPCRateLimit > 0 {
+		context.RateLimiter = NewRateLimiter(cfg.RPCRateLimit, cfg.RPCRateBurst, cfg.RPCRateLimitWeightOverrides)
 	}
-	context.NotificationManager = NewNotificationManager(cfg.ActiveNetParams)
 
 	return context
 }
@@ -0,0 +1,37 @@
+package rpccontext
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	miningmanagermodel "github.com/wombatlabs/coinsecd/domain/miningmanager/model"
+)
+
+var mempoolChangeReasonToRPCMempoolChangeReason = map[miningmanagermodel.MempoolChangeReason]appmessage.MempoolChangeReason{
+	miningmanagermodel.MempoolChangeReasonAdded:       appmessage.MempoolChangeReasonAdded,
+	miningmanagermodel.MempoolChangeReasonAccepted:    appmessage.MempoolChangeReasonAccepted,
+	miningmanagermodel.MempoolChangeReasonDoubleSpent: appmessage.MempoolChangeReasonDoubleSpent,
+	miningmanagermodel.MempoolChangeReasonReplaced:    appmessage.MempoolChangeReasonReplaced,
+	miningmanagermodel.MempoolChangeReasonExpired:     appmessage.MempoolChangeReasonExpired,
+	miningmanagermodel.MempoolChangeReasonEvicted:     appmessage.MempoolChangeReasonEvicted,
+	miningmanagermodel.MempoolChangeReasonInvalidated: appmessage.MempoolChangeReasonInvalidated,
+}
+
+// ConvertMempoolChangesToRPCMempoolChanges converts the given mempool changes
+// to their RPC representation
+func (ctx *Context) ConvertMempoolChangesToRPCMempoolChanges(changes []*miningmanagermodel.MempoolChange) (
+	[]*appmessage.MempoolChange, error) {
+
+	rpcChanges := make([]*appmessage.MempoolChange, len(changes))
+	for i, change := range changes {
+		rpcTransaction := appmessage.DomainTransactionToRPCTransaction(change.Transaction)
+		err := ctx.PopulateTransactionWithVerboseData(rpcTransaction, nil)
+		if err != nil {
+			return nil, err
+		}
+		rpcChanges[i] = &appmessage.MempoolChange{
+			TransactionID: change.TransactionID.String(),
+			Transaction:   rpcTransaction,
+			Reason:        mempoolChangeReasonToRPCMempoolChangeReason[change.Reason],
+		}
+	}
+	return rpcChanges, nil
+}
@@ -0,0 +1,196 @@
+package rpccontext
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/domain/utxoindex"
+)
+
+// notificationReplayBuffer assigns sequence numbers to the notifications of a single type,
+// and keeps the latest of them so that a client that reconnects can get the ones it missed.
+// Notifications are only kept while there are listeners, or for a while after the last one
+// unsubscribed, since otherwise there's nobody who could resume from them
+type notificationReplayBuffer struct {
+	capacity     int
+	lastSequence uint64
+
+	// notifications is a ring of the kept notifications, the oldest of which is at
+	// index start. The newest of them has the sequence lastSequence
+	notifications []interface{}
+	start         int
+	count         int
+
+	// keepUntilSequence is the sequence up to which notifications are kept even if no
+	// listener is subscribed, so that the last listener that unsubscribed can resume
+	keepUntilSequence uint64
+}
+
+func newNotificationReplayBuffer(capacity int) *notificationReplayBuffer {
+	return &notificationReplayBuffer{
+		capacity:      capacity,
+		lastSequence:  0,
+		notifications: make([]interface{}, capacity),
+	}
+}
+
+// add assigns the next sequence to the given notification and keeps it, dropping
+// the oldest notification if the buffer is full
+func (b *notificationReplayBuffer) add(notification interface{}) uint64 {
+	b.lastSequence++
+	if b.capacity == 0 {
+		return b.lastSequence
+	}
+
+	if b.count == b.capacity {
+		b.notifications[b.start] = notification
+		b.start = (b.start + 1) % b.capacity
+		return b.lastSequence
+	}
+	b.notifications[(b.start+b.count)%b.capacity] = notification
+	b.count++
+	return b.lastSequence
+}
+
+// skip assigns the next sequence to a notification that isn't kept, because no listener
+// needs it. The kept notifications are dropped too, since without the skipped one they
+// no longer lead to the node's state, so resuming from any sequence assigned so far fails
+func (b *notificationReplayBuffer) skip() {
+	b.lastSequence++
+	b.clear()
+}
+
+// since returns the notifications that came after the notification with the given
+// sequence, oldest first. ok is false if some of them were already dropped, or if the
+// sequence was never assigned, which happens when the node restarted since
+func (b *notificationReplayBuffer) since(sequence uint64) (notifications []interface{}, ok bool) {
+	if sequence > b.lastSequence {
+		return nil, false
+	}
+	missedCount := b.lastSequence - sequence
+	if missedCount > uint64(b.count) {
+		return nil, false
+	}
+	notifications = make([]interface{}, missedCount)
+	for i := range notifications {
+		notifications[i] = b.notifications[(b.start+b.count-int(missedCount)+i)%b.capacity]
+	}
+	return notifications, true
+}
+
+// clear drops all the kept notifications, so that resuming from any sequence
+// assigned before the latest one fails. It's used when the notifications no
+// longer describe the node's state
+func (b *notificationReplayBuffer) clear() {
+	for ; b.count > 0; b.count-- {
+		b.notifications[b.start] = nil
+		b.start = (b.start + 1) % b.capacity
+	}
+	b.start = 0
+}
+
+// keepForResume keeps the next notifications, up to as many as the buffer holds, even if
+// no listener is subscribed. It's called when a listener unsubscribes, since after that
+// many notifications it would miss some of them anyway
+func (b *notificationReplayBuffer) keepForResume() {
+	b.keepUntilSequence = b.lastSequence + uint64(b.capacity)
+}
+
+// isKeepingForResume returns whether notifications are kept for a listener that
+// unsubscribed, regardless of whether any listener is subscribed
+func (b *notificationReplayBuffer) isKeepingForResume() bool {
+	return b.lastSequence < b.keepUntilSequence
+}
+
+// LatestUTXOsChangedSequence returns the sequence of the latest UTXOs changed notification
+func (nm *NotificationManager) LatestUTXOsChangedSequence() uint64 {
+	nm.RLock()
+	defer nm.RUnlock()
+
+	return nm.utxosChangedReplayBuffer.lastSequence
+}
+
+// ResumeUTXOsChangedNotifications instructs the listener to send UTXOs changed notifications to
+// the remote listener for the given addresses, same as PropagateUTXOsChangedNotifications.
+// It returns the notifications for the listener's addresses that came after resumeAfterSequence,
+// along with the sequence of the latest notification. isGap is true if some of the notifications
+// are no longer available, in which case none are returned
+func (nm *NotificationManager) ResumeUTXOsChangedNotifications(nl *NotificationListener,
+	addresses []*UTXOsChangedNotificationAddress, resumeAfterSequence uint64) (
+	latestSequence uint64, missedNotifications []*appmessage.UTXOsChangedNotificationMessage, isGap bool, err error) {
+
+	nm.Lock()
+	defer nm.Unlock()
+
+	nm.propagateUTXOsChangedNotifications(nl, addresses)
+
+	latestSequence = nm.utxosChangedReplayBuffer.lastSequence
+	keptNotifications, ok := nm.utxosChangedReplayBuffer.since(resumeAfterSequence)
+	if !ok {
+		return latestSequence, nil, true, nil
+	}
+
+	sequence := resumeAfterSequence
+	for _, keptNotification := range keptNotifications {
+		sequence++
+		notification, err := nl.convertUTXOChangesToUTXOsChangedNotification(keptNotification.(*utxoindex.UTXOChanges))
+		if err != nil {
+			return 0, nil, false, err
+		}
+		if len(notification.Added) == 0 && len(notification.Removed) == 0 {
+			continue
+		}
+		notification.Sequence = sequence
+		missedNotifications = append(missedNotifications, notification)
+	}
+	return latestSequence, missedNotifications, false, nil
+}
+
+// LatestVirtualSelectedParentChainChangedSequence returns the sequence of the latest
+// virtual selected parent chain changed notification
+func (nm *NotificationManager) LatestVirtualSelectedParentChainChangedSequence() uint64 {
+	nm.RLock()
+	defer nm.RUnlock()
+
+	return nm.virtualSelectedParentChainChangedReplayBuffer.lastSequence
+}
+
+// ResumeVirtualSelectedParentChainChangedNotifications instructs the listener to send chain changed
+// notifications to the remote listener, same as PropagateVirtualSelectedParentChainChangedNotifications.
+// It returns the notifications that came after resumeAfterSequence, along with the sequence of the
+// latest notification. isGap is true if some of the notifications are no longer available, or are
+// missing accepted transaction IDs the listener asked for, in which case none are returned
+func (nm *NotificationManager) ResumeVirtualSelectedParentChainChangedNotifications(nl *NotificationListener,
+	includeAcceptedTransactionIDs bool, resumeAfterSequence uint64) (
+	latestSequence uint64, missedNotifications []*appmessage.VirtualSelectedParentChainChangedNotificationMessage, isGap bool) {
+
+	nm.Lock()
+	defer nm.Unlock()
+
+	nl.PropagateVirtualSelectedParentChainChangedNotifications(includeAcceptedTransactionIDs)
+
+	latestSequence = nm.virtualSelectedParentChainChangedReplayBuffer.lastSequence
+	keptNotifications, ok := nm.virtualSelectedParentChainChangedReplayBuffer.since(resumeAfterSequence)
+	if !ok {
+		return latestSequence, nil, true
+	}
+
+	missedNotifications = make([]*appmessage.VirtualSelectedParentChainChangedNotificationMessage, len(keptNotifications))
+	for i, keptNotification := range keptNotifications {
+		notification := keptNotification.(*appmessage.VirtualSelectedParentChainChangedNotificationMessage)
+		if !includeAcceptedTransactionIDs {
+			notification = withoutAcceptedTransactionIDs(notification)
+		} else if notification.AcceptedTransactionIDs == nil {
+			// Nobody asked for accepted transaction IDs when this notification was created
+			return latestSequence, nil, true
+		}
+		missedNotifications[i] = notification
+	}
+	return latestSequence, missedNotifications, false
+}
+
+func withoutAcceptedTransactionIDs(notification *appmessage.VirtualSelectedParentChainChangedNotificationMessage) *appmessage.VirtualSelectedParentChainChangedNotificationMessage {
+	return &appmessage.VirtualSelectedParentChainChangedNotificationMessage{
+		RemovedChainBlockHashes: notification.RemovedChainBlockHashes,
+		AddedChainBlockHashes:   notification.AddedChainBlockHashes,
+		Sequence:                notification.Sequence,
+	}
+}
@@ -0,0 +1,171 @@
+package rpccontext
+
+import (
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/domain/dagconfig"
+	routerpkg "github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
+)
+
+func TestNotificationReplayBuffer(t *testing.T) {
+	buffer := newNotificationReplayBuffer(3)
+	for i := 1; i <= 5; i++ {
+		sequence := buffer.add(i)
+		if sequence != uint64(i) {
+			t.Fatalf("add: expected sequence %d but got %d", i, sequence)
+		}
+	}
+
+	tests := []struct {
+		sequence              uint64
+		expectedOK            bool
+		expectedNotifications []int
+	}{
+		{sequence: 0, expectedOK: false},
+		{sequence: 1, expectedOK: false},
+		{sequence: 2, expectedOK: true, expectedNotifications: []int{3, 4, 5}},
+		{sequence: 4, expectedOK: true, expectedNotifications: []int{5}},
+		{sequence: 5, expectedOK: true, expectedNotifications: []int{}},
+		{sequence: 6, expectedOK: false},
+	}
+	for _, test := range tests {
+		notifications, ok := buffer.since(test.sequence)
+		if ok != test.expectedOK {
+			t.Fatalf("since(%d): expected ok %t but got %t", test.sequence, test.expectedOK, ok)
+		}
+		if len(notifications) != len(test.expectedNotifications) {
+			t.Fatalf("since(%d): expected %d notifications but got %d",
+				test.sequence, len(test.expectedNotifications), len(notifications))
+		}
+		for i, notification := range notifications {
+			if notification.(int) != test.expectedNotifications[i] {
+				t.Fatalf("since(%d): expected notification %d to be %d but got %d",
+					test.sequence, i, test.expectedNotifications[i], notification)
+			}
+		}
+	}
+
+	buffer.clear()
+	if _, ok := buffer.since(4); ok {
+		t.Fatalf("since: expected a gap after clear")
+	}
+	if _, ok := buffer.since(5); !ok {
+		t.Fatalf("since: expected no gap when resuming from the latest sequence after clear")
+	}
+	if sequence := buffer.add(6); sequence != 6 {
+		t.Fatalf("add: expected sequence 6 after clear but got %d", sequence)
+	}
+}
+
+func TestResumeVirtualSelectedParentChainChangedNotifications(t *testing.T) {
+	notificationManager := NewNotificationManager(&dagconfig.SimnetParams, 2)
+	for i := 0; i < 3; i++ {
+		notification := appmessage.NewVirtualSelectedParentChainChangedNotificationMessage(
+			nil, []string{string(rune('a' + i))}, nil)
+		err := notificationManager.NotifyVirtualSelectedParentChainChanged(notification)
+		if err != nil {
+			t.Fatalf("NotifyVirtualSelectedParentChainChanged: %+v", err)
+		}
+	}
+
+	router := routerpkg.NewRouter("test")
+	notificationManager.AddListener(router)
+	listener, err := notificationManager.Listener(router)
+	if err != nil {
+		t.Fatalf("Listener: %+v", err)
+	}
+
+	latestSequence, missedNotifications, isGap :=
+		notificationManager.ResumeVirtualSelectedParentChainChangedNotifications(listener, false, 1)
+	if isGap {
+		t.Fatalf("ResumeVirtualSelectedParentChainChangedNotifications: unexpected gap")
+	}
+	if latestSequence != 3 {
+		t.Fatalf("ResumeVirtualSelectedParentChainChangedNotifications: expected latest sequence 3 but got %d",
+			latestSequence)
+	}
+	if len(missedNotifications) != 2 {
+		t.Fatalf("ResumeVirtualSelectedParentChainChangedNotifications: expected 2 missed notifications but got %d",
+			len(missedNotifications))
+	}
+	for i, notification := range missedNotifications {
+		expectedSequence := uint64(i + 2)
+		if notification.Sequence != expectedSequence {
+			t.Fatalf("ResumeVirtualSelectedParentChainChangedNotifications: expected notification %d to "+
+				"have sequence %d but got %d", i, expectedSequence, notification.Sequence)
+		}
+	}
+
+	_, _, isGap = notificationManager.ResumeVirtualSelectedParentChainChangedNotifications(listener, false, 0)
+	if !isGap {
+		t.Fatalf("ResumeVirtualSelectedParentChainChangedNotifications: expected a gap " +
+			"when resuming from a notification that was dropped")
+	}
+
+	_, _, isGap = notificationManager.ResumeVirtualSelectedParentChainChangedNotifications(listener, true, 1)
+	if !isGap {
+		t.Fatalf("ResumeVirtualSelectedParentChainChangedNotifications: expected a gap " +
+			"when the missed notifications lack accepted transaction IDs")
+	}
+}
+
+func TestVirtualSelectedParentChainChangedReplayWindow(t *testing.T) {
+	notificationManager := NewNotificationManager(&dagconfig.SimnetParams, 2)
+	notify := func() {
+		hasListeners, _ := notificationManager.HasListenersThatPropagateVirtualSelectedParentChainChanged()
+		if !hasListeners {
+			notificationManager.SkipVirtualSelectedParentChainChanged()
+			return
+		}
+		err := notificationManager.NotifyVirtualSelectedParentChainChanged(
+			appmessage.NewVirtualSelectedParentChainChangedNotificationMessage(nil, nil, nil))
+		if err != nil {
+			t.Fatalf("NotifyVirtualSelectedParentChainChanged: %+v", err)
+		}
+	}
+	checkListeners := func(expectedHasListeners, expectedRequireAcceptedTransactionIDs bool) {
+		hasListeners, requireAcceptedTransactionIDs :=
+			notificationManager.HasListenersThatPropagateVirtualSelectedParentChainChanged()
+		if hasListeners != expectedHasListeners || requireAcceptedTransactionIDs != expectedRequireAcceptedTransactionIDs {
+			t.Fatalf("HasListenersThatPropagateVirtualSelectedParentChainChanged: expected (%t, %t) but got (%t, %t)",
+				expectedHasListeners, expectedRequireAcceptedTransactionIDs, hasListeners, requireAcceptedTransactionIDs)
+		}
+	}
+
+	// Nobody ever subscribed, so nothing is kept
+	checkListeners(false, false)
+	notify()
+	if notificationManager.virtualSelectedParentChainChangedReplayBuffer.count != 0 {
+		t.Fatalf("Expected no notifications to be kept before any listener subscribed")
+	}
+
+	router := routerpkg.NewRouter("test")
+	notificationManager.AddListener(router)
+	listener, err := notificationManager.Listener(router)
+	if err != nil {
+		t.Fatalf("Listener: %+v", err)
+	}
+	listener.PropagateVirtualSelectedParentChainChangedNotifications(true)
+	checkListeners(true, true)
+	notify()
+	disconnectSequence := notificationManager.LatestVirtualSelectedParentChainChangedSequence()
+	notificationManager.RemoveListener(router)
+
+	// Notifications keep being created for the listener that unsubscribed, until
+	// as many were created as are kept
+	for i := 0; i < 2; i++ {
+		checkListeners(true, true)
+		notify()
+	}
+	checkListeners(false, false)
+	notify()
+
+	resumingListener := newNotificationListener(&dagconfig.SimnetParams)
+	_, _, isGap := notificationManager.ResumeVirtualSelectedParentChainChangedNotifications(
+		resumingListener, true, disconnectSequence)
+	if !isGap {
+		t.Fatalf("ResumeVirtualSelectedParentChainChangedNotifications: expected a gap " +
+			"when resuming from before skipped notifications")
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
 	"github.com/wombatlabs/coinsecd/domain/consensus/utils/txscript"
+	miningmanagermodel "github.com/wombatlabs/coinsecd/domain/miningmanager/model"
 
 	"github.com/wombatlabs/coinsecd/app/appmessage"
 	"github.com/wombatlabs/coinsecd/domain/utxoindex"
@@ -19,6 +20,13 @@ type NotificationManager struct {
 	sync.RWMutex
 	listeners map[*routerpkg.Router]*NotificationListener
 	params    *dagconfig.Params
+
+	utxosChangedReplayBuffer                      *notificationReplayBuffer
+	virtualSelectedParentChainChangedReplayBuffer *notificationReplayBuffer
+
+	// Accepted transaction IDs are included in the chain changed notifications up to this sequence,
+	// so that they're kept for a listener that wanted them and disconnected, in case it resumes
+	replayAcceptedTransactionIDsUntilSequence uint64
 }
 
 // UTXOsChangedNotificationAddress represents a coinsecd address.
@@ -44,13 +52,20 @@ type NotificationListener struct {
 
 	propagateUTXOsChangedNotificationAddresses                                    map[utxoindex.ScriptPublicKeyString]*UTXOsChangedNotificationAddress
 	includeAcceptedTransactionIDsInVirtualSelectedParentChainChangedNotifications bool
+
+	propagateMempoolChangedNotifications         bool
+	propagateMempoolChangedNotificationAddresses map[utxoindex.ScriptPublicKeyString]*UTXOsChangedNotificationAddress
 }
 
-// NewNotificationManager creates a new NotificationManager
-func NewNotificationManager(params *dagconfig.Params) *NotificationManager {
+// NewNotificationManager creates a new NotificationManager. The latest replaySize notifications
+// of every resumable type are kept for listeners that reconnect
+func NewNotificationManager(params *dagconfig.Params, replaySize int) *NotificationManager {
 	return &NotificationManager{
 		params:    params,
 		listeners: make(map[*routerpkg.Router]*NotificationListener),
+
+		utxosChangedReplayBuffer:                      newNotificationReplayBuffer(replaySize),
+		virtualSelectedParentChainChangedReplayBuffer: newNotificationReplayBuffer(replaySize),
 	}
 }
 
@@ -68,6 +83,19 @@ func (nm *NotificationManager) RemoveListener(router *routerpkg.Router) {
 	nm.Lock()
 	defer nm.Unlock()
 
+	listener, ok := nm.listeners[router]
+	if ok {
+		if listener.propagateUTXOsChangedNotifications {
+			nm.utxosChangedReplayBuffer.keepForResume()
+		}
+		if listener.propagateVirtualSelectedParentChainChangedNotifications {
+			nm.virtualSelectedParentChainChangedReplayBuffer.keepForResume()
+			if listener.includeAcceptedTransactionIDsInVirtualSelectedParentChainChangedNotifications {
+				nm.replayAcceptedTransactionIDsUntilSequence =
+					nm.virtualSelectedParentChainChangedReplayBuffer.keepUntilSequence
+			}
+		}
+	}
 	delete(nm.listeners, router)
 }
 
@@ -116,13 +144,12 @@ func (nm *NotificationManager) NotifyBlockAdded(notification *appmessage.BlockAd
 func (nm *NotificationManager) NotifyVirtualSelectedParentChainChanged(
 	notification *appmessage.VirtualSelectedParentChainChangedNotificationMessage) error {
 
-	nm.RLock()
-	defer nm.RUnlock()
+	// Apply a write-lock since the replay buffer is modified
+	nm.Lock()
+	defer nm.Unlock()
 
-	notificationWithoutAcceptedTransactionIDs := &appmessage.VirtualSelectedParentChainChangedNotificationMessage{
-		RemovedChainBlockHashes: notification.RemovedChainBlockHashes,
-		AddedChainBlockHashes:   notification.AddedChainBlockHashes,
-	}
+	notification.Sequence = nm.virtualSelectedParentChainChangedReplayBuffer.add(notification)
+	notificationWithoutAcceptedTransactionIDs := withoutAcceptedTransactionIDs(notification)
 
 	for router, listener := range nm.listeners {
 		if listener.propagateVirtualSelectedParentChainChangedNotifications {
@@ -142,16 +169,29 @@ func (nm *NotificationManager) NotifyVirtualSelectedParentChainChanged(
 	return nil
 }
 
+// SkipVirtualSelectedParentChainChanged notifies the notification manager that the DAG's selected parent
+// chain has changed while there were no listeners for it, so that listeners that resume from before the
+// change don't miss it silently
+func (nm *NotificationManager) SkipVirtualSelectedParentChainChanged() {
+	// Apply a write-lock since the replay buffer is modified
+	nm.Lock()
+	defer nm.Unlock()
+
+	nm.virtualSelectedParentChainChangedReplayBuffer.skip()
+}
+
 // HasListenersThatPropagateVirtualSelectedParentChainChanged returns whether there's any listener that is
 // subscribed to VirtualSelectedParentChainChanged notifications as well as checks if any such listener requested
 // to include AcceptedTransactionIDs.
+// Notifications are also required while they're kept for a listener that unsubscribed, since it may resume
 func (nm *NotificationManager) HasListenersThatPropagateVirtualSelectedParentChainChanged() (hasListeners, hasListenersThatRequireAcceptedTransactionIDs bool) {
 
 	nm.RLock()
 	defer nm.RUnlock()
 
-	hasListeners = false
-	hasListenersThatRequireAcceptedTransactionIDs = false
+	replayBuffer := nm.virtualSelectedParentChainChangedReplayBuffer
+	hasListeners = replayBuffer.isKeepingForResume()
+	hasListenersThatRequireAcceptedTransactionIDs = replayBuffer.lastSequence < nm.replayAcceptedTransactionIDsUntilSequence
 
 	for _, listener := range nm.listeners {
 		if listener.propagateVirtualSelectedParentChainChangedNotifications {
@@ -201,9 +241,23 @@ func (nm *NotificationManager) NotifyFinalityConflictResolved(notification *appm
 
 // NotifyUTXOsChanged notifies the notification manager that UTXOs have been changed
 func (nm *NotificationManager) NotifyUTXOsChanged(utxoChanges *utxoindex.UTXOChanges) error {
-	nm.RLock()
-	defer nm.RUnlock()
+	// Apply a write-lock since the replay buffer is modified
+	nm.Lock()
+	defer nm.Unlock()
 
+	hasListeners := nm.utxosChangedReplayBuffer.isKeepingForResume()
+	for _, listener := range nm.listeners {
+		if listener.propagateUTXOsChangedNotifications {
+			hasListeners = true
+			break
+		}
+	}
+	if !hasListeners {
+		nm.utxosChangedReplayBuffer.skip()
+		return nil
+	}
+
+	sequence := nm.utxosChangedReplayBuffer.add(utxoChanges)
 	for router, listener := range nm.listeners {
 		if listener.propagateUTXOsChangedNotifications {
 			// Filter utxoChanges and create a notification
@@ -211,6 +265,7 @@ func (nm *NotificationManager) NotifyUTXOsChanged(utxoChanges *utxoindex.UTXOCha
 			if err != nil {
 				return err
 			}
+			notification.Sequence = sequence
 
 			// Don't send the notification if it's empty
 			if len(notification.Added) == 0 && len(notification.Removed) == 0 {
@@ -227,6 +282,57 @@ func (nm *NotificationManager) NotifyUTXOsChanged(utxoChanges *utxoindex.UTXOCha
 	return nil
 }
 
+// HasMempoolChangedListeners indicates if the notification manager has any listeners for `MempoolChanged` events
+func (nm *NotificationManager) HasMempoolChangedListeners() bool {
+	nm.RLock()
+	defer nm.RUnlock()
+
+	for _, listener := range nm.listeners {
+		if listener.propagateMempoolChangedNotifications {
+			return true
+		}
+	}
+	return false
+}
+
+// NotifyMempoolChanged notifies the notification manager that transactions have been added to or
+// removed from the mempool. rpcChanges[i] is the RPC representation of changes[i]
+func (nm *NotificationManager) NotifyMempoolChanged(
+	changes []*miningmanagermodel.MempoolChange, rpcChanges []*appmessage.MempoolChange) error {
+
+	nm.RLock()
+	defer nm.RUnlock()
+
+	var changesScriptPublicKeyStrings [][]utxoindex.ScriptPublicKeyString
+	for router, listener := range nm.listeners {
+		if !listener.propagateMempoolChangedNotifications {
+			continue
+		}
+
+		notificationChanges := rpcChanges
+		if len(listener.propagateMempoolChangedNotificationAddresses) > 0 {
+			if changesScriptPublicKeyStrings == nil {
+				changesScriptPublicKeyStrings = make([][]utxoindex.ScriptPublicKeyString, len(changes))
+				for i, change := range changes {
+					changesScriptPublicKeyStrings[i] = transactionScriptPublicKeyStrings(change.Transaction)
+				}
+			}
+			notificationChanges = listener.filterMempoolChanges(rpcChanges, changesScriptPublicKeyStrings)
+		}
+
+		// Don't send the notification if it's empty
+		if len(notificationChanges) == 0 {
+			continue
+		}
+
+		err := router.OutgoingRoute().MaybeEnqueue(appmessage.NewMempoolChangedNotificationMessage(notificationChanges))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // NotifyVirtualSelectedParentBlueScoreChanged notifies the notification manager that the DAG's
 // virtual selected parent blue score has changed
 func (nm *NotificationManager) NotifyVirtualSelectedParentBlueScoreChanged(
@@ -287,8 +393,13 @@ func (nm *NotificationManager) NotifyNewBlockTemplate(
 // NotifyPruningPointUTXOSetOverride notifies the notification manager that the UTXO index
 // reset due to pruning point change via IBD.
 func (nm *NotificationManager) NotifyPruningPointUTXOSetOverride() error {
-	nm.RLock()
-	defer nm.RUnlock()
+	// Apply a write-lock since the replay buffer is modified
+	nm.Lock()
+	defer nm.Unlock()
+
+	// The UTXO index was rebuilt, so the kept UTXOs changed notifications no longer lead to its
+	// current state. Listeners that resume must resync instead
+	nm.utxosChangedReplayBuffer.clear()
 
 	for router, listener := range nm.listeners {
 		if listener.propagatePruningPointUTXOSetOverrideNotifications {
@@ -356,6 +467,10 @@ func (nm *NotificationManager) PropagateUTXOsChangedNotifications(nl *Notificati
 	nm.Lock()
 	defer nm.Unlock()
 
+	nm.propagateUTXOsChangedNotifications(nl, addresses)
+}
+
+func (nm *NotificationManager) propagateUTXOsChangedNotifications(nl *NotificationListener, addresses []*UTXOsChangedNotificationAddress) {
 	if !nl.propagateUTXOsChangedNotifications {
 		nl.propagateUTXOsChangedNotifications = true
 		nl.propagateUTXOsChangedNotificationAddresses =
@@ -460,6 +575,59 @@ func (nl *NotificationListener) scriptPubKeyStringToAddressString(scriptPublicKe
 	return addressString, nil
 }
 
+// PropagateMempoolChangedNotifications instructs the listener to send mempool changed notifications
+// to the remote listener for the transactions that spend from or pay to the given addresses. Subsequent
+// calls add the given addresses to the old ones. If no addresses were ever given, notifications are
+// sent for all transactions.
+func (nm *NotificationManager) PropagateMempoolChangedNotifications(nl *NotificationListener, addresses []*UTXOsChangedNotificationAddress) {
+	// Apply a write-lock since the internal listener address map is modified
+	nm.Lock()
+	defer nm.Unlock()
+
+	if !nl.propagateMempoolChangedNotifications {
+		nl.propagateMempoolChangedNotifications = true
+		nl.propagateMempoolChangedNotificationAddresses =
+			make(map[utxoindex.ScriptPublicKeyString]*UTXOsChangedNotificationAddress, len(addresses))
+	}
+
+	for _, address := range addresses {
+		nl.propagateMempoolChangedNotificationAddresses[address.ScriptPublicKeyString] = address
+	}
+}
+
+// filterMempoolChanges returns the changes of the transactions that spend from or pay to any of the
+// listener's addresses. changesScriptPublicKeyStrings[i] holds the script public keys of rpcChanges[i]
+func (nl *NotificationListener) filterMempoolChanges(rpcChanges []*appmessage.MempoolChange,
+	changesScriptPublicKeyStrings [][]utxoindex.ScriptPublicKeyString) []*appmessage.MempoolChange {
+
+	var filteredChanges []*appmessage.MempoolChange
+	for i, rpcChange := range rpcChanges {
+		for _, scriptPublicKeyString := range changesScriptPublicKeyStrings[i] {
+			if _, ok := nl.propagateMempoolChangedNotificationAddresses[scriptPublicKeyString]; ok {
+				filteredChanges = append(filteredChanges, rpcChange)
+				break
+			}
+		}
+	}
+	return filteredChanges
+}
+
+// transactionScriptPublicKeyStrings returns the script public keys the given transaction
+// spends from and pays to
+func transactionScriptPublicKeyStrings(transaction *externalapi.DomainTransaction) []utxoindex.ScriptPublicKeyString {
+	scriptPublicKeyStrings := make([]utxoindex.ScriptPublicKeyString, 0, len(transaction.Inputs)+len(transaction.Outputs))
+	for _, input := range transaction.Inputs {
+		if input.UTXOEntry != nil {
+			scriptPublicKeyStrings = append(scriptPublicKeyStrings,
+				utxoindex.ScriptPublicKeyString(input.UTXOEntry.ScriptPublicKey().String()))
+		}
+	}
+	for _, output := range transaction.Outputs {
+		scriptPublicKeyStrings = append(scriptPublicKeyStrings, utxoindex.ScriptPublicKeyString(output.ScriptPublicKey.String()))
+	}
+	return scriptPublicKeyStrings
+}
+
 // PropagateVirtualSelectedParentBlueScoreChangedNotifications instructs the listener to send
 // virtual selected parent blue score notifications to the remote listener
 func (nl *NotificationListener) PropagateVirtualSelectedParentBlueScoreChangedNotifications() {
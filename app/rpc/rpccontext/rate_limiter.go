@@ -0,0 +1,190 @@
+package rpccontext
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+)
+
+// defaultRequestWeights are the weights of the requests that are more expensive than
+// the rest, which weigh 1. Requests that go over the UTXO set or over many blocks weigh
+// the most
+var defaultRequestWeights = map[appmessage.MessageCommand]float64{
+	appmessage.CmdGetUTXOsByAddressesRequestMessage:                    20,
+	appmessage.CmdGetBlocksRequestMessage:                              20,
+	appmessage.CmdGetVirtualSelectedParentChainFromBlockRequestMessage: 20,
+
+	appmessage.CmdGetBalancesByAddressesRequestMessage:         10,
+	appmessage.CmdGetHeadersRequestMessage:                     10,
+	appmessage.CmdGetMempoolEntriesRequestMessage:              10,
+	appmessage.CmdGetMempoolEntriesByAddressesRequestMessage:   10,
+	appmessage.CmdGetAddressHistoryRequestMessage:              10,
+	appmessage.CmdGetCoinSupplyRequestMessage:                  10,
+	appmessage.CmdEstimateNetworkHashesPerSecondRequestMessage: 10,
+
+	appmessage.CmdGetBlockRequestMessage:            5,
+	appmessage.CmdGetBalanceByAddressRequestMessage: 5,
+	appmessage.CmdGetTransactionRequestMessage:      5,
+}
+
+// idleBucketExpiry is how long a full bucket is kept after its last request. It's
+// dropped afterwards, since a new bucket would be full as well
+const idleBucketExpiry = 10 * time.Minute
+
+// RateLimiter limits the request weight that every RPC client may use with a token
+// bucket per client. A client's bucket holds up to burst weight, and regains
+// ratePerSecond weight every second
+type RateLimiter struct {
+	ratePerSecond float64
+	burst         float64
+	weights       map[appmessage.MessageCommand]float64
+
+	mutex       sync.Mutex
+	buckets     map[string]*rateLimitBucket
+	lastPruning time.Time
+
+	// now is replaced in tests
+	now func() time.Time
+}
+
+type rateLimitBucket struct {
+	availableWeight      float64
+	lastRefill           time.Time
+	requestCount         uint64
+	rejectedRequestCount uint64
+	consumedWeight       float64
+	lastRequest          time.Time
+}
+
+// NewRateLimiter creates a new RateLimiter. weightOverrides replaces the default weights
+// of the given request commands
+func NewRateLimiter(ratePerSecond float64, burst float64,
+	weightOverrides map[appmessage.MessageCommand]float64) *RateLimiter {
+
+	weights := make(map[appmessage.MessageCommand]float64, len(defaultRequestWeights)+len(weightOverrides))
+	for command, weight := range defaultRequestWeights {
+		weights[command] = weight
+	}
+	for command, weight := range weightOverrides {
+		weights[command] = weight
+	}
+
+	return &RateLimiter{
+		ratePerSecond: ratePerSecond,
+		burst:         burst,
+		weights:       weights,
+		buckets:       make(map[string]*rateLimitBucket),
+		now:           time.Now,
+	}
+}
+
+// RatePerSecond returns the weight that every client regains per second
+func (rl *RateLimiter) RatePerSecond() float64 {
+	return rl.ratePerSecond
+}
+
+// Burst returns the most weight a client may accumulate
+func (rl *RateLimiter) Burst() float64 {
+	return rl.burst
+}
+
+// weight returns the weight of the given request command. It's capped at the
+// burst, since heavier requests could never be allowed otherwise
+func (rl *RateLimiter) weight(command appmessage.MessageCommand) float64 {
+	weight, ok := rl.weights[command]
+	if !ok {
+		weight = 1
+	}
+	if weight > rl.burst {
+		return rl.burst
+	}
+	return weight
+}
+
+// Allow consumes the weight of the given request command from the bucket of the given
+// client, and returns true if the bucket had enough of it. Otherwise, it returns false
+// along with how long the client has to wait until the request would be allowed
+func (rl *RateLimiter) Allow(client string, command appmessage.MessageCommand) (retryAfter time.Duration, ok bool) {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	now := rl.now()
+	rl.pruneIdleBuckets(now)
+
+	bucket, ok := rl.buckets[client]
+	if !ok {
+		bucket = &rateLimitBucket{availableWeight: rl.burst, lastRefill: now}
+		rl.buckets[client] = bucket
+	}
+	rl.refill(bucket, now)
+	bucket.lastRequest = now
+
+	weight := rl.weight(command)
+	if bucket.availableWeight < weight {
+		bucket.rejectedRequestCount++
+		missingWeight := weight - bucket.availableWeight
+		return time.Duration(missingWeight / rl.ratePerSecond * float64(time.Second)), false
+	}
+	bucket.availableWeight -= weight
+	bucket.requestCount++
+	bucket.consumedWeight += weight
+	return 0, true
+}
+
+func (rl *RateLimiter) refill(bucket *rateLimitBucket, now time.Time) {
+	elapsed := now.Sub(bucket.lastRefill)
+	if elapsed <= 0 {
+		return
+	}
+	bucket.availableWeight += elapsed.Seconds() * rl.ratePerSecond
+	if bucket.availableWeight > rl.burst {
+		bucket.availableWeight = rl.burst
+	}
+	bucket.lastRefill = now
+}
+
+// pruneIdleBuckets drops the buckets of the clients that haven't sent a request for
+// idleBucketExpiry, so that the number of buckets doesn't grow with every IP that
+// ever connected. It only goes over the buckets once every idleBucketExpiry
+func (rl *RateLimiter) pruneIdleBuckets(now time.Time) {
+	if now.Sub(rl.lastPruning) < idleBucketExpiry {
+		return
+	}
+	rl.lastPruning = now
+
+	for client, bucket := range rl.buckets {
+		if now.Sub(bucket.lastRequest) < idleBucketExpiry {
+			continue
+		}
+		rl.refill(bucket, now)
+		if bucket.availableWeight == rl.burst {
+			delete(rl.buckets, client)
+		}
+	}
+}
+
+// Usage returns how much of its rate limit every known client uses, ordered by client
+func (rl *RateLimiter) Usage() []*appmessage.RPCClientUsage {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	now := rl.now()
+	usage := make([]*appmessage.RPCClientUsage, 0, len(rl.buckets))
+	for client, bucket := range rl.buckets {
+		rl.refill(bucket, now)
+		usage = append(usage, &appmessage.RPCClientUsage{
+			Client:               client,
+			AvailableWeight:      bucket.availableWeight,
+			RequestCount:         bucket.requestCount,
+			RejectedRequestCount: bucket.rejectedRequestCount,
+			ConsumedWeight:       bucket.consumedWeight,
+			LastRequestTimestamp: bucket.lastRequest.UnixMilli(),
+		})
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		return usage[i].Client < usage[j].Client
+	})
+	return usage
+}
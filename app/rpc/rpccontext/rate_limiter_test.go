@@ -0,0 +1,86 @@
+package rpccontext
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(1_600_000_000, 0)
+	rateLimiter := NewRateLimiter(10, 28, map[appmessage.MessageCommand]float64{
+		appmessage.CmdGetBlockRequestMessage: 2,
+	})
+	rateLimiter.now = func() time.Time { return now }
+
+	// GetBlocks weighs 20 and GetBlock was overridden to 2, so a GetBlocks request
+	// and 4 GetBlock requests use the whole burst
+	allow := func(client string, command appmessage.MessageCommand) (time.Duration, bool) {
+		return rateLimiter.Allow(client, command)
+	}
+	if _, ok := allow("a", appmessage.CmdGetBlocksRequestMessage); !ok {
+		t.Fatalf("Expected the first GetBlocks to be allowed")
+	}
+	for i := 0; i < 4; i++ {
+		if _, ok := allow("a", appmessage.CmdGetBlockRequestMessage); !ok {
+			t.Fatalf("Expected GetBlock #%d to be allowed", i)
+		}
+	}
+	retryAfter, ok := allow("a", appmessage.CmdGetBlocksRequestMessage)
+	if ok {
+		t.Fatalf("Expected GetBlocks to be rejected once the bucket is empty")
+	}
+	if retryAfter != 2*time.Second {
+		t.Fatalf("Unexpected retryAfter. Want: %s, got: %s", 2*time.Second, retryAfter)
+	}
+
+	// Other clients have buckets of their own
+	if _, ok := allow("b", appmessage.CmdGetBlocksRequestMessage); !ok {
+		t.Fatalf("Expected another client's GetBlocks to be allowed")
+	}
+
+	// Requests that weigh the default 1 are still rejected until some weight is regained
+	if _, ok := allow("a", appmessage.CmdGetInfoRequestMessage); ok {
+		t.Fatalf("Expected GetInfo to be rejected once the bucket is empty")
+	}
+	now = now.Add(100 * time.Millisecond)
+	if _, ok := allow("a", appmessage.CmdGetInfoRequestMessage); !ok {
+		t.Fatalf("Expected GetInfo to be allowed after regaining weight")
+	}
+	now = now.Add(2 * time.Second)
+	if _, ok := allow("a", appmessage.CmdGetBlocksRequestMessage); !ok {
+		t.Fatalf("Expected GetBlocks to be allowed after regaining weight")
+	}
+
+	usage := rateLimiter.Usage()
+	if len(usage) != 2 || usage[0].Client != "a" || usage[1].Client != "b" {
+		t.Fatalf("Unexpected usage clients: %v", usage)
+	}
+	if usage[0].RequestCount != 7 || usage[0].RejectedRequestCount != 2 || usage[0].ConsumedWeight != 49 {
+		t.Fatalf("Unexpected usage of client a: %+v", usage[0])
+	}
+	if usage[0].LastRequestTimestamp != now.UnixMilli() {
+		t.Fatalf("Unexpected last request timestamp of client a: %d", usage[0].LastRequestTimestamp)
+	}
+
+	// Idle full buckets are eventually dropped
+	now = now.Add(idleBucketExpiry)
+	allow("c", appmessage.CmdGetInfoRequestMessage)
+	usage = rateLimiter.Usage()
+	if len(usage) != 1 || usage[0].Client != "c" {
+		t.Fatalf("Expected only the bucket of client c to be kept, got: %v", usage)
+	}
+}
+
+func TestRateLimiterWeightAboveBurst(t *testing.T) {
+	rateLimiter := NewRateLimiter(1, 5, nil)
+
+	// GetUtxosByAddresses weighs more than the whole burst, so it's capped at it
+	if _, ok := rateLimiter.Allow("a", appmessage.CmdGetUTXOsByAddressesRequestMessage); !ok {
+		t.Fatalf("Expected requests that weigh more than the burst to be allowed with a full bucket")
+	}
+	if _, ok := rateLimiter.Allow("a", appmessage.CmdGetInfoRequestMessage); ok {
+		t.Fatalf("Expected the bucket to be empty")
+	}
+}
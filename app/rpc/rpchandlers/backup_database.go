@@ -0,0 +1,54 @@
+package rpchandlers
+
+import (
+	"sync"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/app/dbbackup"
+	"github.com/wombatlabs/coinsecd/app/rpc/rpccontext"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
+)
+
+// backupLock makes sure that only one database backup runs at a time
+var backupLock sync.Mutex
+
+// HandleBackupDatabase handles the respectively named RPC command
+func HandleBackupDatabase(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	backupDatabaseRequest := request.(*appmessage.BackupDatabaseRequestMessage)
+
+	if context.Config.SafeRPC {
+		log.Warn("BackupDatabase RPC command called while node in safe RPC mode -- ignoring.")
+		errorMessage := &appmessage.BackupDatabaseResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("BackupDatabase RPC command called while node in safe RPC mode")
+		return errorMessage, nil
+	}
+	// The backup is written to any directory the client names on the node's machine, so
+	// it's only served to clients that authenticated with a role that may call it
+	if context.NetAdapter.RPCAuthenticator() == nil {
+		log.Warn("BackupDatabase RPC command called while RPC authentication is disabled -- ignoring.")
+		errorMessage := &appmessage.BackupDatabaseResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("BackupDatabase RPC command requires RPC authentication to be enabled")
+		return errorMessage, nil
+	}
+	if backupDatabaseRequest.TargetDirectory == "" {
+		errorMessage := &appmessage.BackupDatabaseResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("A target directory must be given")
+		return errorMessage, nil
+	}
+	if !backupLock.TryLock() {
+		errorMessage := &appmessage.BackupDatabaseResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Another database backup is already running")
+		return errorMessage, nil
+	}
+	defer backupLock.Unlock()
+
+	manifest, err := dbbackup.Backup(context.Database, context.DatabaseVersion, backupDatabaseRequest.TargetDirectory)
+	if err != nil {
+		errorMessage := &appmessage.BackupDatabaseResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not back up the database: %s", err)
+		return errorMessage, nil
+	}
+
+	return appmessage.NewBackupDatabaseResponseMessage(uint32(manifest.DatabaseVersion), manifest.ActivePrefix,
+		manifest.EntryCount()), nil
+}
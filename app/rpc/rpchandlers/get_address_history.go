@@ -0,0 +1,84 @@
+package rpchandlers
+
+import (
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/app/rpc/rpccontext"
+	"github.com/wombatlabs/coinsecd/domain/addresshistoryindex"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/txscript"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
+	"github.com/wombatlabs/coinsecd/util"
+)
+
+const (
+	defaultAddressHistoryLimit = 100
+	maxAddressHistoryLimit     = 1000
+)
+
+// HandleGetAddressHistory handles the respectively named RPC command
+func HandleGetAddressHistory(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	if !context.Config.AddressHistoryIndex {
+		errorMessage := &appmessage.GetAddressHistoryResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Method unavailable when coinsecd is run without --addresshistoryindex")
+		return errorMessage, nil
+	}
+
+	getAddressHistoryRequest := request.(*appmessage.GetAddressHistoryRequestMessage)
+
+	address, err := util.DecodeAddress(getAddressHistoryRequest.Address, context.Config.ActiveNetParams.Prefix)
+	if err != nil {
+		errorMessage := &appmessage.GetAddressHistoryResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not decode address '%s': %s", getAddressHistoryRequest.Address, err)
+		return errorMessage, nil
+	}
+	scriptPublicKey, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		errorMessage := &appmessage.GetAddressHistoryResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not create a scriptPublicKey for address '%s': %s",
+			getAddressHistoryRequest.Address, err)
+		return errorMessage, nil
+	}
+
+	cursor, err := hex.DecodeString(getAddressHistoryRequest.Cursor)
+	if err != nil {
+		errorMessage := &appmessage.GetAddressHistoryResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not decode cursor '%s': %s", getAddressHistoryRequest.Cursor, err)
+		return errorMessage, nil
+	}
+
+	limit := int(getAddressHistoryRequest.Limit)
+	if limit == 0 {
+		limit = defaultAddressHistoryLimit
+	}
+	if limit > maxAddressHistoryLimit {
+		errorMessage := &appmessage.GetAddressHistoryResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Limit must be at most %d", maxAddressHistoryLimit)
+		return errorMessage, nil
+	}
+
+	historyEntries, nextCursor, err := context.AddressHistoryIndex.History(scriptPublicKey, cursor, limit)
+	if err != nil {
+		if errors.Is(err, addresshistoryindex.ErrInvalidCursor) {
+			errorMessage := &appmessage.GetAddressHistoryResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Cursor '%s' is invalid or no longer points to an accepted "+
+				"transaction. Please restart from the newest transaction", getAddressHistoryRequest.Cursor)
+			return errorMessage, nil
+		}
+		return nil, err
+	}
+
+	entries := make([]*appmessage.AddressHistoryEntry, len(historyEntries))
+	for i, historyEntry := range historyEntries {
+		entries[i] = &appmessage.AddressHistoryEntry{
+			TransactionID:          historyEntry.TransactionID.String(),
+			AcceptingBlockHash:     historyEntry.AcceptingBlockHash.String(),
+			AcceptingBlockDAAScore: historyEntry.AcceptingBlockDAAScore,
+			IsPayingToAddress:      historyEntry.IsPayingToScriptPublicKey,
+			IsSpendingFromAddress:  historyEntry.IsSpendingScriptPublicKey,
+		}
+	}
+
+	return appmessage.NewGetAddressHistoryResponseMessage(entries, hex.EncodeToString(nextCursor)), nil
+}
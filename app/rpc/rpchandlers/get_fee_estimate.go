@@ -0,0 +1,26 @@
+package rpchandlers
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/app/rpc/rpccontext"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/model"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
+)
+
+// HandleGetFeeEstimate handles the respectively named RPC command
+func HandleGetFeeEstimate(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	feeEstimate := context.Domain.MiningManager().GetFeeEstimate()
+
+	return appmessage.NewGetFeeEstimateResponseMessage(&appmessage.RPCFeeEstimate{
+		PriorityBucket: feeRateBucketToRPC(feeEstimate.PriorityBucket),
+		NormalBucket:   feeRateBucketToRPC(feeEstimate.NormalBucket),
+		LowBucket:      feeRateBucketToRPC(feeEstimate.LowBucket),
+	}), nil
+}
+
+func feeRateBucketToRPC(bucket model.FeeRateBucket) *appmessage.RPCFeeRateBucket {
+	return &appmessage.RPCFeeRateBucket{
+		FeeRate:          bucket.FeeRate,
+		EstimatedSeconds: bucket.EstimatedSeconds,
+	}
+}
@@ -0,0 +1,24 @@
+package rpchandlers
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/app/rpc/rpccontext"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
+)
+
+// HandleGetMempoolInfo handles the respectively named RPC command
+func HandleGetMempoolInfo(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	mempoolInfo := context.Domain.MiningManager().GetMempoolInfo()
+
+	feeRateHistogram := make([]*appmessage.RPCFeeRateHistogramBucket, len(mempoolInfo.FeeRateHistogram))
+	for i, bucket := range mempoolInfo.FeeRateHistogram {
+		feeRateHistogram[i] = &appmessage.RPCFeeRateHistogramBucket{
+			MinimumFeeRate:   bucket.MinimumFeeRate,
+			TransactionCount: bucket.TransactionCount,
+			TotalMass:        bucket.TotalMass,
+		}
+	}
+
+	return appmessage.NewGetMempoolInfoResponseMessage(mempoolInfo.TransactionCount, mempoolInfo.OrphanCount,
+		mempoolInfo.TotalMass, mempoolInfo.MinimumFeeRate, feeRateHistogram), nil
+}
@@ -0,0 +1,25 @@
+package rpchandlers
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/app/rpc/rpccontext"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
+)
+
+// HandleGetRPCUsage handles the respectively named RPC command
+func HandleGetRPCUsage(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	// The usage reveals the addresses and token names of all RPC clients, so it's only
+	// served to clients that authenticated with a role that may call it
+	if context.NetAdapter.RPCAuthenticator() == nil {
+		log.Warn("GetRPCUsage RPC command called while RPC authentication is disabled -- ignoring.")
+		errorMessage := &appmessage.GetRPCUsageResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("GetRPCUsage RPC command requires RPC authentication to be enabled")
+		return errorMessage, nil
+	}
+
+	if context.RateLimiter == nil {
+		return appmessage.NewGetRPCUsageResponseMessage(false, 0, 0, nil), nil
+	}
+	return appmessage.NewGetRPCUsageResponseMessage(true, context.RateLimiter.RatePerSecond(),
+		context.RateLimiter.Burst(), context.RateLimiter.Usage()), nil
+}
@@ -0,0 +1,86 @@
+package rpchandlers
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/app/rpc/rpccontext"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/hashes"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/transactionid"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
+)
+
+// HandleGetTransaction handles the respectively named RPC command
+func HandleGetTransaction(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getTransactionRequest := request.(*appmessage.GetTransactionRequestMessage)
+
+	transactionID, err := transactionid.FromString(getTransactionRequest.TransactionID)
+	if err != nil {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction ID could not be parsed: %s", err)
+		return errorMessage, nil
+	}
+
+	mempoolTransaction, _, found := context.Domain.MiningManager().GetTransaction(transactionID, true, false)
+	if found {
+		rpcTransaction := appmessage.DomainTransactionToRPCTransaction(mempoolTransaction)
+		err := context.PopulateTransactionWithVerboseData(rpcTransaction, nil)
+		if err != nil {
+			return nil, err
+		}
+		return appmessage.NewGetTransactionResponseMessage(rpcTransaction, true, "", nil), nil
+	}
+
+	if !context.Config.TXIndex {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction %s was not found in the mempool. "+
+			"Looking up transactions outside the mempool is unavailable when coinsecd is run without --txindex",
+			transactionID)
+		return errorMessage, nil
+	}
+
+	txIndexEntry, found, err := context.TXIndex.TXEntry(transactionID)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction %s was not found", transactionID)
+		return errorMessage, nil
+	}
+
+	// Prefer the block whose copy of the transaction got accepted
+	includingBlockHash := txIndexEntry.IncludingBlockHashes[0]
+	acceptingBlockHash := ""
+	if txIndexEntry.AcceptingBlockInfo != nil {
+		includingBlockHash = txIndexEntry.AcceptingBlockInfo.IncludingBlockHash
+		acceptingBlockHash = txIndexEntry.AcceptingBlockInfo.AcceptingBlockHash.String()
+	}
+
+	block, found, err := context.Domain.Consensus().GetBlock(includingBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		errorMessage := &appmessage.GetTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("The data of block %s, which includes transaction %s, "+
+			"has been pruned", includingBlockHash, transactionID)
+		return errorMessage, nil
+	}
+
+	for _, transaction := range block.Transactions {
+		if !consensushashing.TransactionID(transaction).Equal(transactionID) {
+			continue
+		}
+		rpcTransaction := appmessage.DomainTransactionToRPCTransaction(transaction)
+		err := context.PopulateTransactionWithVerboseData(rpcTransaction, block.Header)
+		if err != nil {
+			return nil, err
+		}
+		return appmessage.NewGetTransactionResponseMessage(rpcTransaction, false, acceptingBlockHash,
+			hashes.ToStrings(txIndexEntry.IncludingBlockHashes)), nil
+	}
+
+	errorMessage := &appmessage.GetTransactionResponseMessage{}
+	errorMessage.Error = appmessage.RPCErrorf("Transaction %s was not found in block %s", transactionID, includingBlockHash)
+	return errorMessage, nil
+}
@@ -26,8 +26,18 @@ func HandleNotifyUTXOsChanged(context *rpccontext.Context, router *router.Router
 	if err != nil {
 		return nil, err
 	}
-	context.NotificationManager.PropagateUTXOsChangedNotifications(listener, addresses)
-
 	response := appmessage.NewNotifyUTXOsChangedResponseMessage()
+	if !notifyUTXOsChangedRequest.Resume {
+		context.NotificationManager.PropagateUTXOsChangedNotifications(listener, addresses)
+		response.Sequence = context.NotificationManager.LatestUTXOsChangedSequence()
+		return response, nil
+	}
+
+	response.Sequence, response.MissedNotifications, response.SequenceGap, err =
+		context.NotificationManager.ResumeUTXOsChangedNotifications(
+			listener, addresses, notifyUTXOsChangedRequest.ResumeAfterSequence)
+	if err != nil {
+		return nil, err
+	}
 	return response, nil
 }
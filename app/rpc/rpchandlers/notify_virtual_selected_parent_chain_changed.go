@@ -16,9 +16,17 @@ func HandleNotifyVirtualSelectedParentChainChanged(context *rpccontext.Context,
 	if err != nil {
 		return nil, err
 	}
-	listener.PropagateVirtualSelectedParentChainChangedNotifications(
-		notifyVirtualSelectedParentChainChangedRequest.IncludeAcceptedTransactionIDs)
-
 	response := appmessage.NewNotifyVirtualSelectedParentChainChangedResponseMessage()
+	if !notifyVirtualSelectedParentChainChangedRequest.Resume {
+		listener.PropagateVirtualSelectedParentChainChangedNotifications(
+			notifyVirtualSelectedParentChainChangedRequest.IncludeAcceptedTransactionIDs)
+		response.Sequence = context.NotificationManager.LatestVirtualSelectedParentChainChangedSequence()
+		return response, nil
+	}
+
+	response.Sequence, response.MissedNotifications, response.SequenceGap =
+		context.NotificationManager.ResumeVirtualSelectedParentChainChangedNotifications(listener,
+			notifyVirtualSelectedParentChainChangedRequest.IncludeAcceptedTransactionIDs,
+			notifyVirtualSelectedParentChainChangedRequest.ResumeAfterSequence)
 	return response, nil
 }
@@ -0,0 +1,55 @@
+package rpchandlers
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/app/rpc/rpccontext"
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/mempool"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleSubmitTransactionPackage handles the respectively named RPC command
+func HandleSubmitTransactionPackage(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	submitTransactionPackageRequest := request.(*appmessage.SubmitTransactionPackageRequestMessage)
+
+	if len(submitTransactionPackageRequest.Transactions) == 0 {
+		errorMessage := &appmessage.SubmitTransactionPackageResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("The package has no transactions")
+		return errorMessage, nil
+	}
+
+	domainTransactions := make([]*externalapi.DomainTransaction, len(submitTransactionPackageRequest.Transactions))
+	for i, transaction := range submitTransactionPackageRequest.Transactions {
+		domainTransaction, err := appmessage.RPCTransactionToDomainTransaction(transaction)
+		if err != nil {
+			errorMessage := &appmessage.SubmitTransactionPackageResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not parse transaction #%d: %s", i, err)
+			return errorMessage, nil
+		}
+		domainTransactions[i] = domainTransaction
+	}
+
+	transactionID := consensushashing.TransactionID(domainTransactions[len(domainTransactions)-1])
+	acceptedTransactions, err := context.ProtocolManager.AddTransactionPackage(domainTransactions)
+	if err != nil {
+		if !errors.As(err, &mempool.RuleError{}) {
+			return nil, err
+		}
+
+		log.Debugf("Rejected the package of transaction %s: %s", transactionID, err)
+		// Return the ID also in the case of error, so that clients can match the response to the correct package submit request
+		errorMessage := appmessage.NewSubmitTransactionPackageResponseMessage(transactionID.String(), nil)
+		errorMessage.Error = appmessage.RPCErrorf("Rejected the package of transaction %s: %s", transactionID, err)
+		return errorMessage, nil
+	}
+
+	acceptedTransactionIDs := make([]string, len(acceptedTransactions))
+	for i, acceptedTransaction := range acceptedTransactions {
+		acceptedTransactionIDs[i] = consensushashing.TransactionID(acceptedTransaction).String()
+	}
+
+	response := appmessage.NewSubmitTransactionPackageResponseMessage(transactionID.String(), acceptedTransactionIDs)
+	return response, nil
+}
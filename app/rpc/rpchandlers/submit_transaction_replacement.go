@@ -0,0 +1,44 @@
+package rpchandlers
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/app/rpc/rpccontext"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/mempool"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleSubmitTransactionReplacement handles the respectively named RPC command
+func HandleSubmitTransactionReplacement(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	submitTransactionReplacementRequest := request.(*appmessage.SubmitTransactionReplacementRequestMessage)
+
+	domainTransaction, err := appmessage.RPCTransactionToDomainTransaction(submitTransactionReplacementRequest.Transaction)
+	if err != nil {
+		errorMessage := &appmessage.SubmitTransactionReplacementResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not parse transaction: %s", err)
+		return errorMessage, nil
+	}
+
+	transactionID := consensushashing.TransactionID(domainTransaction)
+	replacedTransactions, err := context.ProtocolManager.AddTransactionReplacement(domainTransaction)
+	if err != nil {
+		if !errors.As(err, &mempool.RuleError{}) {
+			return nil, err
+		}
+
+		log.Debugf("Rejected transaction replacement %s: %s", transactionID, err)
+		// Return the ID also in the case of error, so that clients can match the response to the correct transaction submit request
+		errorMessage := appmessage.NewSubmitTransactionReplacementResponseMessage(transactionID.String(), nil)
+		errorMessage.Error = appmessage.RPCErrorf("Rejected transaction %s: %s", transactionID, err)
+		return errorMessage, nil
+	}
+
+	replacedTransactionIDs := make([]string, len(replacedTransactions))
+	for i, replacedTransaction := range replacedTransactions {
+		replacedTransactionIDs[i] = consensushashing.TransactionID(replacedTransaction).String()
+	}
+
+	response := appmessage.NewSubmitTransactionReplacementResponseMessage(transactionID.String(), replacedTransactionIDs)
+	return response, nil
+}
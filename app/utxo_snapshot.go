@@ -0,0 +1,48 @@
+package app
+
+import (
+	"github.com/wombatlabs/coinsecd/app/utxosnapshot"
+	"github.com/wombatlabs/coinsecd/domain"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/mempool"
+	"github.com/wombatlabs/coinsecd/infrastructure/config"
+	infrastructuredatabase "github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// exportUTXOSnapshot writes a UTXO snapshot of the database to the file given to the
+// export-utxo-snapshot command. The node isn't started, so the exported state can't
+// change while it's written
+func exportUTXOSnapshot(cfg *config.Config, db infrastructuredatabase.Database) error {
+	consensusConfig := newConsensusConfig(cfg)
+	domain, err := domain.New(consensusConfig, mempool.DefaultConfig(&consensusConfig.Params), db)
+	if err != nil {
+		return err
+	}
+
+	summary, err := utxosnapshot.Export(domain.Consensus(), cfg.ActiveNetParams, cfg.ExportUTXOSnapshotPath)
+	if err != nil {
+		return err
+	}
+	log.Infof("Exported a UTXO snapshot of pruning point %s to %s, with %d blocks in the pruning point "+
+		"anticone, %d headers in its future and %d UTXOs", summary.PruningPoint, cfg.ExportUTXOSnapshotPath,
+		summary.BlockCount, summary.HeaderCount, summary.UTXOCount)
+	return nil
+}
+
+// importUTXOSnapshot bootstraps the consensus of the domain from the UTXO snapshot given
+// with --import-snapshot. A snapshot that was already imported is skipped, so that the
+// node can be restarted with the same configuration
+func importUTXOSnapshot(cfg *config.Config, domain domain.Domain) error {
+	summary, err := utxosnapshot.Import(domain, cfg.ActiveNetParams, cfg.ImportSnapshot)
+	if err != nil {
+		if errors.Is(err, utxosnapshot.ErrAlreadyImported) {
+			log.Infof("Skipping the import of the UTXO snapshot %s: %s", cfg.ImportSnapshot, err)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to import the UTXO snapshot %s", cfg.ImportSnapshot)
+	}
+	log.Infof("Imported the UTXO snapshot of pruning point %s from %s, with %d blocks in the pruning point "+
+		"anticone, %d headers in its future and %d UTXOs", summary.PruningPoint, cfg.ImportSnapshot,
+		summary.BlockCount, summary.HeaderCount, summary.UTXOCount)
+	return nil
+}
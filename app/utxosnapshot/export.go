@@ -0,0 +1,300 @@
+package utxosnapshot
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	"github.com/wombatlabs/coinsecd/domain/dagconfig"
+	"github.com/wombatlabs/coinsecd/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+const (
+	// utxoChunkSize is the number of UTXOs in every UTXO set chunk, as in the
+	// UTXO set chunks that are sent to peers
+	utxoChunkSize = 1000
+
+	// maxHeadersPerMessage limits every call to GetHashesBetween, which is a heavy
+	// operation. It must be at least MergeSetSizeLimit + 1
+	maxHeadersPerMessage = 1 << 10
+)
+
+// Summary describes the contents of a UTXO snapshot
+type Summary struct {
+	PruningPoint *externalapi.DomainHash
+
+	// BlockCount is the number of blocks in the pruning point and its anticone
+	BlockCount int
+
+	// HeaderCount is the number of headers in the pruning point's future
+	HeaderCount int
+
+	UTXOCount int
+}
+
+// Export writes a UTXO snapshot of the given consensus to a new file at the given path.
+// The consensus must not change while it's exported, so it's meant to be used while
+// the node isn't running
+func Export(consensus externalapi.Consensus, params *dagconfig.Params, path string) (*Summary, error) {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "Export")
+	defer onEnd()
+
+	pruningPoint, err := consensus.PruningPoint()
+	if err != nil {
+		return nil, err
+	}
+	if pruningPoint.Equal(params.GenesisHash) {
+		return nil, errors.Errorf("the pruning point is still the genesis, so there's no state to snapshot")
+	}
+
+	writer, err := createSnapshotFile(path, params.Name)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := writeSnapshot(writer, consensus, params, pruningPoint)
+	if err != nil {
+		writer.abort()
+		return nil, err
+	}
+	err = writer.close()
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func writeSnapshot(writer *snapshotWriter, consensus externalapi.Consensus, params *dagconfig.Params,
+	pruningPoint *externalapi.DomainHash) (*Summary, error) {
+
+	summary := &Summary{PruningPoint: pruningPoint}
+
+	log.Infof("Writing the pruning point proof of %s", pruningPoint)
+	err := writePruningPointProof(writer, consensus, pruningPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Writing the pruning points and the pruning point anticone")
+	summary.BlockCount, err = writePruningPointsAndPruningPointAnticone(writer, consensus, params)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Writing the headers of the pruning point future")
+	summary.HeaderCount, err = writePruningPointFutureHeaders(writer, consensus, pruningPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Writing the pruning point UTXO set")
+	summary.UTXOCount, err = writePruningPointUTXOSet(writer, consensus, pruningPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+func writePruningPointProof(writer *snapshotWriter, consensus externalapi.Consensus,
+	pruningPoint *externalapi.DomainHash) error {
+
+	pruningPointProof, err := consensus.BuildPruningPointProof()
+	if err != nil {
+		return err
+	}
+	proofPruningPoint := consensushashing.HeaderHash(pruningPointProof.Headers[0][len(pruningPointProof.Headers[0])-1])
+	if !proofPruningPoint.Equal(pruningPoint) {
+		return errors.Errorf("the pruning point proof is of %s instead of the pruning point %s",
+			proofPruningPoint, pruningPoint)
+	}
+
+	return writer.writeMessage(appmessage.DomainPruningPointProofToMsgPruningPointProof(pruningPointProof))
+}
+
+// writePruningPointsAndPruningPointAnticone writes the headers of the pruning points,
+// followed by the pruning point and its anticone with the trusted data needed to validate
+// them, in the same way the HandlePruningPointAndItsAnticoneRequests flow sends them
+func writePruningPointsAndPruningPointAnticone(writer *snapshotWriter, consensus externalapi.Consensus,
+	params *dagconfig.Params) (int, error) {
+
+	pruningPointHeaders, err := consensus.PruningPointHeaders()
+	if err != nil {
+		return 0, err
+	}
+	msgPruningPointHeaders := make([]*appmessage.MsgBlockHeader, len(pruningPointHeaders))
+	for i, header := range pruningPointHeaders {
+		msgPruningPointHeaders[i] = appmessage.DomainBlockHeaderToBlockHeader(header)
+	}
+	err = writer.writeMessage(appmessage.NewMsgPruningPoints(msgPruningPointHeaders))
+	if err != nil {
+		return 0, err
+	}
+
+	pointAndItsAnticone, err := consensus.PruningPointAndItsAnticone()
+	if err != nil {
+		return 0, err
+	}
+
+	windowSize := params.DifficultyAdjustmentWindowSize
+	daaWindowBlocks := make([]*externalapi.TrustedDataDataDAAHeader, 0, windowSize)
+	daaWindowHashesToIndex := make(map[externalapi.DomainHash]int, windowSize)
+	trustedDataDAABlockIndexes := make(map[externalapi.DomainHash][]uint64)
+
+	ghostdagData := make([]*externalapi.BlockGHOSTDAGDataHashPair, 0)
+	ghostdagDataHashToIndex := make(map[externalapi.DomainHash]int)
+	trustedDataGHOSTDAGDataIndexes := make(map[externalapi.DomainHash][]uint64)
+	for _, blockHash := range pointAndItsAnticone {
+		blockDAAWindowHashes, err := consensus.BlockDAAWindowHashes(blockHash)
+		if err != nil {
+			return 0, err
+		}
+
+		trustedDataDAABlockIndexes[*blockHash] = make([]uint64, 0, windowSize)
+		for i, daaBlockHash := range blockDAAWindowHashes {
+			index, exists := daaWindowHashesToIndex[*daaBlockHash]
+			if !exists {
+				trustedDataDataDAAHeader, err := consensus.TrustedDataDataDAAHeader(blockHash, daaBlockHash, uint64(i))
+				if err != nil {
+					return 0, err
+				}
+				daaWindowBlocks = append(daaWindowBlocks, trustedDataDataDAAHeader)
+				index = len(daaWindowBlocks) - 1
+				daaWindowHashesToIndex[*daaBlockHash] = index
+			}
+
+			trustedDataDAABlockIndexes[*blockHash] = append(trustedDataDAABlockIndexes[*blockHash], uint64(index))
+		}
+
+		ghostdagDataBlockHashes, err := consensus.TrustedBlockAssociatedGHOSTDAGDataBlockHashes(blockHash)
+		if err != nil {
+			return 0, err
+		}
+
+		trustedDataGHOSTDAGDataIndexes[*blockHash] = make([]uint64, 0, params.K)
+		for _, ghostdagDataBlockHash := range ghostdagDataBlockHashes {
+			index, exists := ghostdagDataHashToIndex[*ghostdagDataBlockHash]
+			if !exists {
+				data, err := consensus.TrustedGHOSTDAGData(ghostdagDataBlockHash)
+				if err != nil {
+					return 0, err
+				}
+				ghostdagData = append(ghostdagData, &externalapi.BlockGHOSTDAGDataHashPair{
+					Hash:         ghostdagDataBlockHash,
+					GHOSTDAGData: data,
+				})
+				index = len(ghostdagData) - 1
+				ghostdagDataHashToIndex[*ghostdagDataBlockHash] = index
+			}
+
+			trustedDataGHOSTDAGDataIndexes[*blockHash] = append(trustedDataGHOSTDAGDataIndexes[*blockHash], uint64(index))
+		}
+	}
+
+	err = writer.writeMessage(appmessage.DomainTrustedDataToTrustedData(daaWindowBlocks, ghostdagData))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, blockHash := range pointAndItsAnticone {
+		block, found, err := consensus.GetBlock(blockHash)
+		if err != nil {
+			return 0, err
+		}
+		if !found {
+			return 0, errors.Errorf("pruning point anticone block %s not found", blockHash)
+		}
+
+		err = writer.writeMessage(appmessage.DomainBlockWithTrustedDataToBlockWithTrustedDataV4(
+			block, trustedDataDAABlockIndexes[*blockHash], trustedDataGHOSTDAGDataIndexes[*blockHash]))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = writer.writeMessage(appmessage.NewMsgDoneBlocksWithTrustedData())
+	if err != nil {
+		return 0, err
+	}
+	return len(pointAndItsAnticone), nil
+}
+
+// writePruningPointFutureHeaders writes the headers between the pruning point and the
+// headers selected tip. The importing node needs them to validate that the pruning
+// point is deep enough
+func writePruningPointFutureHeaders(writer *snapshotWriter, consensus externalapi.Consensus,
+	pruningPoint *externalapi.DomainHash) (int, error) {
+
+	headersSelectedTip, err := consensus.GetHeadersSelectedTip()
+	if err != nil {
+		return 0, err
+	}
+
+	headerCount := 0
+	lowHash := pruningPoint
+	for !lowHash.Equal(headersSelectedTip) {
+		blockHashes, _, err := consensus.GetHashesBetween(lowHash, headersSelectedTip, maxHeadersPerMessage)
+		if err != nil {
+			return 0, err
+		}
+		if len(blockHashes) == 0 {
+			return 0, errors.Errorf("found no headers between %s and the headers selected tip %s",
+				lowHash, headersSelectedTip)
+		}
+
+		blockHeaders := make([]*appmessage.MsgBlockHeader, len(blockHashes))
+		for i, blockHash := range blockHashes {
+			blockHeader, err := consensus.GetBlockHeader(blockHash)
+			if err != nil {
+				return 0, err
+			}
+			blockHeaders[i] = appmessage.DomainBlockHeaderToBlockHeader(blockHeader)
+		}
+		err = writer.writeMessage(appmessage.NewBlockHeadersMessage(blockHeaders))
+		if err != nil {
+			return 0, err
+		}
+
+		headerCount += len(blockHashes)
+		lowHash = blockHashes[len(blockHashes)-1]
+	}
+
+	err = writer.writeMessage(appmessage.NewMsgDoneHeaders())
+	if err != nil {
+		return 0, err
+	}
+	return headerCount, nil
+}
+
+func writePruningPointUTXOSet(writer *snapshotWriter, consensus externalapi.Consensus,
+	pruningPoint *externalapi.DomainHash) (int, error) {
+
+	utxoCount := 0
+	var fromOutpoint *externalapi.DomainOutpoint
+	for {
+		pruningPointUTXOs, err := consensus.GetPruningPointUTXOs(pruningPoint, fromOutpoint, utxoChunkSize)
+		if err != nil {
+			return 0, err
+		}
+
+		if len(pruningPointUTXOs) > 0 {
+			outpointAndUTXOEntryPairs :=
+				appmessage.DomainOutpointAndUTXOEntryPairsToOutpointAndUTXOEntryPairs(pruningPointUTXOs)
+			err = writer.writeMessage(appmessage.NewMsgPruningPointUTXOSetChunk(outpointAndUTXOEntryPairs))
+			if err != nil {
+				return 0, err
+			}
+			utxoCount += len(pruningPointUTXOs)
+			fromOutpoint = pruningPointUTXOs[len(pruningPointUTXOs)-1].Outpoint
+		}
+
+		if len(pruningPointUTXOs) < utxoChunkSize {
+			break
+		}
+	}
+
+	err := writer.writeMessage(appmessage.NewMsgDonePruningPointUTXOSetChunks())
+	if err != nil {
+		return 0, err
+	}
+	return utxoCount, nil
+}
@@ -0,0 +1,242 @@
+// Package utxosnapshot writes the pruning point state of a node to a single file, and
+// bootstraps another node from that file instead of downloading the state from a peer.
+//
+// The file starts with a magic, a version and the length-prefixed name of the network
+// it was exported from. The rest of the file is a sequence of length-prefixed protowire
+// messages, in the order that a syncee receives them during IBD with a headers proof:
+// the pruning point proof, the headers of the pruning points, the trusted data, the
+// pruning point and its anticone, the headers of the pruning point's future, and the
+// pruning point UTXO set. Every list of messages ends with its done message. All
+// integers are little-endian.
+package utxosnapshot
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/pkg/errors"
+)
+
+const (
+	fileMagic   uint32 = 0x53555343 // "CSUS"
+	fileVersion uint32 = 1
+
+	// maxNetworkNameLength and maxMessageSize guard against allocating absurd
+	// amounts of memory when reading a corrupted file. maxMessageSize is the
+	// max size of a P2P message
+	maxNetworkNameLength = 256
+	maxMessageSize       = 1024 * 1024 * 1024
+)
+
+// ErrUnsupportedVersion indicates that a snapshot was written with a version this
+// node doesn't know how to read
+var ErrUnsupportedVersion = errors.New("unsupported UTXO snapshot version")
+
+// snapshotWriter writes a snapshot to a temporary file, which is renamed to its
+// final path only once the snapshot is complete
+type snapshotWriter struct {
+	path          string
+	temporaryPath string
+	file          *os.File
+	writer        *bufio.Writer
+}
+
+// createSnapshotFile starts writing a snapshot of the given network to the given path.
+// An existing file is never overwritten
+func createSnapshotFile(path string, networkName string) (*snapshotWriter, error) {
+	_, err := os.Stat(path)
+	if err == nil {
+		return nil, errors.Errorf("%s already exists", path)
+	}
+	if !os.IsNotExist(err) {
+		return nil, errors.WithStack(err)
+	}
+
+	temporaryPath := path + ".new"
+	file, err := os.OpenFile(temporaryPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	w := &snapshotWriter{
+		path:          path,
+		temporaryPath: temporaryPath,
+		file:          file,
+		writer:        bufio.NewWriter(file),
+	}
+	err = writeUint32s(w.writer, fileMagic, fileVersion, uint32(len(networkName)))
+	if err != nil {
+		w.abort()
+		return nil, err
+	}
+	_, err = w.writer.WriteString(networkName)
+	if err != nil {
+		w.abort()
+		return nil, errors.WithStack(err)
+	}
+	return w, nil
+}
+
+func (w *snapshotWriter) writeMessage(message appmessage.Message) error {
+	protoMessage, err := protowire.FromAppMessage(message)
+	if err != nil {
+		return err
+	}
+	serializedMessage, err := proto.Marshal(protoMessage)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if len(serializedMessage) > maxMessageSize {
+		return errors.Errorf("the %s message is %d bytes, which is over the maximum of %d",
+			message.Command(), len(serializedMessage), maxMessageSize)
+	}
+
+	err = writeUint32s(w.writer, uint32(len(serializedMessage)))
+	if err != nil {
+		return err
+	}
+	_, err = w.writer.Write(serializedMessage)
+	return errors.WithStack(err)
+}
+
+// close completes the snapshot and moves it to its final path
+func (w *snapshotWriter) close() error {
+	err := w.writer.Flush()
+	if err != nil {
+		w.abort()
+		return errors.WithStack(err)
+	}
+	err = w.file.Sync()
+	if err != nil {
+		w.abort()
+		return errors.WithStack(err)
+	}
+	err = w.file.Close()
+	if err != nil {
+		os.Remove(w.temporaryPath)
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.Rename(w.temporaryPath, w.path))
+}
+
+// abort removes the partially written snapshot
+func (w *snapshotWriter) abort() {
+	w.file.Close()
+	os.Remove(w.temporaryPath)
+}
+
+type snapshotReader struct {
+	file   *os.File
+	reader *bufio.Reader
+}
+
+// openSnapshotFile opens the snapshot at the given path, and validates that it's a snapshot
+// of the given network in a version this node can read
+func openSnapshotFile(path string, networkName string) (*snapshotReader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	r := &snapshotReader{
+		file:   file,
+		reader: bufio.NewReader(file),
+	}
+
+	err = r.readHeader(path, networkName)
+	if err != nil {
+		r.close()
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *snapshotReader) readHeader(path string, networkName string) error {
+	var magic, version, networkNameLength uint32
+	err := readValues(r.reader, &magic, &version)
+	if err != nil {
+		return err
+	}
+	if magic != fileMagic {
+		return errors.Errorf("%s is not a UTXO snapshot", path)
+	}
+	if version != fileVersion {
+		return errors.Wrapf(ErrUnsupportedVersion, "version %d", version)
+	}
+
+	err = readValues(r.reader, &networkNameLength)
+	if err != nil {
+		return err
+	}
+	if networkNameLength > maxNetworkNameLength {
+		return errors.Errorf("the network name of the UTXO snapshot is %d bytes, which is over "+
+			"the maximum of %d", networkNameLength, maxNetworkNameLength)
+	}
+	snapshotNetworkName := make([]byte, networkNameLength)
+	_, err = io.ReadFull(r.reader, snapshotNetworkName)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if string(snapshotNetworkName) != networkName {
+		return errors.Errorf("the UTXO snapshot is of network %s, but the node is on %s",
+			snapshotNetworkName, networkName)
+	}
+	return nil
+}
+
+// readMessage reads the next message in the snapshot. It returns io.EOF once
+// all the messages were read
+func (r *snapshotReader) readMessage() (appmessage.Message, error) {
+	var messageSize uint32
+	err := binary.Read(r.reader, binary.LittleEndian, &messageSize)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		return nil, errors.WithStack(err)
+	}
+	if messageSize > maxMessageSize {
+		return nil, errors.Errorf("the UTXO snapshot has a message of %d bytes, which is over "+
+			"the maximum of %d", messageSize, maxMessageSize)
+	}
+
+	serializedMessage := make([]byte, messageSize)
+	_, err = io.ReadFull(r.reader, serializedMessage)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	protoMessage := &protowire.CoinsecdMessage{}
+	err = proto.Unmarshal(serializedMessage, protoMessage)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return protoMessage.ToAppMessage()
+}
+
+func (r *snapshotReader) close() {
+	r.file.Close()
+}
+
+func writeUint32s(writer io.Writer, values ...uint32) error {
+	for _, value := range values {
+		err := binary.Write(writer, binary.LittleEndian, value)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+func readValues(reader io.Reader, values ...interface{}) error {
+	for _, value := range values {
+		err := binary.Read(reader, binary.LittleEndian, value)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,365 @@
+package utxosnapshot
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/domain"
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/consensus/ruleerrors"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	"github.com/wombatlabs/coinsecd/domain/dagconfig"
+	"github.com/wombatlabs/coinsecd/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+// ErrAlreadyImported indicates that the pruning point of a UTXO snapshot is already one
+// of the pruning points of the node, so there's nothing to import
+var ErrAlreadyImported = errors.New("the UTXO snapshot was already imported")
+
+// Import bootstraps the consensus of the given domain from the UTXO snapshot at the given
+// path. The snapshot is imported into a staging consensus, which replaces the current one
+// only after the pruning point UTXO set was validated against the UTXO commitment of the
+// pruning point. If anything fails, the current consensus is kept as is
+func Import(domain domain.Domain, params *dagconfig.Params, path string) (*Summary, error) {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "Import")
+	defer onEnd()
+
+	reader, err := openSnapshotFile(path, params.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.close()
+
+	pruningPointProof, pruningPoint, err := readPruningPointProof(reader)
+	if err != nil {
+		return nil, err
+	}
+	if pruningPoint.Equal(params.GenesisHash) {
+		return nil, errors.Errorf("the pruning point of the UTXO snapshot is the genesis")
+	}
+
+	isAlreadyImported, err := isPruningPointOfConsensus(domain.Consensus(), pruningPoint)
+	if err != nil {
+		return nil, err
+	}
+	if isAlreadyImported {
+		return nil, errors.Wrapf(ErrAlreadyImported, "%s is already a pruning point of the node", pruningPoint)
+	}
+
+	log.Infof("Validating the pruning point proof of %s", pruningPoint)
+	err = domain.Consensus().ValidatePruningPointProof(pruningPointProof)
+	if err != nil {
+		return nil, errors.Wrapf(err, "the pruning point proof of the UTXO snapshot is invalid")
+	}
+
+	err = domain.InitStagingConsensusWithoutGenesis()
+	if err != nil {
+		return nil, err
+	}
+	summary, err := importIntoStagingConsensus(domain, reader, pruningPointProof, pruningPoint)
+	if err != nil {
+		deleteStagingConsensusErr := domain.DeleteStagingConsensus()
+		if deleteStagingConsensusErr != nil {
+			log.Errorf("Failed to delete the staging consensus: %s", deleteStagingConsensusErr)
+		}
+		return nil, err
+	}
+
+	err = domain.CommitStagingConsensus()
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func readPruningPointProof(reader *snapshotReader) (*externalapi.PruningPointProof, *externalapi.DomainHash, error) {
+	message, err := reader.readMessage()
+	if err != nil {
+		return nil, nil, err
+	}
+	msgPruningPointProof, ok := message.(*appmessage.MsgPruningPointProof)
+	if !ok {
+		return nil, nil, unexpectedMessageError(appmessage.CmdPruningPointProof, message)
+	}
+
+	pruningPointProof := appmessage.MsgPruningPointProofToDomainPruningPointProof(msgPruningPointProof)
+	if len(pruningPointProof.Headers) == 0 || len(pruningPointProof.Headers[0]) == 0 {
+		return nil, nil, errors.Errorf("the pruning point proof of the UTXO snapshot is empty")
+	}
+	pruningPoint := consensushashing.HeaderHash(pruningPointProof.Headers[0][len(pruningPointProof.Headers[0])-1])
+	return pruningPointProof, pruningPoint, nil
+}
+
+func isPruningPointOfConsensus(consensus externalapi.Consensus, blockHash *externalapi.DomainHash) (bool, error) {
+	pruningPointHeaders, err := consensus.PruningPointHeaders()
+	if err != nil {
+		return false, err
+	}
+	for _, header := range pruningPointHeaders {
+		if consensushashing.HeaderHash(header).Equal(blockHash) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// importIntoStagingConsensus feeds the snapshot to the staging consensus in the same way
+// IBD with a headers proof feeds it the data received from the syncer
+func importIntoStagingConsensus(domain domain.Domain, reader *snapshotReader,
+	pruningPointProof *externalapi.PruningPointProof, pruningPoint *externalapi.DomainHash) (*Summary, error) {
+
+	summary := &Summary{PruningPoint: pruningPoint}
+	stagingConsensus := domain.StagingConsensus()
+
+	err := stagingConsensus.ApplyPruningPointProof(pruningPointProof)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Importing the pruning points and the pruning point anticone")
+	err = importPruningPoints(domain, reader, pruningPoint)
+	if err != nil {
+		return nil, err
+	}
+	summary.BlockCount, err = importPruningPointAnticone(stagingConsensus, reader, pruningPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Importing the headers of the pruning point future")
+	summary.HeaderCount, err = importPruningPointFutureHeaders(stagingConsensus, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	isValid, err := stagingConsensus.IsValidPruningPoint(pruningPoint)
+	if err != nil {
+		return nil, err
+	}
+	if !isValid {
+		return nil, errors.Errorf("%s is not a valid pruning point", pruningPoint)
+	}
+
+	log.Infof("Importing the pruning point UTXO set")
+	summary.UTXOCount, err = importPruningPointUTXOSet(stagingConsensus, reader, pruningPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = reader.readMessage()
+	if !errors.Is(err, io.EOF) {
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.Errorf("the UTXO snapshot has unexpected data after the pruning point UTXO set")
+	}
+	return summary, nil
+}
+
+func importPruningPoints(domain domain.Domain, reader *snapshotReader, pruningPoint *externalapi.DomainHash) error {
+	message, err := reader.readMessage()
+	if err != nil {
+		return err
+	}
+	msgPruningPoints, ok := message.(*appmessage.MsgPruningPoints)
+	if !ok {
+		return unexpectedMessageError(appmessage.CmdPruningPoints, message)
+	}
+	if len(msgPruningPoints.Headers) == 0 {
+		return errors.Errorf("the UTXO snapshot has no pruning points")
+	}
+
+	headers := make([]externalapi.BlockHeader, len(msgPruningPoints.Headers))
+	for i, header := range msgPruningPoints.Headers {
+		headers[i] = appmessage.BlockHeaderToDomainBlockHeader(header)
+	}
+
+	arePruningPointsViolatingFinality, err := domain.Consensus().ArePruningPointsViolatingFinality(headers)
+	if err != nil {
+		return err
+	}
+	if arePruningPointsViolatingFinality {
+		return errors.Errorf("the pruning points of the UTXO snapshot are violating finality")
+	}
+
+	lastPruningPoint := consensushashing.HeaderHash(headers[len(headers)-1])
+	if !lastPruningPoint.Equal(pruningPoint) {
+		return errors.Errorf("the last pruning point of the UTXO snapshot is %s instead of the proof "+
+			"pruning point %s", lastPruningPoint, pruningPoint)
+	}
+
+	return domain.StagingConsensus().ImportPruningPoints(headers)
+}
+
+func importPruningPointAnticone(consensus externalapi.Consensus, reader *snapshotReader,
+	pruningPoint *externalapi.DomainHash) (int, error) {
+
+	message, err := reader.readMessage()
+	if err != nil {
+		return 0, err
+	}
+	msgTrustedData, ok := message.(*appmessage.MsgTrustedData)
+	if !ok {
+		return 0, unexpectedMessageError(appmessage.CmdTrustedData, message)
+	}
+
+	blockCount := 0
+	for {
+		message, err := reader.readMessage()
+		if err != nil {
+			return 0, err
+		}
+
+		switch message := message.(type) {
+		case *appmessage.MsgBlockWithTrustedDataV4:
+			blockWithTrustedData, err := toBlockWithTrustedData(message, msgTrustedData)
+			if err != nil {
+				return 0, err
+			}
+			if blockCount == 0 && !consensushashing.BlockHash(blockWithTrustedData.Block).Equal(pruningPoint) {
+				return 0, errors.Errorf("the first block with trusted data of the UTXO snapshot is not the pruning point")
+			}
+
+			err = consensus.ValidateAndInsertBlockWithTrustedData(blockWithTrustedData, false)
+			if err != nil {
+				return 0, err
+			}
+			blockCount++
+		case *appmessage.MsgDoneBlocksWithTrustedData:
+			if blockCount == 0 {
+				return 0, errors.Errorf("the UTXO snapshot doesn't have the pruning point block")
+			}
+			return blockCount, nil
+		default:
+			return 0, unexpectedMessageError(appmessage.CmdBlockWithTrustedDataV4, message)
+		}
+	}
+}
+
+func toBlockWithTrustedData(block *appmessage.MsgBlockWithTrustedDataV4,
+	data *appmessage.MsgTrustedData) (*externalapi.BlockWithTrustedData, error) {
+
+	blockWithTrustedData := &externalapi.BlockWithTrustedData{
+		Block:        appmessage.MsgBlockToDomainBlock(block.Block),
+		DAAWindow:    make([]*externalapi.TrustedDataDataDAAHeader, 0, len(block.DAAWindowIndices)),
+		GHOSTDAGData: make([]*externalapi.BlockGHOSTDAGDataHashPair, 0, len(block.GHOSTDAGDataIndices)),
+	}
+
+	for _, index := range block.DAAWindowIndices {
+		if index >= uint64(len(data.DAAWindow)) {
+			return nil, errors.Errorf("the UTXO snapshot has a DAA window index %d out of %d DAA window blocks",
+				index, len(data.DAAWindow))
+		}
+		blockWithTrustedData.DAAWindow = append(blockWithTrustedData.DAAWindow,
+			appmessage.TrustedDataDataDAABlockV4ToTrustedDataDataDAAHeader(data.DAAWindow[index]))
+	}
+
+	for _, index := range block.GHOSTDAGDataIndices {
+		if index >= uint64(len(data.GHOSTDAGData)) {
+			return nil, errors.Errorf("the UTXO snapshot has a GHOSTDAG data index %d out of %d GHOSTDAG data",
+				index, len(data.GHOSTDAGData))
+		}
+		blockWithTrustedData.GHOSTDAGData = append(blockWithTrustedData.GHOSTDAGData,
+			appmessage.GHOSTDAGHashPairToDomainGHOSTDAGHashPair(data.GHOSTDAGData[index]))
+	}
+
+	return blockWithTrustedData, nil
+}
+
+func importPruningPointFutureHeaders(consensus externalapi.Consensus, reader *snapshotReader) (int, error) {
+	headerCount := 0
+	for {
+		message, err := reader.readMessage()
+		if err != nil {
+			return 0, err
+		}
+
+		switch message := message.(type) {
+		case *appmessage.BlockHeadersMessage:
+			for _, msgBlockHeader := range message.BlockHeaders {
+				err := importHeader(consensus, msgBlockHeader)
+				if err != nil {
+					return 0, err
+				}
+			}
+			headerCount += len(message.BlockHeaders)
+		case *appmessage.MsgDoneHeaders:
+			return headerCount, nil
+		default:
+			return 0, unexpectedMessageError(appmessage.CmdBlockHeaders, message)
+		}
+	}
+}
+
+func importHeader(consensus externalapi.Consensus, msgBlockHeader *appmessage.MsgBlockHeader) error {
+	block := &externalapi.DomainBlock{
+		Header:       appmessage.BlockHeaderToDomainBlockHeader(msgBlockHeader),
+		Transactions: nil,
+	}
+
+	// The headers of the pruning point future include the pruning point anticone,
+	// which was already inserted with its trusted data
+	blockHash := consensushashing.BlockHash(block)
+	blockInfo, err := consensus.GetBlockInfo(blockHash)
+	if err != nil {
+		return err
+	}
+	if blockInfo.Exists {
+		return nil
+	}
+
+	err = consensus.ValidateAndInsertBlock(block, false)
+	if err != nil {
+		if errors.Is(err, ruleerrors.ErrDuplicateBlock) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to import header %s", blockHash)
+	}
+	return nil
+}
+
+func importPruningPointUTXOSet(consensus externalapi.Consensus, reader *snapshotReader,
+	pruningPoint *externalapi.DomainHash) (utxoCount int, err error) {
+
+	defer func() {
+		clearErr := consensus.ClearImportedPruningPointData()
+		if clearErr != nil {
+			panic(fmt.Sprintf("failed to clear imported pruning point data: %s", clearErr))
+		}
+	}()
+
+	for {
+		message, err := reader.readMessage()
+		if err != nil {
+			return 0, err
+		}
+
+		switch message := message.(type) {
+		case *appmessage.MsgPruningPointUTXOSetChunk:
+			domainOutpointAndUTXOEntryPairs :=
+				appmessage.OutpointAndUTXOEntryPairsToDomainOutpointAndUTXOEntryPairs(message.OutpointAndUTXOEntryPairs)
+			err := consensus.AppendImportedPruningPointUTXOs(domainOutpointAndUTXOEntryPairs)
+			if err != nil {
+				return 0, err
+			}
+			utxoCount += len(domainOutpointAndUTXOEntryPairs)
+		case *appmessage.MsgDonePruningPointUTXOSetChunks:
+			// ValidateAndInsertImportedPruningPoint checks the MuHash of the imported
+			// UTXO set against the UTXO commitment of the pruning point
+			err := consensus.ValidateAndInsertImportedPruningPoint(pruningPoint)
+			if err != nil {
+				return 0, errors.Wrapf(err, "failed to validate the UTXO set of the UTXO snapshot")
+			}
+			return utxoCount, nil
+		default:
+			return 0, unexpectedMessageError(appmessage.CmdPruningPointUTXOSetChunk, message)
+		}
+	}
+}
+
+func unexpectedMessageError(expectedCommand appmessage.MessageCommand, message appmessage.Message) error {
+	return errors.Errorf("malformed UTXO snapshot: expected a %s message, but got %s",
+		expectedCommand, message.Command())
+}
@@ -0,0 +1,7 @@
+package utxosnapshot
+
+import (
+	"github.com/wombatlabs/coinsecd/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("SNAP")
@@ -0,0 +1,252 @@
+package utxosnapshot
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/domain"
+	"github.com/wombatlabs/coinsecd/domain/consensus"
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/consensus/ruleerrors"
+	"github.com/wombatlabs/coinsecd/domain/dagconfig"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/mempool"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database/memdb"
+	"github.com/pkg/errors"
+)
+
+func TestExportAndImport(t *testing.T) {
+	params := testParams()
+	sourceDomain := newTestDomain(t, params)
+	const blockCount = 30
+	for i := 0; i < blockCount; i++ {
+		addBlock(t, sourceDomain)
+	}
+	pruningPoint, err := sourceDomain.Consensus().PruningPoint()
+	if err != nil {
+		t.Fatalf("PruningPoint: %+v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "snapshot.dat")
+	exportSummary, err := Export(sourceDomain.Consensus(), params, path)
+	if err != nil {
+		t.Fatalf("Export: %+v", err)
+	}
+	if !exportSummary.PruningPoint.Equal(pruningPoint) {
+		t.Fatalf("Expected the snapshot to be of pruning point %s, but got %s", pruningPoint, exportSummary.PruningPoint)
+	}
+	if exportSummary.UTXOCount == 0 {
+		t.Fatalf("The snapshot has no UTXOs")
+	}
+	_, err = Export(sourceDomain.Consensus(), params, path)
+	if err == nil {
+		t.Fatalf("Exporting over an existing file unexpectedly succeeded")
+	}
+
+	importingDomain := newTestDomain(t, params)
+	importSummary, err := Import(importingDomain, params, path)
+	if err != nil {
+		t.Fatalf("Import: %+v", err)
+	}
+	if !importSummary.PruningPoint.Equal(exportSummary.PruningPoint) ||
+		importSummary.BlockCount != exportSummary.BlockCount ||
+		importSummary.HeaderCount != exportSummary.HeaderCount ||
+		importSummary.UTXOCount != exportSummary.UTXOCount {
+		t.Fatalf("Expected the import summary to be %+v, but got %+v", exportSummary, importSummary)
+	}
+	importedPruningPoint, err := importingDomain.Consensus().PruningPoint()
+	if err != nil {
+		t.Fatalf("PruningPoint: %+v", err)
+	}
+	if !importedPruningPoint.Equal(pruningPoint) {
+		t.Fatalf("Expected the pruning point after the import to be %s, but got %s", pruningPoint, importedPruningPoint)
+	}
+	pruningPointUTXOs, err := importingDomain.Consensus().GetPruningPointUTXOs(
+		pruningPoint, nil, exportSummary.UTXOCount+1)
+	if err != nil {
+		t.Fatalf("GetPruningPointUTXOs: %+v", err)
+	}
+	if len(pruningPointUTXOs) != exportSummary.UTXOCount {
+		t.Fatalf("Expected %d UTXOs in the imported pruning point UTXO set, but got %d",
+			exportSummary.UTXOCount, len(pruningPointUTXOs))
+	}
+
+	// The imported consensus keeps building on top of the pruning point
+	addBlock(t, importingDomain)
+
+	_, err = Import(importingDomain, params, path)
+	if !errors.Is(err, ErrAlreadyImported) {
+		t.Fatalf("Expected ErrAlreadyImported, but got %v", err)
+	}
+}
+
+func TestImportBadUTXOSet(t *testing.T) {
+	params := testParams()
+	sourceDomain := newTestDomain(t, params)
+	for i := 0; i < 30; i++ {
+		addBlock(t, sourceDomain)
+	}
+
+	directory := t.TempDir()
+	path := filepath.Join(directory, "snapshot.dat")
+	_, err := Export(sourceDomain.Consensus(), params, path)
+	if err != nil {
+		t.Fatalf("Export: %+v", err)
+	}
+
+	// Rewrite the snapshot with one of its UTXOs changed, so that it no longer
+	// matches the UTXO commitment of the pruning point
+	badPath := filepath.Join(directory, "bad-snapshot.dat")
+	rewriteSnapshot(t, params, path, badPath, func(message appmessage.Message) {
+		chunk, ok := message.(*appmessage.MsgPruningPointUTXOSetChunk)
+		if ok {
+			chunk.OutpointAndUTXOEntryPairs[0].UTXOEntry.Amount++
+		}
+	})
+
+	importingDomain := newTestDomain(t, params)
+	_, err = Import(importingDomain, params, badPath)
+	if !errors.Is(err, ruleerrors.ErrBadPruningPointUTXOSet) {
+		t.Fatalf("Expected ErrBadPruningPointUTXOSet, but got %v", err)
+	}
+	pruningPoint, err := importingDomain.Consensus().PruningPoint()
+	if err != nil {
+		t.Fatalf("PruningPoint: %+v", err)
+	}
+	if !pruningPoint.Equal(params.GenesisHash) {
+		t.Fatalf("The consensus was changed by a failed import")
+	}
+
+	// The failed import leaves nothing behind that prevents importing a good snapshot
+	_, err = Import(importingDomain, params, path)
+	if err != nil {
+		t.Fatalf("Import: %+v", err)
+	}
+}
+
+func TestOpenSnapshotFileErrors(t *testing.T) {
+	params := testParams()
+	directory := t.TempDir()
+
+	_, err := openSnapshotFile(filepath.Join(directory, "missing.dat"), params.Name)
+	if !os.IsNotExist(errors.Cause(err)) {
+		t.Fatalf("Expected a not-exist error, but got %v", err)
+	}
+
+	notSnapshotPath := filepath.Join(directory, "not-snapshot.dat")
+	err = os.WriteFile(notSnapshotPath, []byte("this is not a UTXO snapshot"), 0600)
+	if err != nil {
+		t.Fatalf("WriteFile: %+v", err)
+	}
+	_, err = openSnapshotFile(notSnapshotPath, params.Name)
+	if err == nil {
+		t.Fatalf("Expected an error when opening a file that is not a UTXO snapshot")
+	}
+
+	futureVersionPath := filepath.Join(directory, "future-version.dat")
+	err = os.WriteFile(futureVersionPath, []byte{0x43, 0x53, 0x55, 0x53, 0x02, 0x00, 0x00, 0x00}, 0600)
+	if err != nil {
+		t.Fatalf("WriteFile: %+v", err)
+	}
+	_, err = openSnapshotFile(futureVersionPath, params.Name)
+	if !errors.Is(err, ErrUnsupportedVersion) {
+		t.Fatalf("Expected ErrUnsupportedVersion, but got %v", err)
+	}
+
+	otherNetworkPath := filepath.Join(directory, "other-network.dat")
+	writer, err := createSnapshotFile(otherNetworkPath, dagconfig.MainnetParams.Name)
+	if err != nil {
+		t.Fatalf("createSnapshotFile: %+v", err)
+	}
+	err = writer.close()
+	if err != nil {
+		t.Fatalf("close: %+v", err)
+	}
+	_, err = openSnapshotFile(otherNetworkPath, params.Name)
+	if err == nil {
+		t.Fatalf("Expected an error when opening a UTXO snapshot of another network")
+	}
+	reader, err := openSnapshotFile(otherNetworkPath, dagconfig.MainnetParams.Name)
+	if err != nil {
+		t.Fatalf("openSnapshotFile: %+v", err)
+	}
+	defer reader.close()
+	_, err = reader.readMessage()
+	if !errors.Is(err, io.EOF) {
+		t.Fatalf("Expected io.EOF when reading an empty UTXO snapshot, but got %v", err)
+	}
+}
+
+func testParams() *dagconfig.Params {
+	params := dagconfig.SimnetParams
+	params.SkipProofOfWork = true
+
+	// This makes the pruning depth 6 blocks, so that the pruning point
+	// moves after a few blocks
+	params.TargetTimePerBlock = time.Minute
+	params.FinalityDuration = 2 * params.TargetTimePerBlock
+	params.K = 0
+	params.PruningProofM = 20
+	return &params
+}
+
+func newTestDomain(t *testing.T, params *dagconfig.Params) domain.Domain {
+	consensusConfig := &consensus.Config{Params: *params}
+	domainInstance, err := domain.New(consensusConfig, mempool.DefaultConfig(&consensusConfig.Params), memdb.NewMemoryDB())
+	if err != nil {
+		t.Fatalf("New: %+v", err)
+	}
+	return domainInstance
+}
+
+func addBlock(t *testing.T, domain domain.Domain) {
+	coinbaseData := &externalapi.DomainCoinbaseData{
+		ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{0x51}, Version: 0},
+		ExtraData:       []byte{},
+	}
+	block, err := domain.Consensus().BuildBlock(coinbaseData, nil)
+	if err != nil {
+		t.Fatalf("BuildBlock: %+v", err)
+	}
+	err = domain.Consensus().ValidateAndInsertBlock(block, true)
+	if err != nil {
+		t.Fatalf("ValidateAndInsertBlock: %+v", err)
+	}
+}
+
+func rewriteSnapshot(t *testing.T, params *dagconfig.Params, sourcePath string, targetPath string,
+	modify func(message appmessage.Message)) {
+
+	reader, err := openSnapshotFile(sourcePath, params.Name)
+	if err != nil {
+		t.Fatalf("openSnapshotFile: %+v", err)
+	}
+	defer reader.close()
+	writer, err := createSnapshotFile(targetPath, params.Name)
+	if err != nil {
+		t.Fatalf("createSnapshotFile: %+v", err)
+	}
+
+	for {
+		message, err := reader.readMessage()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("readMessage: %+v", err)
+		}
+		modify(message)
+		err = writer.writeMessage(message)
+		if err != nil {
+			t.Fatalf("writeMessage: %+v", err)
+		}
+	}
+
+	err = writer.close()
+	if err != nil {
+		t.Fatalf("close: %+v", err)
+	}
+}
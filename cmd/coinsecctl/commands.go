@@ -34,6 +34,12 @@ var commandTypes = []reflect.Type{
 	reflect.TypeOf(protowire.CoinsecdMessage_GetMempoolEntriesByAddressesRequest{}),
 
 	reflect.TypeOf(protowire.CoinsecdMessage_SubmitTransactionRequest{}),
+	reflect.TypeOf(protowire.CoinsecdMessage_SubmitTransactionReplacementRequest{}),
+	reflect.TypeOf(protowire.CoinsecdMessage_GetFeeEstimateRequest{}),
+	reflect.TypeOf(protowire.CoinsecdMessage_SubmitTransactionPackageRequest{}),
+	reflect.TypeOf(protowire.CoinsecdMessage_GetMempoolInfoRequest{}),
+	reflect.TypeOf(protowire.CoinsecdMessage_GetTransactionRequest{}),
+	reflect.TypeOf(protowire.CoinsecdMessage_GetAddressHistoryRequest{}),
 
 	reflect.TypeOf(protowire.CoinsecdMessage_GetUtxosByAddressesRequest{}),
 	reflect.TypeOf(protowire.CoinsecdMessage_GetBalanceByAddressRequest{}),
@@ -41,6 +47,8 @@ var commandTypes = []reflect.Type{
 
 	reflect.TypeOf(protowire.CoinsecdMessage_BanRequest{}),
 	reflect.TypeOf(protowire.CoinsecdMessage_UnbanRequest{}),
+	reflect.TypeOf(protowire.CoinsecdMessage_GetRpcUsageRequest{}),
+	reflect.TypeOf(protowire.CoinsecdMessage_BackupDatabaseRequest{}),
 }
 
 type commandDescription struct {
@@ -19,6 +19,8 @@ type configFlags struct {
 	AllowConnectionToDifferentVersions bool   `short:"a" long:"allow-connection-to-different-versions" description:"Allow connections to versions different than coinsecctl's version'"`
 	CommandAndParameters               []string
 	config.NetworkFlags
+	config.RPCClientTLSFlags
+	config.RPCClientAuthFlags
 }
 
 func parseConfig() (*configFlags, error) {
@@ -28,7 +28,15 @@ func main() {
 	if err != nil {
 		printErrorAndExit(fmt.Sprintf("error parsing RPC server address: %s", err))
 	}
-	client, err := grpcclient.Connect(rpcAddress)
+	tlsConfig, err := cfg.RPCTLSConfig()
+	if err != nil {
+		printErrorAndExit(fmt.Sprintf("error parsing RPC TLS options: %s", err))
+	}
+	token, err := cfg.RPCAuthToken()
+	if err != nil {
+		printErrorAndExit(fmt.Sprintf("error parsing RPC token options: %s", err))
+	}
+	client, err := grpcclient.ConnectWithAuth(rpcAddress, tlsConfig, token)
 	if err != nil {
 		printErrorAndExit(fmt.Sprintf("error connecting to the RPC server: %s", err))
 	}
@@ -22,7 +22,15 @@ func (mc *minerClient) connect() error {
 	if err != nil {
 		return err
 	}
-	rpcClient, err := rpcclient.NewRPCClient(rpcAddress)
+	tlsConfig, err := mc.cfg.RPCTLSConfig()
+	if err != nil {
+		return err
+	}
+	token, err := mc.cfg.RPCAuthToken()
+	if err != nil {
+		return err
+	}
+	rpcClient, err := rpcclient.NewRPCClientWithAuth(rpcAddress, tlsConfig, token)
 	if err != nil {
 		return err
 	}
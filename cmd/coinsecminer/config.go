@@ -20,6 +20,7 @@ const (
 	defaultLogFilename          = "coinsecminer.log"
 	defaultErrLogFilename       = "coinsecminer_err.log"
 	defaultTargetBlockRateRatio = 2.0
+	defaultThreads              = 1
 )
 
 var (
@@ -38,12 +39,16 @@ type configFlags struct {
 	MineWhenNotSynced     bool     `long:"mine-when-not-synced" description:"Mine even if the node is not synced with the rest of the network."`
 	Profile               string   `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
 	TargetBlocksPerSecond *float64 `long:"target-blocks-per-second" description:"Sets a maximum block rate. 0 means no limit (The default one is 2 * target network block rate)"`
+	Threads               int      `short:"t" long:"threads" description:"Number of CPU threads to mine with"`
 	config.NetworkFlags
+	config.RPCClientTLSFlags
+	config.RPCClientAuthFlags
 }
 
 func parseConfig() (*configFlags, error) {
 	cfg := &configFlags{
 		RPCServer: defaultRPCServer,
+		Threads:   defaultThreads,
 	}
 	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
 	_, err := parser.Parse()
@@ -70,6 +75,10 @@ func parseConfig() (*configFlags, error) {
 		cfg.TargetBlocksPerSecond = &targetBlocksPerSecond
 	}
 
+	if cfg.Threads < 1 {
+		return nil, errors.New("--threads must be at least 1")
+	}
+
 	if cfg.Profile != "" {
 		profilePort, err := strconv.Atoi(cfg.Profile)
 		if err != nil || profilePort < 1024 || profilePort > 65535 {
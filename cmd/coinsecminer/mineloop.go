@@ -2,8 +2,11 @@ package main
 
 import (
 	nativeerrors "errors"
+	"fmt"
 	"github.com/wombatlabs/coinsecd/version"
+	"math"
 	"math/rand"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -17,12 +20,10 @@ import (
 	"github.com/pkg/errors"
 )
 
-var hashesTried uint64
-
 const logHashRateInterval = 10 * time.Second
 
 func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond float64, mineWhenNotSynced bool,
-	miningAddr util.Address) error {
+	miningAddr util.Address, threads int) error {
 	rand.Seed(time.Now().UnixNano()) // Seed the global concurrent-safe random source.
 
 	errChan := make(chan error)
@@ -38,6 +39,17 @@ func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond
 		templatesLoop(client, miningAddr, errChan)
 	})
 
+	workers := newMiningWorkers(threads)
+	minedBlockChan := make(chan *externalapi.DomainBlock)
+	for _, worker := range workers {
+		worker := worker
+		spawn(fmt.Sprintf("miningWorker-%d", worker.index), func() {
+			for {
+				minedBlockChan <- worker.mineNextBlock(mineWhenNotSynced)
+			}
+		})
+	}
+
 	spawn("blocksLoop", func() {
 		const windowSize = 10
 		hasBlockRateTarget := targetBlocksPerSecond != 0
@@ -57,7 +69,7 @@ func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond
 		}
 		windowStart := time.Now()
 		for blockIndex := 1; ; blockIndex++ {
-			foundBlockChan <- mineNextBlock(mineWhenNotSynced)
+			foundBlockChan <- <-minedBlockChan
 			if hasBlockRateTarget {
 				<-blockTicker.C
 				if (blockIndex % windowSize) == 0 {
@@ -82,7 +94,7 @@ func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond
 		doneChan <- struct{}{}
 	})
 
-	logHashRate()
+	logHashRate(workers)
 
 	select {
 	case err := <-errChan:
@@ -92,18 +104,28 @@ func mineLoop(client *minerClient, numberOfBlocks uint64, targetBlocksPerSecond
 	}
 }
 
-func logHashRate() {
+func logHashRate(workers []*miningWorker) {
 	spawn("logHashRate", func() {
 		lastCheck := time.Now()
 		for range time.Tick(logHashRateInterval) {
-			currentHashesTried := atomic.LoadUint64(&hashesTried)
 			currentTime := time.Now()
-			kiloHashesTried := float64(currentHashesTried) / 1000.0
-			hashRate := kiloHashesTried / currentTime.Sub(lastCheck).Seconds()
-			log.Infof("Current hash rate is %.2f Khash/s", hashRate)
+			elapsedSeconds := currentTime.Sub(lastCheck).Seconds()
+			totalHashRate := 0.0
+			workerHashRates := make([]string, len(workers))
+			for i, worker := range workers {
+				// Reset the worker's counter, so the next sample only counts new hashes
+				currentHashesTried := atomic.SwapUint64(&worker.hashesTried, 0)
+				hashRate := float64(currentHashesTried) / 1000.0 / elapsedSeconds
+				totalHashRate += hashRate
+				workerHashRates[i] = fmt.Sprintf("%.2f", hashRate)
+			}
+			if len(workers) == 1 {
+				log.Infof("Current hash rate is %.2f Khash/s", totalHashRate)
+			} else {
+				log.Infof("Current hash rate is %.2f Khash/s (per thread: %s Khash/s)",
+					totalHashRate, strings.Join(workerHashRates, ", "))
+			}
 			lastCheck = currentTime
-			// subtract from hashesTried the hashes we already sampled
-			atomic.AddUint64(&hashesTried, -currentHashesTried)
 		}
 	})
 }
@@ -134,28 +156,68 @@ func handleFoundBlock(client *minerClient, block *externalapi.DomainBlock) error
 	return nil
 }
 
-func mineNextBlock(mineWhenNotSynced bool) *externalapi.DomainBlock {
-	nonce := rand.Uint64() // Use the global concurrent-safe random source.
+// miningWorker mines on its own copy of the current template, trying only
+// nonces from its own range, so that workers never repeat each other's work
+type miningWorker struct {
+	// hashesTried is accessed atomically, so it's kept first for it to be
+	// 64-bit aligned on 32-bit platforms as well
+	hashesTried uint64
+
+	index           int
+	nonceRangeStart uint64
+	nonceRangeSize  uint64
+}
+
+// newMiningWorkers returns the given number of workers, splitting the nonce space evenly between them
+func newMiningWorkers(threads int) []*miningWorker {
+	nonceRangeSize := math.MaxUint64 / uint64(threads)
+	workers := make([]*miningWorker, threads)
+	for i := range workers {
+		workers[i] = &miningWorker{
+			index:           i,
+			nonceRangeStart: uint64(i) * nonceRangeSize,
+			nonceRangeSize:  nonceRangeSize,
+		}
+	}
+	return workers
+}
+
+func (w *miningWorker) mineNextBlock(mineWhenNotSynced bool) *externalapi.DomainBlock {
+	nonce := w.nonceRangeStart + rand.Uint64()%w.nonceRangeSize // Use the global concurrent-safe random source.
 	for {
-		nonce++
-		// For each nonce we try to build a block from the most up to date
-		// block template.
-		// In the rare case where the nonce space is exhausted for a specific
+		// The template ID is read before the template itself, so that a template
+		// set in between is noticed on the next nonce rather than missed.
+		templateID := templatemanager.ID()
+		block, state := getBlockForMining(mineWhenNotSynced)
+
+		// Keep working on this template until a new one arrives.
+		// In the rare case where the nonce range is exhausted for a specific
 		// block, it'll keep looping the nonce until a new block template
 		// is discovered.
-		block, state := getBlockForMining(mineWhenNotSynced)
-		state.Nonce = nonce
-		atomic.AddUint64(&hashesTried, 1)
-		if state.CheckProofOfWork() {
-			mutHeader := block.Header.ToMutable()
-			mutHeader.SetNonce(nonce)
-			block.Header = mutHeader.ToImmutable()
-			log.Infof("Found block %s with parents %s", consensushashing.BlockHash(block), block.Header.DirectParents())
-			return block
+		for templatemanager.ID() == templateID {
+			nonce = w.nextNonce(nonce)
+			state.Nonce = nonce
+			atomic.AddUint64(&w.hashesTried, 1)
+			if state.CheckProofOfWork() {
+				mutHeader := block.Header.ToMutable()
+				mutHeader.SetNonce(nonce)
+				block.Header = mutHeader.ToImmutable()
+				log.Infof("Found block %s with parents %s", consensushashing.BlockHash(block), block.Header.DirectParents())
+				return block
+			}
 		}
 	}
 }
 
+// nextNonce returns the nonce following the given one, wrapping around within the worker's nonce range
+func (w *miningWorker) nextNonce(nonce uint64) uint64 {
+	offset := nonce - w.nonceRangeStart + 1
+	if offset >= w.nonceRangeSize {
+		offset = 0
+	}
+	return w.nonceRangeStart + offset
+}
+
 func getBlockForMining(mineWhenNotSynced bool) (*externalapi.DomainBlock, *pow.State) {
 	tryCount := 0
 
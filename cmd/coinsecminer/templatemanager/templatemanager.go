@@ -5,6 +5,7 @@ import (
 	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
 	"github.com/wombatlabs/coinsecd/domain/consensus/utils/pow"
 	"sync"
+	"sync/atomic"
 )
 
 var currentTemplate *externalapi.DomainBlock
@@ -12,6 +13,10 @@ var currentState *pow.State
 var isSynced bool
 var lock = &sync.Mutex{}
 
+// templateID is incremented every time the template is replaced. It's read
+// without taking the lock, so that miners can cheaply notice a new template
+var templateID uint64
+
 // Get returns the template to work on
 func Get() (*externalapi.DomainBlock, *pow.State, bool) {
 	lock.Lock()
@@ -36,5 +41,12 @@ func Set(template *appmessage.GetBlockTemplateResponseMessage) error {
 	currentTemplate = block
 	currentState = pow.NewState(block.Header.ToMutable())
 	isSynced = template.IsSynced
+	atomic.AddUint64(&templateID, 1)
 	return nil
 }
+
+// ID returns an identifier of the current template, which
+// changes every time a new template is set
+func ID() uint64 {
+	return atomic.LoadUint64(&templateID)
+}
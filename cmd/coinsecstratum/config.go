@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wombatlabs/coinsecd/infrastructure/config"
+	"github.com/wombatlabs/coinsecd/infrastructure/logger"
+	"github.com/wombatlabs/coinsecd/util"
+	"github.com/wombatlabs/coinsecd/version"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultLogFilename     = "coinsecstratum.log"
+	defaultErrLogFilename  = "coinsecstratum_err.log"
+	defaultStratumListen   = ":5555"
+	defaultStartDifficulty = 1
+	defaultMinDifficulty   = 0.000001
+	defaultSharesPerMinute = 20
+	defaultLogLevel        = "info"
+)
+
+var (
+	// Default configuration options
+	defaultAppDir     = util.AppDir("coinsecstratum", false)
+	defaultLogFile    = filepath.Join(defaultAppDir, defaultLogFilename)
+	defaultErrLogFile = filepath.Join(defaultAppDir, defaultErrLogFilename)
+	defaultRPCServer  = "localhost"
+)
+
+type configFlags struct {
+	ShowVersion       bool    `short:"V" long:"version" description:"Display version information and exit"`
+	RPCServer         string  `short:"s" long:"rpcserver" description:"RPC server to connect to"`
+	StratumListen     string  `long:"stratumlisten" description:"Interface/port to listen for Stratum miners on (default all interfaces port: 5555)"`
+	MiningAddr        string  `long:"miningaddr" description:"Address to mine to"`
+	StartDifficulty   float64 `long:"startdiff" description:"Share difficulty that new workers start at. Difficulty 1 takes 2^32 hashes per share on average"`
+	MinDifficulty     float64 `long:"mindiff" description:"Lowest share difficulty that a worker may be assigned"`
+	SharesPerMinute   float64 `long:"sharesperminute" description:"Rate of shares per worker that the share difficulty is adjusted to"`
+	MineWhenNotSynced bool    `long:"mine-when-not-synced" description:"Hand out jobs even if the node is not synced with the rest of the network."`
+	LogLevel          string  `short:"d" long:"loglevel" description:"Set log level {trace, debug, info, warn, error, critical}"`
+	config.NetworkFlags
+	config.RPCClientTLSFlags
+	config.RPCClientAuthFlags
+}
+
+func parseConfig() (*configFlags, error) {
+	cfg := &configFlags{
+		RPCServer:       defaultRPCServer,
+		StratumListen:   defaultStratumListen,
+		StartDifficulty: defaultStartDifficulty,
+		MinDifficulty:   defaultMinDifficulty,
+		SharesPerMinute: defaultSharesPerMinute,
+		LogLevel:        defaultLogLevel,
+	}
+	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
+	_, err := parser.Parse()
+
+	// Show the version and exit if the version flag was specified.
+	if cfg.ShowVersion {
+		appName := filepath.Base(os.Args[0])
+		appName = strings.TrimSuffix(appName, filepath.Ext(appName))
+		fmt.Println(appName, "version", version.Version())
+		os.Exit(0)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = cfg.ResolveNetwork(parser)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.MiningAddr == "" {
+		return nil, errors.New("--miningaddr is required")
+	}
+
+	if cfg.MinDifficulty <= 0 || cfg.StartDifficulty < cfg.MinDifficulty {
+		return nil, errors.New("--mindiff must be positive and not above --startdiff")
+	}
+
+	if cfg.SharesPerMinute <= 0 {
+		return nil, errors.New("--sharesperminute must be positive")
+	}
+
+	logger.InitLog(defaultLogFile, defaultErrLogFile)
+	err = logger.SetLogLevelsString(cfg.LogLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
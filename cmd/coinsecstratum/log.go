@@ -0,0 +1,11 @@
+package main
+
+import (
+	"github.com/wombatlabs/coinsecd/infrastructure/logger"
+	"github.com/wombatlabs/coinsecd/util/panics"
+)
+
+var (
+	log   = logger.RegisterSubSystem("STRB")
+	spawn = panics.GoroutineWrapperFunc(log)
+)
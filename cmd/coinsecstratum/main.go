@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/wombatlabs/coinsecd/cmd/coinsecstratum/stratum"
+	"github.com/wombatlabs/coinsecd/infrastructure/os/signal"
+	"github.com/wombatlabs/coinsecd/util"
+	"github.com/wombatlabs/coinsecd/util/panics"
+	"github.com/wombatlabs/coinsecd/version"
+
+	"github.com/pkg/errors"
+)
+
+func main() {
+	defer panics.HandlePanic(log, "MAIN", nil)
+	interrupt := signal.InterruptListener()
+
+	cfg, err := parseConfig()
+	if err != nil {
+		printErrorAndExit(errors.Errorf("Error parsing command-line arguments: %s", err))
+	}
+
+	// Show version at startup.
+	log.Infof("Version %s", version.Version())
+
+	_, err = util.DecodeAddress(cfg.MiningAddr, cfg.ActiveNetParams.Prefix)
+	if err != nil {
+		printErrorAndExit(errors.Errorf("Error decoding mining address: %s", err))
+	}
+
+	client, newBlockTemplateChan, err := connectToNode(cfg)
+	if err != nil {
+		printErrorAndExit(errors.Wrap(err, "error connecting to the RPC server"))
+	}
+	defer client.Disconnect()
+
+	server := stratum.NewServer(&stratum.Config{
+		ListenAddress:         cfg.StratumListen,
+		MiningAddress:         cfg.MiningAddr,
+		ExtraData:             "coinsecstratum-" + version.Version(),
+		StartDifficulty:       cfg.StartDifficulty,
+		MinDifficulty:         cfg.MinDifficulty,
+		TargetSharesPerMinute: cfg.SharesPerMinute,
+		MineWhenNotSynced:     cfg.MineWhenNotSynced,
+	}, client)
+	err = server.Start()
+	if err != nil {
+		printErrorAndExit(err)
+	}
+	defer server.Stop()
+
+	errChan := make(chan error)
+	spawn("templatesLoop", func() {
+		templatesLoop(client, server, newBlockTemplateChan, errChan)
+	})
+
+	select {
+	case err := <-errChan:
+		log.Errorf("%+v", err)
+	case <-interrupt:
+	}
+}
+
+func printErrorAndExit(err error) {
+	fmt.Fprintf(os.Stderr, "%+v\n", err)
+	os.Exit(1)
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	nativeerrors "errors"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/cmd/coinsecstratum/stratum"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcclient"
+	"github.com/pkg/errors"
+)
+
+const nodeTimeout = 10 * time.Second
+
+func connectToNode(cfg *configFlags) (*rpcclient.RPCClient, chan struct{}, error) {
+	rpcAddress, err := cfg.NetParams().NormalizeRPCServerAddress(cfg.RPCServer)
+	if err != nil {
+		return nil, nil, err
+	}
+	tlsConfig, err := cfg.RPCTLSConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	token, err := cfg.RPCAuthToken()
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := rpcclient.NewRPCClientWithAuth(rpcAddress, tlsConfig, token)
+	if err != nil {
+		return nil, nil, err
+	}
+	client.SetTimeout(nodeTimeout)
+
+	newBlockTemplateChan := make(chan struct{}, 1)
+	err = client.RegisterForNewBlockTemplateNotifications(func(_ *appmessage.NewBlockTemplateNotificationMessage) {
+		select {
+		case newBlockTemplateChan <- struct{}{}:
+		default:
+		}
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error requesting new-block-template notifications")
+	}
+
+	log.Infof("Connected to %s", rpcAddress)
+	return client, newBlockTemplateChan, nil
+}
+
+// templatesLoop hands out a new job whenever the node has a new block template
+func templatesLoop(client *rpcclient.RPCClient, server *stratum.Server, newBlockTemplateChan <-chan struct{},
+	errChan chan<- error) {
+
+	ticker := time.NewTicker(stratum.TemplateRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		err := server.UpdateTemplate()
+		if nativeerrors.Is(err, router.ErrTimeout) {
+			log.Warnf("Got timeout while requesting block template from %s: %s", client.Address(), err)
+			err = client.Reconnect()
+			if err != nil {
+				errChan <- err
+				return
+			}
+		} else if nativeerrors.Is(err, router.ErrRouteClosed) {
+			log.Debugf("Got route is closed while requesting block template from %s. "+
+				"The client is most likely reconnecting", client.Address())
+		} else if err != nil {
+			errChan <- errors.Wrapf(err, "error updating the block template")
+			return
+		}
+
+		select {
+		case <-newBlockTemplateChan:
+			ticker.Reset(stratum.TemplateRefreshInterval)
+		case <-ticker.C:
+		}
+	}
+}
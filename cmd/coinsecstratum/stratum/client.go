@@ -0,0 +1,305 @@
+package stratum
+
+import (
+	"encoding/json"
+	"math/rand"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/pow"
+	"github.com/pkg/errors"
+)
+
+const (
+	dialTimeout    = 5 * time.Second
+	requestTimeout = 10 * time.Second
+)
+
+// Job is a unit of work received from a Stratum server
+type Job struct {
+	ID         string
+	PrePowHash *externalapi.DomainHash
+	Timestamp  int64
+	CleanJobs  bool
+
+	// Difficulty is the share difficulty that was in effect when the job was received
+	Difficulty float64
+}
+
+// Client is a minimal Stratum miner. It's used by the test miner, and
+// allows testing the server without any third-party mining software
+type Client struct {
+	connection *connection
+
+	nextRequestID   uint64
+	pendingRequests map[uint64]chan *incomingMessage
+	pendingMutex    sync.Mutex
+
+	extranonce uint16
+	difficulty float64
+	stateMutex sync.Mutex
+
+	jobs   chan *Job
+	closed chan struct{}
+}
+
+// Dial connects to the Stratum server at the given address
+func Dial(address string) (*Client, error) {
+	conn, err := net.DialTimeout("tcp", address, dialTimeout)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error connecting to %s", address)
+	}
+	client := &Client{
+		connection:      newConnection(conn),
+		pendingRequests: make(map[uint64]chan *incomingMessage),
+		jobs:            make(chan *Job, 1),
+		closed:          make(chan struct{}),
+	}
+	spawn("Client.readLoop", client.readLoop)
+	return client, nil
+}
+
+// Close disconnects from the server
+func (c *Client) Close() error {
+	return c.connection.close()
+}
+
+// Closed returns a channel that's closed once the connection to the server is lost
+func (c *Client) Closed() <-chan struct{} {
+	return c.closed
+}
+
+// Jobs returns a channel of the jobs sent by the server. Only the latest job is
+// kept, so a slow reader skips jobs that were superseded in the meantime
+func (c *Client) Jobs() <-chan *Job {
+	return c.jobs
+}
+
+// Extranonce returns the extranonce the server assigned on Subscribe
+func (c *Client) Extranonce() uint16 {
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+
+	return c.extranonce
+}
+
+// Subscribe subscribes to jobs from the server
+func (c *Client) Subscribe(userAgent string) error {
+	result, err := c.call(methodSubscribe, userAgent)
+	if err != nil {
+		return err
+	}
+	var fields []json.RawMessage
+	err = json.Unmarshal(result, &fields)
+	if err != nil || len(fields) < 3 {
+		return errors.Errorf("malformed subscribe result %s", result)
+	}
+	var extranonceString string
+	var serverExtranonce2Size int
+	err = json.Unmarshal(fields[1], &extranonceString)
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal(fields[2], &serverExtranonce2Size)
+	if err != nil {
+		return err
+	}
+	if serverExtranonce2Size != extranonce2Size {
+		return errors.Errorf("unsupported extranonce2 size %d", serverExtranonce2Size)
+	}
+	extranonce, err := strconv.ParseUint(extranonceString, 16, 16)
+	if err != nil {
+		return errors.Wrapf(err, "malformed extranonce %s", extranonceString)
+	}
+
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+	c.extranonce = uint16(extranonce)
+	return nil
+}
+
+// Authorize authorizes the given worker with the server
+func (c *Client) Authorize(workerName, password string) error {
+	result, err := c.call(methodAuthorize, workerName, password)
+	if err != nil {
+		return err
+	}
+	var isAuthorized bool
+	err = json.Unmarshal(result, &isAuthorized)
+	if err != nil || !isAuthorized {
+		return errors.Errorf("worker %s was not authorized", workerName)
+	}
+	return nil
+}
+
+// Submit submits a share for the given job. A rejected share is returned as an *Error
+func (c *Client) Submit(workerName, jobID string, nonce uint64) error {
+	_, err := c.call(methodSubmit, workerName, jobID, strconv.FormatUint(nonce, 16))
+	return err
+}
+
+// Mine searches the client's part of the nonce space for a share for the
+// given job, until one is found or abort is closed
+func (c *Client) Mine(job *Job, abort <-chan struct{}, hashesTried *uint64) (nonce uint64, found bool) {
+	const extranonce2Mask = 1<<(extranonce2Size*8) - 1
+	const abortCheckInterval = 1024
+
+	state := pow.NewStateFromPrePowHash(job.PrePowHash, job.Timestamp, DifficultyToTarget(job.Difficulty))
+	extranonce := uint64(c.Extranonce()) << (extranonce2Size * 8)
+	extranonce2 := rand.Uint64() & extranonce2Mask
+	for i := 0; ; i++ {
+		if i%abortCheckInterval == 0 {
+			select {
+			case <-abort:
+				return 0, false
+			default:
+			}
+		}
+		extranonce2 = (extranonce2 + 1) & extranonce2Mask
+		state.Nonce = extranonce | extranonce2
+		atomic.AddUint64(hashesTried, 1)
+		if state.CheckProofOfWork() {
+			return state.Nonce, true
+		}
+	}
+}
+
+func (c *Client) call(method string, params ...interface{}) (json.RawMessage, error) {
+	responseChan := make(chan *incomingMessage, 1)
+	c.pendingMutex.Lock()
+	c.nextRequestID++
+	requestID := c.nextRequestID
+	c.pendingRequests[requestID] = responseChan
+	c.pendingMutex.Unlock()
+	defer func() {
+		c.pendingMutex.Lock()
+		defer c.pendingMutex.Unlock()
+		delete(c.pendingRequests, requestID)
+	}()
+
+	err := c.connection.write(&request{
+		ID:     json.RawMessage(strconv.FormatUint(requestID, 10)),
+		Method: method,
+		Params: params,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case response := <-responseChan:
+		if response.Error != nil {
+			return nil, response.Error
+		}
+		return response.Result, nil
+	case <-c.closed:
+		return nil, errors.Errorf("connection closed while waiting for a response to %s", method)
+	case <-time.After(requestTimeout):
+		return nil, errors.Errorf("timeout waiting for a response to %s", method)
+	}
+}
+
+func (c *Client) readLoop() {
+	defer close(c.closed)
+	for {
+		message, err := c.connection.read()
+		if err != nil {
+			log.Debugf("Error reading from the stratum server: %s", err)
+			return
+		}
+		if message.Method != "" {
+			err = c.handleNotification(message)
+			if err != nil {
+				log.Warnf("Error handling %s: %s", message.Method, err)
+			}
+			continue
+		}
+
+		requestID, err := strconv.ParseUint(string(message.ID), 10, 64)
+		if err != nil {
+			log.Warnf("Got a response with an unexpected ID %s", message.ID)
+			continue
+		}
+		c.pendingMutex.Lock()
+		responseChan, ok := c.pendingRequests[requestID]
+		c.pendingMutex.Unlock()
+		if ok {
+			responseChan <- message
+		}
+	}
+}
+
+func (c *Client) handleNotification(message *incomingMessage) error {
+	switch message.Method {
+	case methodSetDifficulty:
+		var difficulty float64
+		if len(message.Params) < 1 || json.Unmarshal(message.Params[0], &difficulty) != nil {
+			return errors.New("expected a difficulty")
+		}
+		c.stateMutex.Lock()
+		defer c.stateMutex.Unlock()
+		c.difficulty = difficulty
+		return nil
+	case methodNotify:
+		job, err := c.parseJob(message.Params)
+		if err != nil {
+			return err
+		}
+		c.setLatestJob(job)
+		return nil
+	default:
+		return errors.Errorf("unsupported notification %s", message.Method)
+	}
+}
+
+func (c *Client) parseJob(params []json.RawMessage) (*Job, error) {
+	if len(params) < 4 {
+		return nil, errors.New("expected job ID, pre-PoW hash, timestamp and clean jobs flag")
+	}
+	job := &Job{}
+	var prePowHashString string
+	err := json.Unmarshal(params[0], &job.ID)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(params[1], &prePowHashString)
+	if err != nil {
+		return nil, err
+	}
+	job.PrePowHash, err = externalapi.NewDomainHashFromString(prePowHashString)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(params[2], &job.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(params[3], &job.CleanJobs)
+	if err != nil {
+		return nil, err
+	}
+
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+	job.Difficulty = c.difficulty
+	return job, nil
+}
+
+// setLatestJob replaces any job that wasn't picked up yet with the given one
+func (c *Client) setLatestJob(job *Job) {
+	for {
+		select {
+		case c.jobs <- job:
+			return
+		default:
+		}
+		select {
+		case <-c.jobs:
+		default:
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package stratum
+
+import (
+	"math/big"
+)
+
+var (
+	// maxTarget is the largest possible target, which every hash meets
+	maxTarget = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	// difficultyOneTarget is the share target at difficulty 1. Meeting it takes
+	// 2^32 hashes on average
+	difficultyOneTarget = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 224), big.NewInt(1))
+)
+
+// hashesPerDifficultyOne is the average number of hashes it takes to find a share at difficulty 1
+const hashesPerDifficultyOne = 1 << 32
+
+// DifficultyToTarget returns the share target for the given share difficulty
+func DifficultyToTarget(difficulty float64) *big.Int {
+	if difficulty <= 0 {
+		return new(big.Int).Set(maxTarget)
+	}
+	targetFloat := new(big.Float).SetInt(difficultyOneTarget)
+	targetFloat.Quo(targetFloat, big.NewFloat(difficulty))
+	target, _ := targetFloat.Int(nil)
+	if target.Cmp(maxTarget) > 0 {
+		return new(big.Int).Set(maxTarget)
+	}
+	return target
+}
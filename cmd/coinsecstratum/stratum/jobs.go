@@ -0,0 +1,119 @@
+package stratum
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/pow"
+)
+
+// TemplateRefreshInterval is how often the block template should be refreshed
+// when no new-block-template notification arrives
+const TemplateRefreshInterval = time.Second
+
+// jobShareWindow is how long shares are still accepted for a job after it's
+// handed out, so that shares of miners that are slow to switch jobs aren't lost
+const jobShareWindow = 30 * time.Second
+
+// maxJobs is the number of most recent jobs that shares are still accepted for.
+// It covers jobShareWindow when jobs are handed out every TemplateRefreshInterval
+const maxJobs = int(jobShareWindow / TemplateRefreshInterval)
+
+// job is a block template handed out to miners
+type job struct {
+	id    string
+	block *externalapi.DomainBlock
+	state *pow.State
+
+	submittedNonces      map[uint64]struct{}
+	submittedNoncesMutex sync.Mutex
+}
+
+// markSubmitted records the given nonce as submitted for this job,
+// and returns false if it had already been submitted
+func (j *job) markSubmitted(nonce uint64) bool {
+	j.submittedNoncesMutex.Lock()
+	defer j.submittedNoncesMutex.Unlock()
+
+	if _, ok := j.submittedNonces[nonce]; ok {
+		return false
+	}
+	j.submittedNonces[nonce] = struct{}{}
+	return true
+}
+
+// blockWithNonce returns a copy of the job's block with the given nonce
+func (j *job) blockWithNonce(nonce uint64) *externalapi.DomainBlock {
+	mutableHeader := j.block.Header.ToMutable()
+	mutableHeader.SetNonce(nonce)
+	return &externalapi.DomainBlock{
+		Header:       mutableHeader.ToImmutable(),
+		Transactions: j.block.Transactions,
+	}
+}
+
+// notifyParams returns the params of the mining.notify message for this job
+func (j *job) notifyParams(cleanJobs bool) []interface{} {
+	return []interface{}{j.id, j.state.PrePowHash().String(), j.state.Timestamp, cleanJobs}
+}
+
+// jobManager keeps track of the most recent jobs
+type jobManager struct {
+	nextJobID uint64
+	jobs      map[string]*job
+	jobIDs    []string
+	lastJob   *job
+	mutex     sync.RWMutex
+}
+
+func newJobManager() *jobManager {
+	return &jobManager{
+		jobs: make(map[string]*job),
+	}
+}
+
+// add creates a new job from the given block template. cleanJobs is set
+// when the template builds on different parents than the previous one, meaning
+// work on previous jobs is no longer worth continuing
+func (jm *jobManager) add(block *externalapi.DomainBlock) (newJob *job, cleanJobs bool) {
+	jm.mutex.Lock()
+	defer jm.mutex.Unlock()
+
+	jm.nextJobID++
+	newJob = &job{
+		id:              strconv.FormatUint(jm.nextJobID, 16),
+		block:           block,
+		state:           pow.NewState(block.Header.ToMutable()),
+		submittedNonces: make(map[uint64]struct{}),
+	}
+
+	cleanJobs = jm.lastJob == nil ||
+		!externalapi.HashesEqual(jm.lastJob.block.Header.DirectParents(), block.Header.DirectParents())
+
+	jm.jobs[newJob.id] = newJob
+	jm.jobIDs = append(jm.jobIDs, newJob.id)
+	if len(jm.jobIDs) > maxJobs {
+		delete(jm.jobs, jm.jobIDs[0])
+		jm.jobIDs = jm.jobIDs[1:]
+	}
+	jm.lastJob = newJob
+
+	return newJob, cleanJobs
+}
+
+func (jm *jobManager) get(jobID string) (*job, bool) {
+	jm.mutex.RLock()
+	defer jm.mutex.RUnlock()
+
+	job, ok := jm.jobs[jobID]
+	return job, ok
+}
+
+func (jm *jobManager) last() *job {
+	jm.mutex.RLock()
+	defer jm.mutex.RUnlock()
+
+	return jm.lastJob
+}
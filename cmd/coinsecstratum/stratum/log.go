@@ -0,0 +1,9 @@
+package stratum
+
+import (
+	"github.com/wombatlabs/coinsecd/infrastructure/logger"
+	"github.com/wombatlabs/coinsecd/util/panics"
+)
+
+var log = logger.RegisterSubSystem("STRM")
+var spawn = panics.GoroutineWrapperFunc(log)
@@ -0,0 +1,155 @@
+package stratum
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The Stratum methods that are supported by the server
+const (
+	methodSubscribe     = "mining.subscribe"
+	methodAuthorize     = "mining.authorize"
+	methodSubmit        = "mining.submit"
+	methodNotify        = "mining.notify"
+	methodSetDifficulty = "mining.set_difficulty"
+)
+
+// maxMessageSize is the maximum size of a single line-delimited Stratum message
+const maxMessageSize = 64 * 1024
+
+// writeTimeout is how long a write may block before the connection is considered dead
+const writeTimeout = 10 * time.Second
+
+// The error codes returned for failed Stratum requests
+const (
+	ErrCodeOther             = 20
+	ErrCodeJobNotFound       = 21
+	ErrCodeDuplicateShare    = 22
+	ErrCodeLowDifficulty     = 23
+	ErrCodeUnauthorized      = 24
+	ErrCodeNotSubscribed     = 25
+	ErrCodeInvalidExtranonce = 26
+)
+
+// Error is a Stratum error. On the wire it's encoded as [code, message, null]
+type Error struct {
+	Code    int
+	Message string
+}
+
+func newError(code int, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// MarshalJSON implements json.Marshaler
+func (e *Error) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{e.Code, e.Message, nil})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (e *Error) UnmarshalJSON(data []byte) error {
+	var fields []json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+	if len(fields) < 2 {
+		return errors.Errorf("malformed stratum error %s", data)
+	}
+	err = json.Unmarshal(fields[0], &e.Code)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(fields[1], &e.Message)
+}
+
+// request is a Stratum request. Notifications are requests with a null ID
+type request struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params []interface{}   `json:"params"`
+}
+
+// response is the reply to a Stratum request
+type response struct {
+	ID     json.RawMessage `json:"id"`
+	Result interface{}     `json:"result"`
+	Error  *Error          `json:"error"`
+}
+
+// incomingMessage is any message read off a Stratum connection. Requests and
+// notifications have a Method, while responses have a Result or an Error
+type incomingMessage struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+	Result json.RawMessage   `json:"result"`
+	Error  *Error            `json:"error"`
+}
+
+// connection reads and writes line-delimited JSON messages over a net.Conn
+type connection struct {
+	conn       net.Conn
+	scanner    *bufio.Scanner
+	writeMutex sync.Mutex
+}
+
+func newConnection(conn net.Conn) *connection {
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 0, 4096), maxMessageSize)
+	return &connection{
+		conn:    conn,
+		scanner: scanner,
+	}
+}
+
+func (c *connection) read() (*incomingMessage, error) {
+	for c.scanner.Scan() {
+		line := c.scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		message := &incomingMessage{}
+		err := json.Unmarshal(line, message)
+		if err != nil {
+			return nil, errors.Wrapf(err, "malformed stratum message %q", line)
+		}
+		return message, nil
+	}
+	err := c.scanner.Err()
+	if err == nil {
+		return nil, io.EOF
+	}
+	return nil, err
+}
+
+func (c *connection) write(message interface{}) error {
+	serialized, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	serialized = append(serialized, '\n')
+
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	err = c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err != nil {
+		return err
+	}
+	_, err = c.conn.Write(serialized)
+	return err
+}
+
+func (c *connection) close() error {
+	return c.conn.Close()
+}
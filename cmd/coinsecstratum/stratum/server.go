@@ -0,0 +1,217 @@
+package stratum
+
+import (
+	"math"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+// Node is the coinsecd node that the server gets block templates from and submits solved blocks to
+type Node interface {
+	GetBlockTemplate(miningAddress, extraData string) (*appmessage.GetBlockTemplateResponseMessage, error)
+	SubmitBlock(block *externalapi.DomainBlock) (appmessage.RejectReason, error)
+}
+
+// Config is the configuration of a Server
+type Config struct {
+	// ListenAddress is the address Stratum miners connect to
+	ListenAddress string
+
+	// MiningAddress is the address that block rewards are paid to
+	MiningAddress string
+
+	// ExtraData is added to the coinbase of every block template
+	ExtraData string
+
+	// StartDifficulty is the share difficulty a new worker starts at
+	StartDifficulty float64
+
+	// MinDifficulty is the lowest share difficulty that vardiff may assign
+	MinDifficulty float64
+
+	// TargetSharesPerMinute is the rate of shares per worker that vardiff aims for
+	TargetSharesPerMinute float64
+
+	// MineWhenNotSynced allows handing out jobs while the node is not synced
+	MineWhenNotSynced bool
+}
+
+// Server is a Stratum v1 server that hands out block templates from
+// a coinsecd node to miners, and forwards their solutions back to it
+type Server struct {
+	cfg      *Config
+	node     Node
+	jobs     *jobManager
+	listener net.Listener
+
+	sessions       map[uint16]*session
+	nextExtranonce uint16
+	sessionsMutex  sync.Mutex
+
+	wasSynced  bool
+	isStopping int32
+}
+
+// NewServer creates a new Stratum server. Use Start to begin accepting miners
+func NewServer(cfg *Config, node Node) *Server {
+	return &Server{
+		cfg:       cfg,
+		node:      node,
+		jobs:      newJobManager(),
+		sessions:  make(map[uint16]*session),
+		wasSynced: true,
+	}
+}
+
+// Start starts listening for Stratum connections
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.cfg.ListenAddress)
+	if err != nil {
+		return errors.Wrapf(err, "error listening on %s", s.cfg.ListenAddress)
+	}
+	s.listener = listener
+	log.Infof("Stratum server listening on %s", listener.Addr())
+
+	spawn("Server.acceptLoop", s.acceptLoop)
+	return nil
+}
+
+// Address returns the address the server is listening on
+func (s *Server) Address() net.Addr {
+	return s.listener.Addr()
+}
+
+// Stop stops accepting connections and disconnects all miners
+func (s *Server) Stop() error {
+	if !atomic.CompareAndSwapInt32(&s.isStopping, 0, 1) {
+		return nil
+	}
+	err := s.listener.Close()
+
+	s.sessionsMutex.Lock()
+	defer s.sessionsMutex.Unlock()
+	for _, session := range s.sessions {
+		_ = session.connection.close()
+	}
+	return err
+}
+
+func (s *Server) acceptLoop() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if atomic.LoadInt32(&s.isStopping) == 1 {
+				return
+			}
+			log.Warnf("Error accepting stratum connection: %s", err)
+			continue
+		}
+
+		session, err := s.newSession(conn)
+		if err != nil {
+			log.Warnf("Rejecting stratum connection from %s: %s", conn.RemoteAddr(), err)
+			_ = conn.Close()
+			continue
+		}
+		spawn("session.handle", session.handle)
+	}
+}
+
+// newSession creates a session for the given connection, assigning it an extranonce
+// that no other session holds, so that miners never search the same nonces
+func (s *Server) newSession(conn net.Conn) (*session, error) {
+	s.sessionsMutex.Lock()
+	defer s.sessionsMutex.Unlock()
+
+	if len(s.sessions) > math.MaxUint16 {
+		return nil, errors.New("no free extranonces left")
+	}
+	for {
+		extranonce := s.nextExtranonce
+		s.nextExtranonce++
+		if _, ok := s.sessions[extranonce]; ok {
+			continue
+		}
+
+		session := newSession(s, conn, extranonce)
+		s.sessions[extranonce] = session
+		return session, nil
+	}
+}
+
+func (s *Server) removeSession(session *session) {
+	s.sessionsMutex.Lock()
+	defer s.sessionsMutex.Unlock()
+
+	delete(s.sessions, session.extranonce)
+}
+
+// UpdateTemplate gets a new block template from the node and sends it to all miners as a new job
+func (s *Server) UpdateTemplate() error {
+	template, err := s.node.GetBlockTemplate(s.cfg.MiningAddress, s.cfg.ExtraData)
+	if err != nil {
+		return err
+	}
+	if !template.IsSynced && !s.cfg.MineWhenNotSynced {
+		if s.wasSynced {
+			log.Warnf("Coinsecd is not synced. Not handing out jobs until it is")
+		}
+		s.wasSynced = false
+		return nil
+	}
+	s.wasSynced = true
+
+	block, err := appmessage.RPCBlockToDomainBlock(template.Block)
+	if err != nil {
+		return err
+	}
+	newJob, cleanJobs := s.jobs.add(block)
+
+	// The job is sent to every session concurrently and outside sessionsMutex, so that
+	// a miner that doesn't read its connection delays neither the others nor new connections
+	sessions := s.sessionsSnapshot()
+	waitGroup := sync.WaitGroup{}
+	waitGroup.Add(len(sessions))
+	for _, session := range sessions {
+		session := session
+		spawn("session.sendJob", func() {
+			defer waitGroup.Done()
+
+			err := session.sendJob(newJob, cleanJobs)
+			if err != nil {
+				log.Debugf("Error sending job to %s: %s", session, err)
+				_ = session.connection.close()
+			}
+		})
+	}
+	waitGroup.Wait()
+	return nil
+}
+
+func (s *Server) sessionsSnapshot() []*session {
+	s.sessionsMutex.Lock()
+	defer s.sessionsMutex.Unlock()
+
+	sessions := make([]*session, 0, len(s.sessions))
+	for _, session := range s.sessions {
+		sessions = append(sessions, session)
+	}
+	return sessions
+}
+
+// submitBlock forwards a solved block to the node
+func (s *Server) submitBlock(block *externalapi.DomainBlock) error {
+	rejectReason, err := s.node.SubmitBlock(block)
+	if err != nil {
+		if rejectReason != appmessage.RejectReasonNone {
+			return errors.Wrapf(err, "block rejected (%s)", rejectReason)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package stratum
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/blockheader"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/pow"
+	"github.com/pkg/errors"
+)
+
+// fakeNode serves block templates with the given bits, and records submitted blocks
+type fakeNode struct {
+	bits            uint32
+	parent          *externalapi.DomainHash
+	submittedBlocks []*externalapi.DomainBlock
+	mutex           sync.Mutex
+}
+
+func (n *fakeNode) setParent(parent *externalapi.DomainHash) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.parent = parent
+}
+
+func (n *fakeNode) GetBlockTemplate(_, _ string) (*appmessage.GetBlockTemplateResponseMessage, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	header := blockheader.NewImmutableBlockHeader(
+		0,
+		[]externalapi.BlockLevelParents{{n.parent}},
+		externalapi.NewZeroHash(),
+		externalapi.NewZeroHash(),
+		externalapi.NewZeroHash(),
+		time.Now().UnixMilli(),
+		n.bits,
+		0,
+		0,
+		0,
+		big.NewInt(0),
+		externalapi.NewZeroHash(),
+	)
+	block := &externalapi.DomainBlock{Header: header, Transactions: []*externalapi.DomainTransaction{}}
+	return &appmessage.GetBlockTemplateResponseMessage{
+		Block:    appmessage.DomainBlockToRPCBlock(block),
+		IsSynced: true,
+	}, nil
+}
+
+func (n *fakeNode) SubmitBlock(block *externalapi.DomainBlock) (appmessage.RejectReason, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.submittedBlocks = append(n.submittedBlocks, block)
+	return appmessage.RejectReasonNone, nil
+}
+
+func (n *fakeNode) blocks() []*externalapi.DomainBlock {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return append([]*externalapi.DomainBlock{}, n.submittedBlocks...)
+}
+
+const (
+	// easyBits is a target so easy that practically every hash solves the block
+	easyBits = 0x2100ffff
+
+	// hardBits is a target that no test is expected to meet
+	hardBits = 0x1a00ffff
+)
+
+func setupServer(t *testing.T, bits uint32, startDifficulty float64) (*Server, *fakeNode) {
+	node := &fakeNode{bits: bits, parent: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{1})}
+	server := NewServer(&Config{
+		ListenAddress:         "127.0.0.1:0",
+		StartDifficulty:       startDifficulty,
+		MinDifficulty:         startDifficulty,
+		TargetSharesPerMinute: 20,
+	}, node)
+	err := server.Start()
+	if err != nil {
+		t.Fatalf("Start: %+v", err)
+	}
+	t.Cleanup(func() { server.Stop() })
+
+	err = server.UpdateTemplate()
+	if err != nil {
+		t.Fatalf("UpdateTemplate: %+v", err)
+	}
+	return server, node
+}
+
+func connectMiner(t *testing.T, server *Server, workerName string) *Client {
+	client, err := Dial(server.Address().String())
+	if err != nil {
+		t.Fatalf("Dial: %+v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	err = client.Subscribe("test")
+	if err != nil {
+		t.Fatalf("Subscribe: %+v", err)
+	}
+	err = client.Authorize(workerName, "")
+	if err != nil {
+		t.Fatalf("Authorize: %+v", err)
+	}
+	return client
+}
+
+func receiveJob(t *testing.T, client *Client) *Job {
+	select {
+	case job := <-client.Jobs():
+		return job
+	case <-time.After(10 * time.Second):
+		t.Fatalf("timeout waiting for a job")
+	}
+	return nil
+}
+
+func requireStratumError(t *testing.T, err error, expectedCode int) {
+	stratumErr := &Error{}
+	if !errors.As(err, &stratumErr) {
+		t.Fatalf("expected a stratum error with code %d, got: %v", expectedCode, err)
+	}
+	if stratumErr.Code != expectedCode {
+		t.Fatalf("expected error code %d, got %d (%s)", expectedCode, stratumErr.Code, stratumErr.Message)
+	}
+}
+
+func TestSubmitBlock(t *testing.T) {
+	server, node := setupServer(t, easyBits, 1e-9)
+	client := connectMiner(t, server, "worker")
+	job := receiveJob(t, client)
+
+	hashesTried := uint64(0)
+	nonce, found := client.Mine(job, make(chan struct{}), &hashesTried)
+	if !found {
+		t.Fatalf("Mine didn't find a share")
+	}
+	err := client.Submit("worker", job.ID, nonce)
+	if err != nil {
+		t.Fatalf("Submit: %+v", err)
+	}
+
+	blocks := node.blocks()
+	if len(blocks) != 1 {
+		t.Fatalf("expected 1 submitted block, got %d", len(blocks))
+	}
+	if blocks[0].Header.Nonce() != nonce {
+		t.Fatalf("expected the submitted block to have nonce %x, got %x", nonce, blocks[0].Header.Nonce())
+	}
+	if !pow.NewState(blocks[0].Header.ToMutable()).CheckProofOfWork() {
+		t.Fatalf("the submitted block doesn't have a valid proof of work")
+	}
+
+	err = client.Submit("worker", job.ID, nonce)
+	requireStratumError(t, err, ErrCodeDuplicateShare)
+
+	err = client.Submit("worker", "unknown", nonce+1)
+	requireStratumError(t, err, ErrCodeJobNotFound)
+
+	otherExtranonce := uint64(client.Extranonce()+1) << (extranonce2Size * 8)
+	err = client.Submit("worker", job.ID, otherExtranonce|1)
+	requireStratumError(t, err, ErrCodeInvalidExtranonce)
+}
+
+func TestLowDifficultyShare(t *testing.T) {
+	server, node := setupServer(t, hardBits, 1e9)
+	client := connectMiner(t, server, "worker")
+	job := receiveJob(t, client)
+
+	// The odds of a nonce meeting difficulty 1e9 are negligible
+	nonce := uint64(client.Extranonce()) << (extranonce2Size * 8)
+	err := client.Submit("worker", job.ID, nonce)
+	requireStratumError(t, err, ErrCodeLowDifficulty)
+
+	if len(node.blocks()) != 0 {
+		t.Fatalf("expected no submitted blocks")
+	}
+}
+
+func TestJobs(t *testing.T) {
+	server, node := setupServer(t, hardBits, 1)
+	firstClient := connectMiner(t, server, "first")
+	secondClient := connectMiner(t, server, "second")
+
+	if firstClient.Extranonce() == secondClient.Extranonce() {
+		t.Fatalf("both miners got extranonce %x", firstClient.Extranonce())
+	}
+
+	firstJob := receiveJob(t, firstClient)
+	receiveJob(t, secondClient)
+
+	// A template with the same parents doesn't invalidate previous jobs
+	err := server.UpdateTemplate()
+	if err != nil {
+		t.Fatalf("UpdateTemplate: %+v", err)
+	}
+	job := receiveJob(t, firstClient)
+	if job.ID == firstJob.ID || job.CleanJobs {
+		t.Fatalf("expected a new job that doesn't clean previous jobs, got %+v", job)
+	}
+	receiveJob(t, secondClient)
+
+	node.setParent(externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{2}))
+	err = server.UpdateTemplate()
+	if err != nil {
+		t.Fatalf("UpdateTemplate: %+v", err)
+	}
+	job = receiveJob(t, firstClient)
+	if !job.CleanJobs {
+		t.Fatalf("expected a job with new parents to clean previous jobs")
+	}
+	job = receiveJob(t, secondClient)
+	if !job.CleanJobs {
+		t.Fatalf("expected all miners to get the new job")
+	}
+}
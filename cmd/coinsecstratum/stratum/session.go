@@ -0,0 +1,305 @@
+package stratum
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	"github.com/pkg/errors"
+)
+
+// extranonceSize is the size in bytes of the per-session extranonce, which
+// makes up the most significant bytes of the nonce
+const extranonceSize = 2
+
+// extranonce2Size is the size in bytes of the part of the nonce that miners search
+const extranonce2Size = 8 - extranonceSize
+
+// session is a single miner's connection to the server
+type session struct {
+	server     *Server
+	connection *connection
+	extranonce uint16
+
+	isSubscribed       bool
+	workerName         string
+	varDiff            *varDiff
+	difficulty         float64
+	previousDifficulty float64
+	validShares        uint64
+	invalidShares      uint64
+	blocksFound        uint64
+	mutex              sync.Mutex
+}
+
+func newSession(server *Server, conn net.Conn, extranonce uint16) *session {
+	now := time.Now()
+	varDiff := newVarDiff(server.cfg.StartDifficulty, server.cfg.MinDifficulty, server.cfg.TargetSharesPerMinute, now)
+	return &session{
+		server:             server,
+		connection:         newConnection(conn),
+		extranonce:         extranonce,
+		varDiff:            varDiff,
+		difficulty:         varDiff.difficulty,
+		previousDifficulty: varDiff.difficulty,
+	}
+}
+
+func (s *session) String() string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.workerName == "" {
+		return s.connection.conn.RemoteAddr().String()
+	}
+	return fmt.Sprintf("%s (%s)", s.workerName, s.connection.conn.RemoteAddr())
+}
+
+func (s *session) handle() {
+	log.Infof("Stratum miner connected from %s", s.connection.conn.RemoteAddr())
+	defer func() {
+		s.server.removeSession(s)
+		_ = s.connection.close()
+
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		log.Infof("Stratum miner %s disconnected. Valid shares: %d, invalid shares: %d, blocks found: %d",
+			s.connection.conn.RemoteAddr(), s.validShares, s.invalidShares, s.blocksFound)
+	}()
+
+	for {
+		message, err := s.connection.read()
+		if err != nil {
+			log.Debugf("Error reading from %s: %s", s.connection.conn.RemoteAddr(), err)
+			return
+		}
+		if message.Method == "" {
+			// Miners aren't expected to send anything but requests
+			continue
+		}
+
+		err = s.handleRequest(message)
+		if err != nil {
+			log.Debugf("Error handling %s from %s: %s", message.Method, s.connection.conn.RemoteAddr(), err)
+			return
+		}
+	}
+}
+
+func (s *session) handleRequest(message *incomingMessage) error {
+	var result interface{}
+	var stratumErr *Error
+	switch message.Method {
+	case methodSubscribe:
+		result, stratumErr = s.handleSubscribe()
+	case methodAuthorize:
+		result, stratumErr = s.handleAuthorize(message.Params)
+	case methodSubmit:
+		result, stratumErr = s.handleSubmit(message.Params)
+	default:
+		stratumErr = newError(ErrCodeOther, fmt.Sprintf("unsupported method %s", message.Method))
+	}
+
+	err := s.connection.write(&response{ID: message.ID, Result: result, Error: stratumErr})
+	if err != nil {
+		return err
+	}
+
+	// A newly authorized miner has nothing to work on until it gets a job
+	if message.Method == methodAuthorize && stratumErr == nil {
+		return s.sendInitialWork()
+	}
+	return nil
+}
+
+func (s *session) handleSubscribe() (interface{}, *Error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.isSubscribed = true
+	extranonce := fmt.Sprintf("%0*x", extranonceSize*2, s.extranonce)
+	subscriptions := [][]string{{methodNotify, extranonce}}
+	return []interface{}{subscriptions, extranonce, extranonce2Size}, nil
+}
+
+func (s *session) handleAuthorize(params []json.RawMessage) (interface{}, *Error) {
+	var workerName string
+	if len(params) < 1 || json.Unmarshal(params[0], &workerName) != nil || workerName == "" {
+		return nil, newError(ErrCodeOther, "expected a worker name")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.isSubscribed {
+		return nil, newError(ErrCodeNotSubscribed, "not subscribed")
+	}
+	s.workerName = workerName
+	log.Infof("Stratum worker %s authorized from %s", workerName, s.connection.conn.RemoteAddr())
+	return true, nil
+}
+
+func (s *session) sendInitialWork() error {
+	err := s.sendDifficulty()
+	if err != nil {
+		return err
+	}
+	lastJob := s.server.jobs.last()
+	if lastJob == nil {
+		return nil
+	}
+	return s.sendJob(lastJob, true)
+}
+
+func (s *session) isAuthorized() bool {
+	return s.isSubscribed && s.workerName != ""
+}
+
+func (s *session) sendDifficulty() error {
+	s.mutex.Lock()
+	difficulty := s.difficulty
+	s.mutex.Unlock()
+
+	return s.connection.write(&request{
+		ID:     json.RawMessage("null"),
+		Method: methodSetDifficulty,
+		Params: []interface{}{difficulty},
+	})
+}
+
+// sendJob sends the given job to the miner, if it's ready for work. Shares
+// at the difficulty preceding the last change are accepted only until the
+// miner gets a new job
+func (s *session) sendJob(newJob *job, cleanJobs bool) error {
+	s.mutex.Lock()
+	if !s.isAuthorized() {
+		s.mutex.Unlock()
+		return nil
+	}
+	s.previousDifficulty = s.difficulty
+	// Retarget here as well, so that a worker that doesn't find any
+	// shares at its current difficulty gets an easier one
+	_, difficultyChanged := s.retarget(s.varDiff.retarget(time.Now()))
+	s.mutex.Unlock()
+
+	if difficultyChanged {
+		err := s.sendDifficulty()
+		if err != nil {
+			return err
+		}
+	}
+	return s.connection.write(&request{
+		ID:     json.RawMessage("null"),
+		Method: methodNotify,
+		Params: newJob.notifyParams(cleanJobs),
+	})
+}
+
+// retarget applies a difficulty returned by varDiff. It must be called with the mutex held
+func (s *session) retarget(newDifficulty float64, changed bool) (float64, bool) {
+	if !changed {
+		return s.difficulty, false
+	}
+	log.Debugf("Changing the difficulty of %s from %g to %g", s.workerName, s.difficulty, newDifficulty)
+	s.difficulty = newDifficulty
+	return newDifficulty, true
+}
+
+func (s *session) handleSubmit(params []json.RawMessage) (interface{}, *Error) {
+	accepted, stratumErr := s.validateAndSubmitShare(params)
+
+	s.mutex.Lock()
+	if !accepted {
+		s.invalidShares++
+		s.mutex.Unlock()
+		return nil, stratumErr
+	}
+	s.validShares++
+	_, difficultyChanged := s.retarget(s.varDiff.addShare(time.Now()))
+	s.mutex.Unlock()
+
+	if difficultyChanged {
+		err := s.sendDifficulty()
+		if err != nil {
+			return nil, newError(ErrCodeOther, err.Error())
+		}
+	}
+	return true, nil
+}
+
+func (s *session) validateAndSubmitShare(params []json.RawMessage) (bool, *Error) {
+	s.mutex.Lock()
+	isAuthorized := s.isAuthorized()
+	shareDifficulty := math.Min(s.difficulty, s.previousDifficulty)
+	s.mutex.Unlock()
+	if !isAuthorized {
+		return false, newError(ErrCodeUnauthorized, "unauthorized worker")
+	}
+
+	var jobID, nonceString string
+	if len(params) < 3 || json.Unmarshal(params[1], &jobID) != nil || json.Unmarshal(params[2], &nonceString) != nil {
+		return false, newError(ErrCodeOther, "expected worker name, job ID and nonce")
+	}
+	nonce, err := s.parseNonce(nonceString)
+	if err != nil {
+		return false, newError(ErrCodeInvalidExtranonce, err.Error())
+	}
+
+	job, ok := s.server.jobs.get(jobID)
+	if !ok {
+		return false, newError(ErrCodeJobNotFound, "job not found")
+	}
+	if !job.markSubmitted(nonce) {
+		return false, newError(ErrCodeDuplicateShare, "duplicate share")
+	}
+
+	state := *job.state
+	state.Nonce = nonce
+	powValue := state.CalculateProofOfWorkValue()
+	if powValue.Cmp(DifficultyToTarget(shareDifficulty)) > 0 && powValue.Cmp(&state.Target) > 0 {
+		return false, newError(ErrCodeLowDifficulty, "low difficulty share")
+	}
+
+	if powValue.Cmp(&state.Target) <= 0 {
+		block := job.blockWithNonce(nonce)
+		blockHash := consensushashing.BlockHash(block)
+		log.Infof("Stratum worker %s found block %s", s, blockHash)
+
+		err := s.server.submitBlock(block)
+		if err != nil {
+			log.Warnf("Error submitting block %s found by %s: %s", blockHash, s, err)
+		} else {
+			s.mutex.Lock()
+			s.blocksFound++
+			s.mutex.Unlock()
+		}
+	}
+	return true, nil
+}
+
+// parseNonce parses a submitted nonce. Miners may submit either the full
+// nonce, which must start with the session's extranonce, or only the part
+// of the nonce that they searched
+func (s *session) parseNonce(nonceString string) (uint64, error) {
+	nonceString = strings.TrimPrefix(nonceString, "0x")
+	nonce, err := strconv.ParseUint(nonceString, 16, 64)
+	if err != nil {
+		return 0, errors.Errorf("malformed nonce %s", nonceString)
+	}
+
+	const extranonceShift = extranonce2Size * 8
+	if len(nonceString) <= extranonce2Size*2 {
+		return uint64(s.extranonce)<<extranonceShift | nonce, nil
+	}
+	if uint16(nonce>>extranonceShift) != s.extranonce {
+		return 0, errors.Errorf("nonce %s doesn't start with extranonce %0*x",
+			nonceString, extranonceSize*2, s.extranonce)
+	}
+	return nonce, nil
+}
@@ -0,0 +1,73 @@
+package stratum
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// varDiffRetargetInterval is how often the share rate of a worker is measured
+	varDiffRetargetInterval = 30 * time.Second
+
+	// varDiffMaxAdjustment is the largest factor by which the difficulty may change in a single retarget
+	varDiffMaxAdjustment = 4.0
+
+	// varDiffTolerance is how far off the target the share rate may be before retargeting
+	varDiffTolerance = 0.25
+)
+
+// varDiff adjusts the share difficulty of a single worker, so that it
+// submits shares at roughly the target rate regardless of its hashrate
+type varDiff struct {
+	targetSharesPerMinute float64
+	minDifficulty         float64
+
+	difficulty     float64
+	windowStart    time.Time
+	sharesInWindow int
+}
+
+func newVarDiff(startDifficulty, minDifficulty, targetSharesPerMinute float64, now time.Time) *varDiff {
+	return &varDiff{
+		targetSharesPerMinute: targetSharesPerMinute,
+		minDifficulty:         minDifficulty,
+		difficulty:            math.Max(startDifficulty, minDifficulty),
+		windowStart:           now,
+	}
+}
+
+// addShare records a valid share, and returns the new difficulty if a retarget is due
+func (v *varDiff) addShare(now time.Time) (newDifficulty float64, changed bool) {
+	v.sharesInWindow++
+	return v.retarget(now)
+}
+
+// retarget returns the new difficulty if the measuring window is over and
+// the share rate was off target. A worker that submits far too many shares is
+// retargeted without waiting for the window to end
+func (v *varDiff) retarget(now time.Time) (newDifficulty float64, changed bool) {
+	elapsed := now.Sub(v.windowStart)
+	expectedSharesInWindow := v.targetSharesPerMinute * varDiffRetargetInterval.Minutes()
+	isFlooding := float64(v.sharesInWindow) >= expectedSharesInWindow*varDiffMaxAdjustment
+	if elapsed < varDiffRetargetInterval && !isFlooding {
+		return v.difficulty, false
+	}
+
+	sharesPerMinute := float64(v.sharesInWindow) / math.Max(elapsed.Minutes(), math.SmallestNonzeroFloat64)
+	ratio := sharesPerMinute / v.targetSharesPerMinute
+	ratio = math.Max(ratio, 1/varDiffMaxAdjustment)
+	ratio = math.Min(ratio, varDiffMaxAdjustment)
+
+	v.windowStart = now
+	v.sharesInWindow = 0
+
+	if math.Abs(ratio-1) <= varDiffTolerance {
+		return v.difficulty, false
+	}
+	newDifficulty = math.Max(v.difficulty*ratio, v.minDifficulty)
+	if newDifficulty == v.difficulty {
+		return v.difficulty, false
+	}
+	v.difficulty = newDifficulty
+	return newDifficulty, true
+}
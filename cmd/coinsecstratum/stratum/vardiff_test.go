@@ -0,0 +1,73 @@
+package stratum
+
+import (
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestVarDiff(t *testing.T) {
+	start := time.Unix(1_000_000, 0)
+	varDiff := newVarDiff(1, 0.5, 20, start)
+
+	// 20 shares per minute is on target
+	now := start
+	for i := 0; i < 10; i++ {
+		now = now.Add(3 * time.Second)
+		if difficulty, changed := varDiff.addShare(now); changed {
+			t.Fatalf("difficulty unexpectedly changed to %g while on target", difficulty)
+		}
+	}
+
+	// A worker that floods shares is retargeted before the window ends,
+	// by no more than varDiffMaxAdjustment
+	var difficulty float64
+	var changed bool
+	for i := 0; i < 40 && !changed; i++ {
+		now = now.Add(500 * time.Millisecond)
+		difficulty, changed = varDiff.addShare(now)
+	}
+	if !changed {
+		t.Fatalf("difficulty didn't change while flooding")
+	}
+	if difficulty != 4 {
+		t.Fatalf("expected difficulty 4 after flooding, got %g", difficulty)
+	}
+
+	// A worker that finds no shares gets an easier difficulty
+	now = now.Add(varDiffRetargetInterval)
+	difficulty, changed = varDiff.retarget(now)
+	if !changed || difficulty != 1 {
+		t.Fatalf("expected difficulty to drop to 1, got %g (changed: %t)", difficulty, changed)
+	}
+
+	// The difficulty never drops below the minimum
+	now = now.Add(varDiffRetargetInterval)
+	difficulty, _ = varDiff.retarget(now)
+	if difficulty != 0.5 {
+		t.Fatalf("expected difficulty to be clamped to 0.5, got %g", difficulty)
+	}
+	now = now.Add(varDiffRetargetInterval)
+	if difficulty, changed = varDiff.retarget(now); changed {
+		t.Fatalf("difficulty unexpectedly changed to %g below the minimum", difficulty)
+	}
+}
+
+func TestDifficultyToTarget(t *testing.T) {
+	if DifficultyToTarget(1).Cmp(difficultyOneTarget) != 0 {
+		t.Fatalf("unexpected target for difficulty 1: %x", DifficultyToTarget(1))
+	}
+
+	expectedTarget := new(big.Int).Rsh(difficultyOneTarget, 1)
+	difference := new(big.Int).Sub(DifficultyToTarget(2), expectedTarget)
+	if difference.CmpAbs(big.NewInt(1)) > 0 {
+		t.Fatalf("unexpected target for difficulty 2: %x", DifficultyToTarget(2))
+	}
+
+	for _, difficulty := range []float64{0, 1e-12} {
+		if DifficultyToTarget(difficulty).Cmp(maxTarget) != 0 {
+			t.Fatalf("expected the target for difficulty %g to be capped, got %x",
+				difficulty, DifficultyToTarget(difficulty))
+		}
+	}
+}
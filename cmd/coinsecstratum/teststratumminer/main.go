@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/cmd/coinsecstratum/stratum"
+	"github.com/wombatlabs/coinsecd/infrastructure/logger"
+	"github.com/wombatlabs/coinsecd/infrastructure/os/signal"
+	"github.com/wombatlabs/coinsecd/util/panics"
+	"github.com/wombatlabs/coinsecd/version"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+)
+
+var (
+	log   = logger.RegisterSubSystem("TSMN")
+	spawn = panics.GoroutineWrapperFunc(log)
+)
+
+const logHashRateInterval = 10 * time.Second
+
+type configFlags struct {
+	ShowVersion    bool   `short:"V" long:"version" description:"Display version information and exit"`
+	StratumServer  string `short:"s" long:"stratumserver" description:"Stratum server to connect to"`
+	Worker         string `short:"w" long:"worker" description:"Worker name to authorize as"`
+	Password       string `short:"p" long:"password" description:"Worker password"`
+	NumberOfShares uint64 `short:"n" long:"numshares" description:"Number of accepted shares to mine before exiting. If omitted, will mine until the process is interrupted."`
+}
+
+// teststratumminer is a minimal CPU Stratum miner, used for testing coinsecstratum
+// without any third-party mining software
+func main() {
+	defer panics.HandlePanic(log, "MAIN", nil)
+	interrupt := signal.InterruptListener()
+
+	cfg := &configFlags{
+		StratumServer: "localhost:5555",
+		Worker:        "teststratumminer",
+	}
+	_, err := flags.Parse(cfg)
+	if cfg.ShowVersion {
+		appName := filepath.Base(os.Args[0])
+		appName = strings.TrimSuffix(appName, filepath.Ext(appName))
+		fmt.Println(appName, "version", version.Version())
+		os.Exit(0)
+	}
+	if err != nil {
+		os.Exit(1)
+	}
+	logger.InitLogStdout(logger.LevelInfo)
+
+	doneChan := make(chan error)
+	spawn("mine", func() {
+		doneChan <- mine(cfg)
+	})
+
+	select {
+	case err := <-doneChan:
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%+v\n", err)
+			os.Exit(1)
+		}
+	case <-interrupt:
+	}
+}
+
+func mine(cfg *configFlags) error {
+	client, err := stratum.Dial(cfg.StratumServer)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	err = client.Subscribe("teststratumminer/" + version.Version())
+	if err != nil {
+		return errors.Wrap(err, "error subscribing")
+	}
+	err = client.Authorize(cfg.Worker, cfg.Password)
+	if err != nil {
+		return errors.Wrap(err, "error authorizing")
+	}
+	log.Infof("Connected to %s with extranonce %04x", cfg.StratumServer, client.Extranonce())
+
+	hashesTried := uint64(0)
+	logHashRate(&hashesTried)
+
+	var job *stratum.Job
+	select {
+	case job = <-client.Jobs():
+	case <-client.Closed():
+		return errors.New("the stratum server closed the connection")
+	}
+
+	acceptedShares := uint64(0)
+	for cfg.NumberOfShares == 0 || acceptedShares < cfg.NumberOfShares {
+		abort := make(chan struct{})
+		foundNonceChan := make(chan uint64, 1)
+		currentJob := job
+		spawn("mineJob", func() {
+			nonce, found := client.Mine(currentJob, abort, &hashesTried)
+			if found {
+				foundNonceChan <- nonce
+			}
+		})
+
+		select {
+		case nonce := <-foundNonceChan:
+			err := client.Submit(cfg.Worker, currentJob.ID, nonce)
+			if stratumErr := (&stratum.Error{}); errors.As(err, &stratumErr) {
+				log.Warnf("Share %x for job %s was rejected: %s", nonce, currentJob.ID, stratumErr)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			acceptedShares++
+			log.Infof("Share %x for job %s was accepted", nonce, currentJob.ID)
+		case job = <-client.Jobs():
+			close(abort)
+		case <-client.Closed():
+			close(abort)
+			return errors.New("the stratum server closed the connection")
+		}
+	}
+	return nil
+}
+
+func logHashRate(hashesTried *uint64) {
+	spawn("logHashRate", func() {
+		lastCheck := time.Now()
+		for range time.Tick(logHashRateInterval) {
+			currentTime := time.Now()
+			currentHashesTried := atomic.SwapUint64(hashesTried, 0)
+			hashRate := float64(currentHashesTried) / 1000.0 / currentTime.Sub(lastCheck).Seconds()
+			log.Infof("Current hash rate is %.2f Khash/s", hashRate)
+			lastCheck = currentTime
+		}
+	})
+}
@@ -122,6 +122,8 @@ type startDaemonConfig struct {
 	Timeout   uint32 `long:"wait-timeout" short:"w" description:"Waiting timeout for RPC calls, seconds (default: 30 s)"`
 	Profile   string `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
 	config.NetworkFlags
+	config.RPCClientTLSFlags
+	config.RPCClientAuthFlags
 }
 
 type dumpUnencryptedDataConfig struct {
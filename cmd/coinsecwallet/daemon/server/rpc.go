@@ -1,19 +1,22 @@
 package server
 
 import (
+	"crypto/tls"
 	"time"
 
 	"github.com/wombatlabs/coinsecd/domain/dagconfig"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcclient"
 )
 
-func connectToRPC(params *dagconfig.Params, rpcServer string, timeout uint32) (*rpcclient.RPCClient, error) {
+func connectToRPC(params *dagconfig.Params, rpcServer string, rpcTLSConfig *tls.Config, rpcToken string,
+	timeout uint32) (*rpcclient.RPCClient, error) {
+
 	rpcAddress, err := params.NormalizeRPCServerAddress(rpcServer)
 	if err != nil {
 		return nil, err
 	}
 
-	rpcClient, err := rpcclient.NewRPCClient(rpcAddress)
+	rpcClient, err := rpcclient.NewRPCClientWithAuth(rpcAddress, rpcTLSConfig, rpcToken)
 	if err != nil {
 		return nil, err
 	}
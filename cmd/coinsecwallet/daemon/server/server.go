@@ -1,6 +1,7 @@
 package server
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
 	"os"
@@ -57,7 +58,9 @@ type server struct {
 const MaxDaemonSendMsgSize = 100_000_000
 
 // Start starts the coinsecwalletd server
-func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath string, profile string, timeout uint32) error {
+func Start(params *dagconfig.Params, listen, rpcServer string, rpcTLSConfig *tls.Config, rpcToken string,
+	keysFilePath string, profile string, timeout uint32) error {
+
 	initLog(defaultLogFile, defaultErrLogFile)
 
 	defer panics.HandlePanic(log, "MAIN", nil)
@@ -75,11 +78,11 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 	log.Infof("Listening to TCP on %s", listen)
 
 	log.Infof("Connecting to a node at %s...", rpcServer)
-	rpcClient, err := connectToRPC(params, rpcServer, timeout)
+	rpcClient, err := connectToRPC(params, rpcServer, rpcTLSConfig, rpcToken, timeout)
 	if err != nil {
 		return (errors.Wrapf(err, "Error connecting to RPC server %s", rpcServer))
 	}
-	backgroundRPCClient, err := connectToRPC(params, rpcServer, timeout)
+	backgroundRPCClient, err := connectToRPC(params, rpcServer, rpcTLSConfig, rpcToken, timeout)
 	if err != nil {
 		return (errors.Wrapf(err, "Error making a second connection to RPC server %s", rpcServer))
 	}
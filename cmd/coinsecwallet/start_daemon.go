@@ -3,5 +3,14 @@ package main
 import "github.com/wombatlabs/coinsecd/cmd/coinsecwallet/daemon/server"
 
 func startDaemon(conf *startDaemonConfig) error {
-	return server.Start(conf.NetParams(), conf.Listen, conf.RPCServer, conf.KeysFile, conf.Profile, conf.Timeout)
+	rpcTLSConfig, err := conf.RPCTLSConfig()
+	if err != nil {
+		return err
+	}
+	rpcToken, err := conf.RPCAuthToken()
+	if err != nil {
+		return err
+	}
+	return server.Start(conf.NetParams(), conf.Listen, conf.RPCServer, rpcTLSConfig, rpcToken, conf.KeysFile, conf.Profile,
+		conf.Timeout)
 }
@@ -0,0 +1,286 @@
+package addresshistoryindex
+
+import (
+	"sync"
+
+	"github.com/wombatlabs/coinsecd/domain"
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/wombatlabs/coinsecd/infrastructure/logger"
+)
+
+// AddressHistoryIndex maintains an index between scriptPublicKeys
+// and the accepted transactions that paid to or spent from them
+type AddressHistoryIndex struct {
+	domain domain.Domain
+	store  *addressHistoryIndexStore
+
+	mutex sync.Mutex
+}
+
+// New creates a new address history index.
+//
+// NOTE: While this is called no new blocks can be added to the consensus.
+func New(domain domain.Domain, database database.Database) (*AddressHistoryIndex, error) {
+	addressHistoryIndex := &AddressHistoryIndex{
+		domain: domain,
+		store:  newAddressHistoryIndexStore(database),
+	}
+	isSynced, err := addressHistoryIndex.isSynced()
+	if err != nil {
+		return nil, err
+	}
+
+	if !isSynced {
+		err := addressHistoryIndex.Reset()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return addressHistoryIndex, nil
+}
+
+// Reset deletes the whole address history index and resyncs it from consensus.
+// Note that history prior to the pruning point is not available after a reset
+func (ahi *AddressHistoryIndex) Reset() error {
+	ahi.mutex.Lock()
+	defer ahi.mutex.Unlock()
+
+	log.Infof("Starting address history index reset")
+
+	err := ahi.store.deleteAll()
+	if err != nil {
+		return err
+	}
+
+	virtualInfo, err := ahi.domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return err
+	}
+
+	pruningPoint, err := ahi.domain.Consensus().PruningPoint()
+	if err != nil {
+		return err
+	}
+
+	// The pruning point's acceptance data might be missing if it was
+	// imported during IBD, in which case there's nothing to add for it
+	pruningPointAcceptanceData, err := ahi.domain.Consensus().GetBlockAcceptanceData(pruningPoint)
+	if err != nil && !database.IsNotFoundError(err) {
+		return err
+	}
+	if err == nil {
+		err = ahi.addAcceptanceData(ahi.store.database, pruningPoint, pruningPointAcceptanceData)
+		if err != nil {
+			return err
+		}
+	}
+
+	selectedParentChain, err := ahi.domain.Consensus().GetVirtualSelectedParentChainFromBlock(pruningPoint)
+	if err != nil {
+		return err
+	}
+
+	const step = 1000
+	for start := 0; start < len(selectedParentChain.Added); start += step {
+		end := start + step
+		if end > len(selectedParentChain.Added) {
+			end = len(selectedParentChain.Added)
+		}
+
+		// We use chunks in order to avoid blocking consensus for too long
+		chainBlocksChunk := selectedParentChain.Added[start:end]
+		chainBlocksAcceptanceData, err := ahi.domain.Consensus().GetBlocksAcceptanceData(chainBlocksChunk)
+		if err != nil {
+			return err
+		}
+
+		for i, chainBlockHash := range chainBlocksChunk {
+			err := ahi.addAcceptanceData(ahi.store.database, chainBlockHash, chainBlocksAcceptanceData[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// This has to be done last to mark that the reset went smoothly and no reset has to be called next time.
+	err = ahi.store.updateVirtualParents(ahi.store.database, virtualInfo.ParentHashes)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Finished address history index reset")
+	return nil
+}
+
+func (ahi *AddressHistoryIndex) isSynced() (bool, error) {
+	addressHistoryIndexVirtualParents, err := ahi.store.getVirtualParents()
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	virtualInfo, err := ahi.domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return false, err
+	}
+
+	return externalapi.HashesEqual(virtualInfo.ParentHashes, addressHistoryIndexVirtualParents), nil
+}
+
+// Update updates the address history index with the given DAG selected parent chain changes
+func (ahi *AddressHistoryIndex) Update(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "AddressHistoryIndex.Update")
+	defer onEnd()
+
+	ahi.mutex.Lock()
+	defer ahi.mutex.Unlock()
+
+	dbTransaction, err := ahi.store.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+
+	selectedParentChainChanges := virtualChangeSet.VirtualSelectedParentChainChanges
+	if selectedParentChainChanges != nil {
+		// Removed chain blocks have to be handled first, since transactions that were
+		// accepted by them may be accepted again by the added chain blocks
+		removedAcceptanceData, err := ahi.domain.Consensus().GetBlocksAcceptanceData(selectedParentChainChanges.Removed)
+		if err != nil {
+			return err
+		}
+		for i, removedChainBlockHash := range selectedParentChainChanges.Removed {
+			log.Tracef("Removing acceptance data of chain block %s from address history index", removedChainBlockHash)
+			err := ahi.removeAcceptanceData(dbTransaction, removedChainBlockHash, removedAcceptanceData[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		addedAcceptanceData, err := ahi.domain.Consensus().GetBlocksAcceptanceData(selectedParentChainChanges.Added)
+		if err != nil {
+			return err
+		}
+		for i, addedChainBlockHash := range selectedParentChainChanges.Added {
+			log.Tracef("Adding acceptance data of chain block %s to address history index", addedChainBlockHash)
+			err := ahi.addAcceptanceData(dbTransaction, addedChainBlockHash, addedAcceptanceData[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = ahi.store.updateVirtualParents(dbTransaction, virtualChangeSet.VirtualParents)
+	if err != nil {
+		return err
+	}
+
+	return dbTransaction.Commit()
+}
+
+type scriptPublicKeyHistoryEntry struct {
+	scriptPublicKey *externalapi.ScriptPublicKey
+	entry           *AddressHistoryEntry
+}
+
+// historyEntriesOfChainBlock returns an entry for every scriptPublicKey that
+// a transaction accepted by the given chain block paid to or spent from
+func (ahi *AddressHistoryIndex) historyEntriesOfChainBlock(chainBlockHash *externalapi.DomainHash,
+	acceptanceData externalapi.AcceptanceData) ([]*scriptPublicKeyHistoryEntry, error) {
+
+	chainBlockHeader, err := ahi.domain.Consensus().GetBlockHeader(chainBlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var historyEntries []*scriptPublicKeyHistoryEntry
+	for _, blockAcceptanceData := range acceptanceData {
+		for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+			if !transactionAcceptanceData.IsAccepted {
+				continue
+			}
+
+			transaction := transactionAcceptanceData.Transaction
+			transactionID := consensushashing.TransactionID(transaction)
+			entriesOfTransaction := make(map[string]*scriptPublicKeyHistoryEntry)
+			entryOf := func(scriptPublicKey *externalapi.ScriptPublicKey) *AddressHistoryEntry {
+				key := scriptPublicKey.String()
+				if historyEntry, ok := entriesOfTransaction[key]; ok {
+					return historyEntry.entry
+				}
+				historyEntry := &scriptPublicKeyHistoryEntry{
+					scriptPublicKey: scriptPublicKey,
+					entry: &AddressHistoryEntry{
+						TransactionID:          transactionID,
+						AcceptingBlockHash:     chainBlockHash,
+						AcceptingBlockDAAScore: chainBlockHeader.DAAScore(),
+					},
+				}
+				entriesOfTransaction[key] = historyEntry
+				historyEntries = append(historyEntries, historyEntry)
+				return historyEntry.entry
+			}
+
+			for _, utxoEntry := range transactionAcceptanceData.TransactionInputUTXOEntries {
+				entryOf(utxoEntry.ScriptPublicKey()).IsSpendingScriptPublicKey = true
+			}
+			for _, output := range transaction.Outputs {
+				entryOf(output.ScriptPublicKey).IsPayingToScriptPublicKey = true
+			}
+		}
+	}
+	return historyEntries, nil
+}
+
+func (ahi *AddressHistoryIndex) addAcceptanceData(dataAccessor database.DataAccessor,
+	chainBlockHash *externalapi.DomainHash, acceptanceData externalapi.AcceptanceData) error {
+
+	historyEntries, err := ahi.historyEntriesOfChainBlock(chainBlockHash, acceptanceData)
+	if err != nil {
+		return err
+	}
+	for _, historyEntry := range historyEntries {
+		err := ahi.store.add(dataAccessor, historyEntry.scriptPublicKey, historyEntry.entry)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ahi *AddressHistoryIndex) removeAcceptanceData(dataAccessor database.DataAccessor,
+	chainBlockHash *externalapi.DomainHash, acceptanceData externalapi.AcceptanceData) error {
+
+	historyEntries, err := ahi.historyEntriesOfChainBlock(chainBlockHash, acceptanceData)
+	if err != nil {
+		return err
+	}
+	for _, historyEntry := range historyEntries {
+		err := ahi.store.remove(dataAccessor, historyEntry.scriptPublicKey,
+			historyEntry.entry.AcceptingBlockDAAScore, historyEntry.entry.TransactionID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// History returns up to `limit` entries of the history of the given scriptPublicKey, newest
+// first, starting right after the entry that the given cursor points to. An empty cursor
+// starts from the newest entry. The returned cursor is nil once the history is exhausted
+func (ahi *AddressHistoryIndex) History(scriptPublicKey *externalapi.ScriptPublicKey,
+	cursor AddressHistoryCursor, limit int) ([]*AddressHistoryEntry, AddressHistoryCursor, error) {
+
+	onEnd := logger.LogAndMeasureExecutionTime(log, "AddressHistoryIndex.History")
+	defer onEnd()
+
+	ahi.mutex.Lock()
+	defer ahi.mutex.Unlock()
+
+	return ahi.store.getHistory(scriptPublicKey, cursor, limit)
+}
@@ -0,0 +1,7 @@
+package addresshistoryindex
+
+import (
+	"github.com/wombatlabs/coinsecd/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("AHIN")
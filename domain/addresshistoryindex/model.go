@@ -0,0 +1,19 @@
+package addresshistoryindex
+
+import (
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+)
+
+// AddressHistoryEntry is an accepted transaction that either paid to
+// or spent from a scriptPublicKey
+type AddressHistoryEntry struct {
+	TransactionID             *externalapi.DomainTransactionID
+	AcceptingBlockHash        *externalapi.DomainHash
+	AcceptingBlockDAAScore    uint64
+	IsPayingToScriptPublicKey bool
+	IsSpendingScriptPublicKey bool
+}
+
+// AddressHistoryCursor marks a position in the history of a scriptPublicKey.
+// An empty cursor marks the newest entry
+type AddressHistoryCursor []byte
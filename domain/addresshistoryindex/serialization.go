@@ -0,0 +1,78 @@
+package addresshistoryindex
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/transactionid"
+)
+
+const (
+	daaScoreSize          = 8
+	historyKeySize        = daaScoreSize + externalapi.DomainHashSize
+	historyValueSize      = externalapi.DomainHashSize + 1
+	payingToFlag     byte = 1 << 0
+	spendingFlag     byte = 1 << 1
+)
+
+// serializeHistoryKey serializes the part of an entry that identifies it in the history
+// of a scriptPublicKey. The DAA score is inverted and big-endian so that iterating over
+// the keys in lexicographical order yields the newest entries first
+func serializeHistoryKey(acceptingBlockDAAScore uint64, transactionID *externalapi.DomainTransactionID) []byte {
+	serializedKey := make([]byte, historyKeySize)
+	binary.BigEndian.PutUint64(serializedKey[:daaScoreSize], math.MaxUint64-acceptingBlockDAAScore)
+	copy(serializedKey[daaScoreSize:], transactionID.ByteSlice())
+	return serializedKey
+}
+
+func deserializeHistoryKey(serializedKey []byte) (uint64, *externalapi.DomainTransactionID, error) {
+	if len(serializedKey) != historyKeySize {
+		return 0, nil, errors.Errorf("expected a serialized history key of size %d but got %d",
+			historyKeySize, len(serializedKey))
+	}
+	acceptingBlockDAAScore := math.MaxUint64 - binary.BigEndian.Uint64(serializedKey[:daaScoreSize])
+	transactionID, err := transactionid.FromBytes(serializedKey[daaScoreSize:])
+	if err != nil {
+		return 0, nil, err
+	}
+	return acceptingBlockDAAScore, transactionID, nil
+}
+
+func serializeHistoryValue(entry *AddressHistoryEntry) []byte {
+	serializedValue := make([]byte, historyValueSize)
+	copy(serializedValue[:externalapi.DomainHashSize], entry.AcceptingBlockHash.ByteSlice())
+	var flags byte
+	if entry.IsPayingToScriptPublicKey {
+		flags |= payingToFlag
+	}
+	if entry.IsSpendingScriptPublicKey {
+		flags |= spendingFlag
+	}
+	serializedValue[externalapi.DomainHashSize] = flags
+	return serializedValue
+}
+
+func deserializeHistoryEntry(serializedKey []byte, serializedValue []byte) (*AddressHistoryEntry, error) {
+	acceptingBlockDAAScore, transactionID, err := deserializeHistoryKey(serializedKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(serializedValue) != historyValueSize {
+		return nil, errors.Errorf("expected a serialized history value of size %d but got %d",
+			historyValueSize, len(serializedValue))
+	}
+	acceptingBlockHash, err := externalapi.NewDomainHashFromByteSlice(serializedValue[:externalapi.DomainHashSize])
+	if err != nil {
+		return nil, err
+	}
+	flags := serializedValue[externalapi.DomainHashSize]
+	return &AddressHistoryEntry{
+		TransactionID:             transactionID,
+		AcceptingBlockHash:        acceptingBlockHash,
+		AcceptingBlockDAAScore:    acceptingBlockDAAScore,
+		IsPayingToScriptPublicKey: flags&payingToFlag != 0,
+		IsSpendingScriptPublicKey: flags&spendingFlag != 0,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package addresshistoryindex
+
+import (
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+)
+
+func Test_serializeHistoryEntry(t *testing.T) {
+	entry := &AddressHistoryEntry{
+		TransactionID:             externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{1}),
+		AcceptingBlockHash:        externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{2}),
+		AcceptingBlockDAAScore:    1234,
+		IsPayingToScriptPublicKey: true,
+	}
+	serializedKey := serializeHistoryKey(entry.AcceptingBlockDAAScore, entry.TransactionID)
+	result, err := deserializeHistoryEntry(serializedKey, serializeHistoryValue(entry))
+	if err != nil {
+		t.Fatalf("Failed deserializing history entry: %v", err)
+	}
+	if !result.TransactionID.Equal(entry.TransactionID) {
+		t.Fatalf("Expected transaction ID %s but got %s", entry.TransactionID, result.TransactionID)
+	}
+	if !result.AcceptingBlockHash.Equal(entry.AcceptingBlockHash) {
+		t.Fatalf("Expected accepting block hash %s but got %s", entry.AcceptingBlockHash, result.AcceptingBlockHash)
+	}
+	if result.AcceptingBlockDAAScore != entry.AcceptingBlockDAAScore {
+		t.Fatalf("Expected accepting block DAA score %d but got %d",
+			entry.AcceptingBlockDAAScore, result.AcceptingBlockDAAScore)
+	}
+	if result.IsPayingToScriptPublicKey != entry.IsPayingToScriptPublicKey ||
+		result.IsSpendingScriptPublicKey != entry.IsSpendingScriptPublicKey {
+		t.Fatalf("Expected flags %t/%t but got %t/%t",
+			entry.IsPayingToScriptPublicKey, entry.IsSpendingScriptPublicKey,
+			result.IsPayingToScriptPublicKey, result.IsSpendingScriptPublicKey)
+	}
+}
+
+func Test_serializeHistoryKeyOrder(t *testing.T) {
+	transactionID := externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{1})
+	older := serializeHistoryKey(10, transactionID)
+	newer := serializeHistoryKey(11, transactionID)
+	if string(newer) >= string(older) {
+		t.Fatalf("Expected the key of a newer entry to be ordered before the key of an older one")
+	}
+}
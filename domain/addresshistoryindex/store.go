@@ -0,0 +1,177 @@
+package addresshistoryindex
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/wombatlabs/coinsecd/domain/consensus/database/binaryserialization"
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+)
+
+var addressHistoryIndexBucket = database.MakeBucket([]byte("address-history-index"))
+var virtualParentsKey = database.MakeBucket([]byte("")).Key([]byte("address-history-index-virtual-parents"))
+
+// ErrInvalidCursor indicates that a history cursor doesn't point to an existing entry,
+// which happens when the cursor is malformed or when its entry was removed by a reorg
+var ErrInvalidCursor = errors.New("invalid address history cursor")
+
+type addressHistoryIndexStore struct {
+	database database.Database
+}
+
+func newAddressHistoryIndexStore(database database.Database) *addressHistoryIndexStore {
+	return &addressHistoryIndexStore{
+		database: database,
+	}
+}
+
+func (ahis *addressHistoryIndexStore) add(dataAccessor database.DataAccessor,
+	scriptPublicKey *externalapi.ScriptPublicKey, entry *AddressHistoryEntry) error {
+
+	log.Tracef("Adding transaction %s to the history of scriptPublicKey %s", entry.TransactionID, scriptPublicKey)
+	key := ahis.historyKey(scriptPublicKey, entry.AcceptingBlockDAAScore, entry.TransactionID)
+	return dataAccessor.Put(key, serializeHistoryValue(entry))
+}
+
+func (ahis *addressHistoryIndexStore) remove(dataAccessor database.DataAccessor, scriptPublicKey *externalapi.ScriptPublicKey,
+	acceptingBlockDAAScore uint64, transactionID *externalapi.DomainTransactionID) error {
+
+	log.Tracef("Removing transaction %s from the history of scriptPublicKey %s", transactionID, scriptPublicKey)
+	return dataAccessor.Delete(ahis.historyKey(scriptPublicKey, acceptingBlockDAAScore, transactionID))
+}
+
+func (ahis *addressHistoryIndexStore) updateVirtualParents(dataAccessor database.DataAccessor,
+	virtualParents []*externalapi.DomainHash) error {
+
+	return dataAccessor.Put(virtualParentsKey, binaryserialization.SerializeHashes(virtualParents))
+}
+
+func (ahis *addressHistoryIndexStore) getVirtualParents() ([]*externalapi.DomainHash, error) {
+	serializedHashes, err := ahis.database.Get(virtualParentsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return binaryserialization.DeserializeHashes(serializedHashes)
+}
+
+// getHistory returns up to `limit` entries of the given scriptPublicKey's history, newest first,
+// starting right after the entry that `cursor` points to. It also returns a cursor to the last
+// returned entry, or nil if there are no more entries
+func (ahis *addressHistoryIndexStore) getHistory(scriptPublicKey *externalapi.ScriptPublicKey,
+	cursor AddressHistoryCursor, limit int) ([]*AddressHistoryEntry, AddressHistoryCursor, error) {
+
+	bucket := ahis.bucketForScriptPublicKey(scriptPublicKey)
+	dbCursor, err := ahis.database.Cursor(bucket)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer dbCursor.Close()
+
+	if len(cursor) > 0 {
+		if len(cursor) != historyKeySize {
+			return nil, nil, ErrInvalidCursor
+		}
+		err := dbCursor.Seek(bucket.Key(cursor))
+		if err != nil {
+			if database.IsNotFoundError(err) {
+				return nil, nil, ErrInvalidCursor
+			}
+			return nil, nil, err
+		}
+	}
+
+	entries := make([]*AddressHistoryEntry, 0, limit)
+	var lastKey []byte
+	for len(entries) < limit {
+		key, ok, err := nextHistoryKey(dbCursor)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			break
+		}
+		value, err := dbCursor.Value()
+		if err != nil {
+			return nil, nil, err
+		}
+		entry, err := deserializeHistoryEntry(key.Suffix(), value)
+		if err != nil {
+			return nil, nil, err
+		}
+		entries = append(entries, entry)
+		lastKey = key.Suffix()
+	}
+
+	if len(entries) < limit {
+		return entries, nil, nil
+	}
+	_, hasMoreEntries, err := nextHistoryKey(dbCursor)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !hasMoreEntries {
+		return entries, nil, nil
+	}
+	nextCursor := make(AddressHistoryCursor, len(lastKey))
+	copy(nextCursor, lastKey)
+	return entries, nextCursor, nil
+}
+
+// nextHistoryKey advances dbCursor to the next entry of the scriptPublicKey's history and returns
+// its key, or returns false if there are no more entries. Keys of other scriptPublicKeys whose
+// bytes happen to be prefixed by this scriptPublicKey's bucket are skipped
+func nextHistoryKey(dbCursor database.Cursor) (*database.Key, bool, error) {
+	for dbCursor.Next() {
+		key, err := dbCursor.Key()
+		if err != nil {
+			return nil, false, err
+		}
+		if len(key.Suffix()) == historyKeySize {
+			return key, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func (ahis *addressHistoryIndexStore) deleteAll() error {
+	// First we delete the virtual parents, so if anything goes wrong, the address history index
+	// will be marked as "not synced" and will be reset.
+	err := ahis.database.Delete(virtualParentsKey)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := ahis.database.Cursor(addressHistoryIndexBucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+
+		err = ahis.database.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ahis *addressHistoryIndexStore) bucketForScriptPublicKey(scriptPublicKey *externalapi.ScriptPublicKey) *database.Bucket {
+	var scriptPublicKeyBytes = make([]byte, 2+len(scriptPublicKey.Script)) // uint16
+	binary.LittleEndian.PutUint16(scriptPublicKeyBytes[:2], scriptPublicKey.Version)
+	copy(scriptPublicKeyBytes[2:], scriptPublicKey.Script)
+	return addressHistoryIndexBucket.Bucket(scriptPublicKeyBytes)
+}
+
+func (ahis *addressHistoryIndexStore) historyKey(scriptPublicKey *externalapi.ScriptPublicKey,
+	acceptingBlockDAAScore uint64, transactionID *externalapi.DomainTransactionID) *database.Key {
+
+	return ahis.bucketForScriptPublicKey(scriptPublicKey).Key(serializeHistoryKey(acceptingBlockDAAScore, transactionID))
+}
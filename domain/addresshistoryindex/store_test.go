@@ -0,0 +1,69 @@
+package addresshistoryindex
+
+import (
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database/memdb"
+)
+
+func TestGetHistoryCursor(t *testing.T) {
+	db := memdb.NewMemoryDB()
+	defer db.Close()
+	store := newAddressHistoryIndexStore(db)
+
+	scriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3}}
+	// The bucket of this scriptPublicKey is prefixed by the bucket of the one above, and
+	// its keys sort after all of that one's entries
+	prefixedScriptPublicKey := &externalapi.ScriptPublicKey{
+		Script: []byte{1, 2, 3, '/', 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	}
+	addEntry := func(scriptPublicKey *externalapi.ScriptPublicKey, daaScore uint64) {
+		entry := &AddressHistoryEntry{
+			TransactionID:             externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{byte(daaScore)}),
+			AcceptingBlockHash:        externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{byte(daaScore)}),
+			AcceptingBlockDAAScore:    daaScore,
+			IsPayingToScriptPublicKey: true,
+		}
+		err := store.add(db, scriptPublicKey, entry)
+		if err != nil {
+			t.Fatalf("add: %+v", err)
+		}
+	}
+	addEntry(scriptPublicKey, 1)
+	addEntry(scriptPublicKey, 2)
+	addEntry(scriptPublicKey, 3)
+	addEntry(prefixedScriptPublicKey, 4)
+
+	entries, cursor, err := store.getHistory(scriptPublicKey, nil, 2)
+	if err != nil {
+		t.Fatalf("getHistory: %+v", err)
+	}
+	if len(entries) != 2 || entries[0].AcceptingBlockDAAScore != 3 || entries[1].AcceptingBlockDAAScore != 2 {
+		t.Fatalf("Unexpected first page: %+v", entries)
+	}
+	if cursor == nil {
+		t.Fatalf("Expected a cursor to the next page")
+	}
+
+	// The last page is exactly full, and only keys of the other scriptPublicKey follow it
+	entries, cursor, err = store.getHistory(scriptPublicKey, cursor, 1)
+	if err != nil {
+		t.Fatalf("getHistory: %+v", err)
+	}
+	if len(entries) != 1 || entries[0].AcceptingBlockDAAScore != 1 {
+		t.Fatalf("Unexpected last page: %+v", entries)
+	}
+	if cursor != nil {
+		t.Fatalf("Expected no cursor after the last entry, but got %x", cursor)
+	}
+
+	entries, cursor, err = store.getHistory(scriptPublicKey, nil, 3)
+	if err != nil {
+		t.Fatalf("getHistory: %+v", err)
+	}
+	if len(entries) != 3 || cursor != nil {
+		t.Fatalf("Expected all 3 entries in one page without a cursor, but got %d entries and cursor %x",
+			len(entries), cursor)
+	}
+}
@@ -3,6 +3,7 @@ package consensus
 import (
 	"math/big"
 	"sync"
+	"sync/atomic"
 
 	"github.com/wombatlabs/coinsecd/util/mstime"
 
@@ -62,8 +63,19 @@ type consensus struct {
 
 	consensusEventsChan chan externalapi.ConsensusEvent
 	virtualNotUpdated   bool
+
+	// virtualResolvedState caches whether the virtual is resolved, so that IsVirtualResolved
+	// doesn't need the consensus lock. It's written under the lock wherever the virtual changes
+	virtualResolvedState int32
 }
 
+// The values of consensus.virtualResolvedState
+const (
+	virtualResolvedStateUnknown int32 = iota
+	virtualResolvedStateResolved
+	virtualResolvedStateNotResolved
+)
+
 // In order to prevent a situation that the consensus lock is held for too much time, we
 // release the lock each time we resolve 100 blocks.
 // Note: `virtualResolveChunk` should be smaller than `params.FinalityDuration` in order to avoid a situation
@@ -78,6 +90,7 @@ func (s *consensus) ValidateAndInsertBlockWithTrustedData(block *externalapi.Blo
 	if err != nil {
 		return err
 	}
+	s.setVirtualResolvedState(virtualResolvedStateUnknown)
 	return nil
 }
 
@@ -259,6 +272,7 @@ func (s *consensus) validateAndInsertBlockNoLock(block *externalapi.DomainBlock,
 	// If block has a body, and yet virtual was not updated -- signify that virtual is in non-updated state
 	if !updateVirtual && blockStatus != externalapi.StatusHeaderOnly {
 		s.virtualNotUpdated = true
+		s.setVirtualResolvedState(virtualResolvedStateNotResolved)
 	}
 
 	err = s.sendBlockAddedEvent(block, blockStatus)
@@ -670,6 +684,7 @@ func (s *consensus) ValidateAndInsertImportedPruningPoint(newPruningPoint *exter
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	s.setVirtualResolvedState(virtualResolvedStateUnknown)
 	return s.blockProcessor.ValidateAndInsertImportedPruningPoint(newPruningPoint)
 }
 
@@ -964,6 +979,11 @@ func (s *consensus) resolveVirtualChunkNoLock(maxBlocksToResolve uint64) (*exter
 		return nil, false, err
 	}
 	s.virtualNotUpdated = !isCompletelyResolved
+	if isCompletelyResolved {
+		s.setVirtualResolvedState(virtualResolvedStateResolved)
+	} else {
+		s.setVirtualResolvedState(virtualResolvedStateNotResolved)
+	}
 
 	stagingArea := model.NewStagingArea()
 	err = s.pruningManager.UpdatePruningPointByVirtual(stagingArea)
@@ -1117,6 +1137,41 @@ func (s *consensus) IsNearlySynced() (bool, error) {
 	return s.isNearlySyncedNoLock()
 }
 
+// IsVirtualResolved returns whether the virtual is fully resolved, i.e. whether its
+// selected parent is the best valid tip of the DAG.
+// The answer is kept up to date by ResolveVirtual and block insertion, so the tips
+// are only examined when nothing has determined it since startup
+func (s *consensus) IsVirtualResolved() (bool, error) {
+	virtualResolvedState := atomic.LoadInt32(&s.virtualResolvedState)
+	if virtualResolvedState != virtualResolvedStateUnknown {
+		return virtualResolvedState == virtualResolvedStateResolved, nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	virtualResolvedState = atomic.LoadInt32(&s.virtualResolvedState)
+	if virtualResolvedState != virtualResolvedStateUnknown {
+		return virtualResolvedState == virtualResolvedStateResolved, nil
+	}
+	stagingArea := model.NewStagingArea()
+	isVirtualResolved, err := s.consensusStateManager.IsVirtualResolved(stagingArea)
+	if err != nil {
+		return false, err
+	}
+	if isVirtualResolved {
+		s.setVirtualResolvedState(virtualResolvedStateResolved)
+	} else {
+		s.setVirtualResolvedState(virtualResolvedStateNotResolved)
+	}
+	return isVirtualResolved, nil
+}
+
+// setVirtualResolvedState must be called with the consensus lock held
+func (s *consensus) setVirtualResolvedState(virtualResolvedState int32) {
+	atomic.StoreInt32(&s.virtualResolvedState, virtualResolvedState)
+}
+
 func (s *consensus) isNearlySyncedNoLock() (bool, error) {
 	stagingArea := model.NewStagingArea()
 	virtualGHOSTDAGData, err := s.ghostdagDataStores[0].Get(s.databaseContext, stagingArea, model.VirtualBlockHash, false)
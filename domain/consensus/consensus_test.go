@@ -74,3 +74,68 @@ func TestConsensus_GetBlockInfo(t *testing.T) {
 
 	})
 }
+
+func TestConsensus_IsVirtualResolved(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestConsensus_IsVirtualResolved")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		isVirtualResolved, err := tc.IsVirtualResolved()
+		if err != nil {
+			t.Fatalf("IsVirtualResolved: %+v", err)
+		}
+		if !isVirtualResolved {
+			t.Fatalf("Expected the virtual of a new consensus to be resolved")
+		}
+
+		// Blocks that are inserted without updating the virtual leave it unresolved
+		previousBlockHash := consensusConfig.GenesisHash
+		for i := 0; i < 5; i++ {
+			block, _, err := tc.BuildBlockWithParents([]*externalapi.DomainHash{previousBlockHash}, nil, nil)
+			if err != nil {
+				t.Fatalf("BuildBlockWithParents: %+v", err)
+			}
+			err = tc.ValidateAndInsertBlock(block, false)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertBlock: %+v", err)
+			}
+			previousBlockHash = consensushashing.BlockHash(block)
+		}
+		isVirtualResolved, err = tc.IsVirtualResolved()
+		if err != nil {
+			t.Fatalf("IsVirtualResolved: %+v", err)
+		}
+		if isVirtualResolved {
+			t.Fatalf("Expected the virtual to not be resolved after inserting blocks without updating it")
+		}
+
+		// Resolving part of the blocks isn't enough
+		_, _, err = tc.ResolveVirtualWithMaxParam(2)
+		if err != nil {
+			t.Fatalf("ResolveVirtualWithMaxParam: %+v", err)
+		}
+		isVirtualResolved, err = tc.IsVirtualResolved()
+		if err != nil {
+			t.Fatalf("IsVirtualResolved: %+v", err)
+		}
+		if isVirtualResolved {
+			t.Fatalf("Expected the virtual to not be resolved while blocks are left to resolve")
+		}
+
+		err = tc.ResolveVirtual(nil)
+		if err != nil {
+			t.Fatalf("ResolveVirtual: %+v", err)
+		}
+		isVirtualResolved, err = tc.IsVirtualResolved()
+		if err != nil {
+			t.Fatalf("IsVirtualResolved: %+v", err)
+		}
+		if !isVirtualResolved {
+			t.Fatalf("Expected the virtual to be resolved after ResolveVirtual")
+		}
+	})
+}
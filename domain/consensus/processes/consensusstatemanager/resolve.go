@@ -91,6 +91,24 @@ func (csm *consensusStateManager) getGHOSTDAGLowerTips(stagingArea *model.Stagin
 	return lowerTips, nil
 }
 
+// IsVirtualResolved returns whether the virtual's selected parent is already the best
+// valid tip, meaning that ResolveVirtual has nothing left to do
+func (csm *consensusStateManager) IsVirtualResolved(stagingArea *model.StagingArea) (bool, error) {
+	pendingTip, pendingTipStatus, err := csm.findNextPendingTip(stagingArea)
+	if err != nil {
+		return false, err
+	}
+	if pendingTip == nil {
+		return true, nil
+	}
+
+	virtualSelectedParent, err := csm.virtualSelectedParent(stagingArea)
+	if err != nil {
+		return false, err
+	}
+	return pendingTipStatus == externalapi.StatusUTXOValid && virtualSelectedParent.Equal(pendingTip), nil
+}
+
 func (csm *consensusStateManager) ResolveVirtual(maxBlocksToResolve uint64) (*externalapi.VirtualChangeSet, bool, error) {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "csm.ResolveVirtual")
 	defer onEnd()
@@ -32,15 +32,29 @@ func NewState(header externalapi.MutableBlockHeader) *State {
 	header.SetTimeInMilliseconds(timestamp)
 	header.SetNonce(nonce)
 
+	state := NewStateFromPrePowHash(prePowHash, timestamp, target)
+	state.Nonce = nonce
+	return state
+}
+
+// NewStateFromPrePowHash creates a new state from the hash of a header with
+// zeroed time and nonce. This allows mining without having the full header,
+// as is the case for remote miners
+func NewStateFromPrePowHash(prePowHash *externalapi.DomainHash, timestamp int64, target *big.Int) *State {
 	return &State{
 		Target:     *target,
 		prePowHash: *prePowHash,
 		mat:        *generateMatrix(prePowHash),
 		Timestamp:  timestamp,
-		Nonce:      nonce,
 	}
 }
 
+// PrePowHash returns the hash of the header with zeroed time and nonce
+func (state *State) PrePowHash() *externalapi.DomainHash {
+	prePowHash := state.prePowHash
+	return &prePowHash
+}
+
 // CalculateProofOfWorkValue hashes the internal header and returns its big.Int value
 func (state *State) CalculateProofOfWorkValue() *big.Int {
 	// PRE_POW_HASH || TIME || 32 zero byte padding || NONCE
@@ -0,0 +1,34 @@
+package pow_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/blockheader"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/pow"
+)
+
+func TestNewStateFromPrePowHash(t *testing.T) {
+	header := blockheader.NewImmutableBlockHeader(
+		0,
+		[]externalapi.BlockLevelParents{{externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{1})}},
+		externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{2}),
+		externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{3}),
+		externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{4}),
+		1234567,
+		0x1e7fffff,
+		987654,
+		5,
+		6,
+		big.NewInt(7),
+		externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{8}),
+	)
+	state := pow.NewState(header.ToMutable())
+	stateFromPrePowHash := pow.NewStateFromPrePowHash(state.PrePowHash(), state.Timestamp, &state.Target)
+	stateFromPrePowHash.Nonce = state.Nonce
+
+	if state.CalculateProofOfWorkValue().Cmp(stateFromPrePowHash.CalculateProofOfWorkValue()) != 0 {
+		t.Fatalf("a state created from the pre-PoW hash calculates a different proof of work value")
+	}
+}
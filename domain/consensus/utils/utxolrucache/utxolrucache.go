@@ -1,9 +1,21 @@
 package utxolrucache
 
 import (
+	"sync/atomic"
+
 	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
 )
 
+// hits and misses count the lookups of all the LRUCaches in the process.
+// They're accessed atomically since they're read outside the consensus lock
+var hits, misses uint64
+
+// Stats returns the number of lookups that hit and missed all the LRUCaches
+// since the process started
+func Stats() (hitCount uint64, missCount uint64) {
+	return atomic.LoadUint64(&hits), atomic.LoadUint64(&misses)
+}
+
 // LRUCache is a least-recently-used cache for UTXO entries
 // indexed by DomainOutpoint
 type LRUCache struct {
@@ -38,8 +50,10 @@ func (c *LRUCache) Add(key *externalapi.DomainOutpoint, value externalapi.UTXOEn
 func (c *LRUCache) Get(key *externalapi.DomainOutpoint) (externalapi.UTXOEntry, bool) {
 	value, ok := c.cache[*key]
 	if !ok {
+		atomic.AddUint64(&misses, 1)
 		return nil, false
 	}
+	atomic.AddUint64(&hits, 1)
 	return value, true
 }
 
@@ -120,7 +120,8 @@ func (btb *blockTemplateBuilder) BuildBlockTemplate(
 
 	mempoolTransactions := btb.mempool.BlockCandidateTransactions()
 	candidateTxs := make([]*candidateTx, 0, len(mempoolTransactions))
-	for _, tx := range mempoolTransactions {
+	for _, mempoolTransaction := range mempoolTransactions {
+		tx := mempoolTransaction.Transaction
 		// Calculate the tx value
 		gasLimit := uint64(0)
 		if !subnetworks.IsBuiltInOrNative(tx.SubnetworkID) {
@@ -128,7 +129,7 @@ func (btb *blockTemplateBuilder) BuildBlockTemplate(
 		}
 		candidateTxs = append(candidateTxs, &candidateTx{
 			DomainTransaction: tx,
-			txValue:           btb.calcTxValue(tx),
+			txValue:           btb.calcTxValue(tx, mempoolTransaction.PackageFeeRate),
 			gasLimit:          gasLimit,
 		})
 	}
@@ -204,15 +205,24 @@ func (btb *blockTemplateBuilder) ModifyBlockTemplate(newCoinbaseData *consensuse
 // calcTxValue calculates a value to be used in transaction selection.
 // The higher the number the more likely it is that the transaction will be
 // included in the block.
-func (btb *blockTemplateBuilder) calcTxValue(tx *consensusexternalapi.DomainTransaction) float64 {
+// A transaction is valued by its package fee rate when that's higher than its own,
+// so that a low-fee parent is selected on behalf of the redeemer that pays for it.
+func (btb *blockTemplateBuilder) calcTxValue(tx *consensusexternalapi.DomainTransaction, packageFeeRate float64) float64 {
 	massLimit := btb.policy.BlockMaxMass
 
 	mass := tx.Mass
-	fee := tx.Fee
+	fee := SelectionFeeRate(tx, packageFeeRate) * float64(mass)
 	if subnetworks.IsBuiltInOrNative(tx.SubnetworkID) {
-		return float64(fee) / (float64(mass) / float64(massLimit))
+		return fee / (float64(mass) / float64(massLimit))
 	}
 	// TODO: Replace with real gas once implemented
 	gasLimit := uint64(math.MaxUint64)
-	return float64(fee) / (float64(mass)/float64(massLimit) + float64(tx.Gas)/float64(gasLimit))
+	return fee / (float64(mass)/float64(massLimit) + float64(tx.Gas)/float64(gasLimit))
+}
+
+// SelectionFeeRate returns the fee rate, in sompi/gram, by which the block template builder
+// values a transaction when selecting transactions: its package fee rate when that's higher
+// than its own
+func SelectionFeeRate(tx *consensusexternalapi.DomainTransaction, packageFeeRate float64) float64 {
+	return math.Max(float64(tx.Fee)/float64(tx.Mass), packageFeeRate)
 }
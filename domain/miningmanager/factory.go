@@ -4,6 +4,7 @@ import (
 	"github.com/wombatlabs/coinsecd/domain/consensusreference"
 	"github.com/wombatlabs/coinsecd/domain/dagconfig"
 	"github.com/wombatlabs/coinsecd/domain/miningmanager/blocktemplatebuilder"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/feeestimator"
 	mempoolpkg "github.com/wombatlabs/coinsecd/domain/miningmanager/mempool"
 	"sync"
 	"time"
@@ -22,13 +23,17 @@ func (f *factory) NewMiningManager(consensusReference consensusreference.Consens
 
 	mempool := mempoolpkg.New(mempoolConfig, consensusReference)
 	blockTemplateBuilder := blocktemplatebuilder.New(consensusReference, mempool, params.MaxBlockMass, params.CoinbasePayloadScriptPublicKeyMaxLength)
+	feeEstimator := feeestimator.New(params.TargetTimePerBlock, params.MaxBlockMass)
 
 	return &miningManager{
 		consensusReference:   consensusReference,
 		mempool:              mempool,
 		blockTemplateBuilder: blockTemplateBuilder,
+		feeEstimator:         feeEstimator,
 		cachingTime:          time.Time{},
 		cacheLock:            &sync.Mutex{},
+
+		transactionExpireInterval: time.Duration(mempoolConfig.TransactionExpireIntervalDAAScore) * params.TargetTimePerBlock,
 	}
 }
 
@@ -0,0 +1,214 @@
+package feeestimator
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/blocktemplatebuilder"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/model"
+)
+
+const (
+	// recentBlockCount is the number of recent blocks whose fee rates are considered
+	recentBlockCount = 100
+
+	// fullBlockMassRatio is the part of the maximum block mass a block needs to use for it
+	// to be considered full. The lowest fee rate included in a block that isn't full says
+	// nothing about the fee rate needed to get in, since anything would have been included.
+	fullBlockMassRatio = 0.9
+
+	normalInclusionTime = time.Minute
+	lowInclusionTime    = time.Hour
+)
+
+// FeeEstimator estimates the fee rates transactions need to pay in order to be included
+// in a block within different time frames.
+//
+// It combines two sources: a projection of the mempool onto upcoming blocks, where
+// transactions are included in decreasing order of the fee rate the block template
+// builder selects them by, and the lowest fee rates that got into recent full blocks.
+type FeeEstimator struct {
+	targetTimePerBlock time.Duration
+	blockMaxMass       uint64
+
+	// recentBlocksMinimumFeeRates holds, for each recent block, the lowest fee rate it
+	// included if it was full, or 0 otherwise
+	recentBlocksMinimumFeeRates []float64
+	nextRecentBlockIndex        int
+	mutex                       sync.Mutex
+}
+
+// New creates a new FeeEstimator
+func New(targetTimePerBlock time.Duration, blockMaxMass uint64) *FeeEstimator {
+	return &FeeEstimator{
+		targetTimePerBlock:          targetTimePerBlock,
+		blockMaxMass:                blockMaxMass,
+		recentBlocksMinimumFeeRates: make([]float64, 0, recentBlockCount),
+	}
+}
+
+// AddBlock records the fee rates of the given transactions, which were included in a new block.
+// Only transactions whose fee and mass are known should be passed, which in practice are the
+// ones that were in the mempool.
+func (fe *FeeEstimator) AddBlock(transactions []*externalapi.DomainTransaction) {
+	totalMass := uint64(0)
+	minimumFeeRate := 0.0
+	for _, transaction := range transactions {
+		if transaction.Mass == 0 {
+			continue
+		}
+		totalMass += transaction.Mass
+		feeRate := transactionFeeRate(transaction)
+		if minimumFeeRate == 0 || feeRate < minimumFeeRate {
+			minimumFeeRate = feeRate
+		}
+	}
+	if float64(totalMass) < fullBlockMassRatio*float64(fe.blockMaxMass) {
+		minimumFeeRate = 0
+	}
+
+	fe.mutex.Lock()
+	defer fe.mutex.Unlock()
+
+	if len(fe.recentBlocksMinimumFeeRates) < recentBlockCount {
+		fe.recentBlocksMinimumFeeRates = append(fe.recentBlocksMinimumFeeRates, minimumFeeRate)
+		return
+	}
+	fe.recentBlocksMinimumFeeRates[fe.nextRecentBlockIndex] = minimumFeeRate
+	fe.nextRecentBlockIndex = (fe.nextRecentBlockIndex + 1) % recentBlockCount
+}
+
+// Estimate returns a fee estimate given the block candidate transactions currently in the
+// mempool and the minimum fee rate the mempool accepts, in sompi/gram
+func (fe *FeeEstimator) Estimate(blockCandidateTransactions []*model.BlockCandidateTransaction,
+	minimumFeeRate float64) *model.FeeEstimate {
+
+	projection := newMempoolProjection(blockCandidateTransactions, fe.blockMaxMass)
+
+	priorityFeeRate := maxFeeRate(projection.feeRateForBlocks(1), fe.recentBlocksFeeRate(), minimumFeeRate)
+	normalFeeRate := maxFeeRate(projection.feeRateForBlocks(fe.blocksIn(normalInclusionTime)), minimumFeeRate)
+	lowFeeRate := maxFeeRate(projection.feeRateForBlocks(fe.blocksIn(lowInclusionTime)), minimumFeeRate)
+
+	// A faster bucket never pays less than a slower one
+	normalFeeRate = maxFeeRate(normalFeeRate, lowFeeRate)
+	priorityFeeRate = maxFeeRate(priorityFeeRate, normalFeeRate)
+
+	return &model.FeeEstimate{
+		PriorityBucket: fe.bucket(projection, priorityFeeRate),
+		NormalBucket:   fe.bucket(projection, normalFeeRate),
+		LowBucket:      fe.bucket(projection, lowFeeRate),
+	}
+}
+
+func (fe *FeeEstimator) bucket(projection *mempoolProjection, feeRate float64) model.FeeRateBucket {
+	return model.FeeRateBucket{
+		FeeRate:          feeRate,
+		EstimatedSeconds: float64(projection.blocksUntilInclusion(feeRate)) * fe.targetTimePerBlock.Seconds(),
+	}
+}
+
+// recentBlocksFeeRate returns the median of the lowest fee rates included in recent blocks,
+// where blocks that weren't full count as having no minimum
+func (fe *FeeEstimator) recentBlocksFeeRate() float64 {
+	fe.mutex.Lock()
+	defer fe.mutex.Unlock()
+
+	if len(fe.recentBlocksMinimumFeeRates) == 0 {
+		return 0
+	}
+	feeRates := make([]float64, len(fe.recentBlocksMinimumFeeRates))
+	copy(feeRates, fe.recentBlocksMinimumFeeRates)
+	sort.Float64s(feeRates)
+	return feeRates[len(feeRates)/2]
+}
+
+func (fe *FeeEstimator) blocksIn(duration time.Duration) uint64 {
+	blocks := uint64(duration / fe.targetTimePerBlock)
+	if blocks == 0 {
+		return 1
+	}
+	return blocks
+}
+
+// mempoolProjection is the mempool transactions sorted by decreasing selection fee rate, which
+// is the order in which the block template builder is expected to include them in upcoming blocks.
+// A new transaction is placed behind the transactions whose fee rate it only ties with
+type mempoolProjection struct {
+	feeRates     []float64
+	masses       []uint64
+	blockMaxMass uint64
+}
+
+func newMempoolProjection(blockCandidateTransactions []*model.BlockCandidateTransaction,
+	blockMaxMass uint64) *mempoolProjection {
+
+	projection := &mempoolProjection{
+		feeRates:     make([]float64, 0, len(blockCandidateTransactions)),
+		masses:       make([]uint64, 0, len(blockCandidateTransactions)),
+		blockMaxMass: blockMaxMass,
+	}
+	sortedTransactions := make([]*model.BlockCandidateTransaction, 0, len(blockCandidateTransactions))
+	for _, blockCandidateTransaction := range blockCandidateTransactions {
+		if blockCandidateTransaction.Transaction.Mass == 0 {
+			continue
+		}
+		sortedTransactions = append(sortedTransactions, blockCandidateTransaction)
+	}
+	selectionFeeRate := func(blockCandidateTransaction *model.BlockCandidateTransaction) float64 {
+		return blocktemplatebuilder.SelectionFeeRate(
+			blockCandidateTransaction.Transaction, blockCandidateTransaction.PackageFeeRate)
+	}
+	sort.SliceStable(sortedTransactions, func(i, j int) bool {
+		return selectionFeeRate(sortedTransactions[i]) > selectionFeeRate(sortedTransactions[j])
+	})
+	for _, blockCandidateTransaction := range sortedTransactions {
+		projection.feeRates = append(projection.feeRates, selectionFeeRate(blockCandidateTransaction))
+		projection.masses = append(projection.masses, blockCandidateTransaction.Transaction.Mass)
+	}
+	return projection
+}
+
+// feeRateForBlocks returns the fee rate needed to be included within the given number of blocks:
+// the smallest fee rate strictly above that of the marginal transaction, which is the one that
+// fills them. It returns 0 if the whole mempool leaves room in them
+func (mp *mempoolProjection) feeRateForBlocks(blocks uint64) float64 {
+	capacity := blocks * mp.blockMaxMass
+	cumulativeMass := uint64(0)
+	for i, mass := range mp.masses {
+		cumulativeMass += mass
+		if cumulativeMass >= capacity {
+			return math.Nextafter(mp.feeRates[i], math.Inf(1))
+		}
+	}
+	return 0
+}
+
+// blocksUntilInclusion returns the number of blocks it's expected to take a transaction with
+// the given fee rate to be included, given the transactions paying at least as much as it
+func (mp *mempoolProjection) blocksUntilInclusion(feeRate float64) uint64 {
+	massAhead := uint64(0)
+	for i, mass := range mp.masses {
+		if mp.feeRates[i] < feeRate {
+			break
+		}
+		massAhead += mass
+	}
+	return massAhead/mp.blockMaxMass + 1
+}
+
+func transactionFeeRate(transaction *externalapi.DomainTransaction) float64 {
+	return float64(transaction.Fee) / float64(transaction.Mass)
+}
+
+func maxFeeRate(feeRates ...float64) float64 {
+	result := 0.0
+	for _, feeRate := range feeRates {
+		if feeRate > result {
+			result = feeRate
+		}
+	}
+	return result
+}
@@ -0,0 +1,137 @@
+package feeestimator
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/model"
+)
+
+func transactionWithFeeRate(feeRate uint64, mass uint64) *externalapi.DomainTransaction {
+	return &externalapi.DomainTransaction{Fee: feeRate * mass, Mass: mass}
+}
+
+func blockCandidateWithFeeRate(feeRate uint64, mass uint64) *model.BlockCandidateTransaction {
+	return &model.BlockCandidateTransaction{Transaction: transactionWithFeeRate(feeRate, mass)}
+}
+
+func justAbove(feeRate float64) float64 {
+	return math.Nextafter(feeRate, math.Inf(1))
+}
+
+func TestEstimate(t *testing.T) {
+	const blockMaxMass = 1000
+	const minimumFeeRate = 1.0
+	feeEstimator := New(time.Second, blockMaxMass)
+
+	// An empty mempool only requires the minimum fee rate, and gets included in the next block
+	estimate := feeEstimator.Estimate(nil, minimumFeeRate)
+	for _, bucket := range []struct {
+		name     string
+		feeRate  float64
+		expected float64
+	}{
+		{"priority", estimate.PriorityBucket.FeeRate, minimumFeeRate},
+		{"normal", estimate.NormalBucket.FeeRate, minimumFeeRate},
+		{"low", estimate.LowBucket.FeeRate, minimumFeeRate},
+	} {
+		if bucket.feeRate != bucket.expected {
+			t.Fatalf("Expected %s fee rate %f with an empty mempool, but got %f", bucket.name, bucket.expected, bucket.feeRate)
+		}
+	}
+	if estimate.PriorityBucket.EstimatedSeconds != 1 {
+		t.Fatalf("Expected inclusion within 1 second, but got %f", estimate.PriorityBucket.EstimatedSeconds)
+	}
+
+	// Fill the next 3 blocks with transactions paying 10, 20 and 30 sompi/gram. Getting into
+	// the next block requires outbidding the transaction paying 30, which fills it
+	mempoolTransactions := []*model.BlockCandidateTransaction{
+		blockCandidateWithFeeRate(10, blockMaxMass),
+		blockCandidateWithFeeRate(30, blockMaxMass),
+		blockCandidateWithFeeRate(20, blockMaxMass),
+	}
+	estimate = feeEstimator.Estimate(mempoolTransactions, minimumFeeRate)
+	if estimate.PriorityBucket.FeeRate != justAbove(30) {
+		t.Fatalf("Expected a priority fee rate just above 30, but got %v", estimate.PriorityBucket.FeeRate)
+	}
+	if estimate.PriorityBucket.EstimatedSeconds != 1 {
+		t.Fatalf("Expected priority inclusion within 1 second, but got %f", estimate.PriorityBucket.EstimatedSeconds)
+	}
+	if estimate.NormalBucket.FeeRate != minimumFeeRate {
+		t.Fatalf("Expected the normal fee rate to be the minimum, since the mempool clears within a minute, but got %f",
+			estimate.NormalBucket.FeeRate)
+	}
+	if estimate.NormalBucket.EstimatedSeconds != 4 {
+		t.Fatalf("Expected normal inclusion within 4 seconds, but got %f", estimate.NormalBucket.EstimatedSeconds)
+	}
+
+	// Recent full blocks that included nothing below 50 sompi/gram raise the priority fee rate
+	for i := 0; i < recentBlockCount; i++ {
+		feeEstimator.AddBlock([]*externalapi.DomainTransaction{transactionWithFeeRate(50, blockMaxMass)})
+	}
+	estimate = feeEstimator.Estimate(mempoolTransactions, minimumFeeRate)
+	if estimate.PriorityBucket.FeeRate != 50 {
+		t.Fatalf("Expected a priority fee rate of 50, but got %f", estimate.PriorityBucket.FeeRate)
+	}
+	if estimate.PriorityBucket.EstimatedSeconds != 1 {
+		t.Fatalf("Expected priority inclusion within 1 second, but got %f", estimate.PriorityBucket.EstimatedSeconds)
+	}
+
+	// Blocks that aren't full say nothing about the fee rate needed to get in
+	for i := 0; i < recentBlockCount; i++ {
+		feeEstimator.AddBlock([]*externalapi.DomainTransaction{transactionWithFeeRate(50, blockMaxMass/2)})
+	}
+	estimate = feeEstimator.Estimate(mempoolTransactions, minimumFeeRate)
+	if estimate.PriorityBucket.FeeRate != justAbove(30) {
+		t.Fatalf("Expected a priority fee rate just above 30, but got %v", estimate.PriorityBucket.FeeRate)
+	}
+}
+
+func TestEstimateTiedFeeRates(t *testing.T) {
+	const blockMaxMass = 1000
+	const minimumFeeRate = 1.0
+	feeEstimator := New(time.Second, blockMaxMass)
+
+	// Transactions tied at 20 sompi/gram fill more than the next block. Paying 20 as well
+	// queues behind them, so only a fee rate above 20 gets into the next block
+	mempoolTransactions := []*model.BlockCandidateTransaction{
+		blockCandidateWithFeeRate(20, blockMaxMass/2),
+		blockCandidateWithFeeRate(20, blockMaxMass/2),
+		blockCandidateWithFeeRate(20, blockMaxMass/2),
+		blockCandidateWithFeeRate(10, blockMaxMass/2),
+	}
+	estimate := feeEstimator.Estimate(mempoolTransactions, minimumFeeRate)
+	if estimate.PriorityBucket.FeeRate != justAbove(20) {
+		t.Fatalf("Expected a priority fee rate just above 20, but got %v", estimate.PriorityBucket.FeeRate)
+	}
+	if estimate.PriorityBucket.EstimatedSeconds != 1 {
+		t.Fatalf("Expected priority inclusion within 1 second, but got %f", estimate.PriorityBucket.EstimatedSeconds)
+	}
+	tiedBucket := feeEstimator.bucket(newMempoolProjection(mempoolTransactions, blockMaxMass), 20)
+	if tiedBucket.EstimatedSeconds != 2 {
+		t.Fatalf("Expected a transaction tied at 20 to be included within 2 seconds, but got %f", tiedBucket.EstimatedSeconds)
+	}
+}
+
+func TestEstimatePackageFeeRate(t *testing.T) {
+	const blockMaxMass = 1000
+	const minimumFeeRate = 1.0
+	feeEstimator := New(time.Second, blockMaxMass)
+
+	// A parent paying nothing is selected by the package fee rate its redeemer pays for it,
+	// so it fills the next block ahead of the transaction paying 20
+	zeroFeeParent := &model.BlockCandidateTransaction{
+		Transaction:    transactionWithFeeRate(0, blockMaxMass),
+		PackageFeeRate: 30,
+	}
+	mempoolTransactions := []*model.BlockCandidateTransaction{
+		blockCandidateWithFeeRate(20, blockMaxMass),
+		zeroFeeParent,
+	}
+	estimate := feeEstimator.Estimate(mempoolTransactions, minimumFeeRate)
+	if estimate.PriorityBucket.FeeRate != justAbove(30) {
+		t.Fatalf("Expected a priority fee rate just above 30, but got %v", estimate.PriorityBucket.FeeRate)
+	}
+}
@@ -149,9 +149,9 @@ func (mp *mempool) IsTransactionOutputDust(output *externalapi.DomainTransaction
 // context of this function is one whose referenced public key script is of a
 // standard form and, for pay-to-script-hash, does not have more than
 // maxStandardP2SHSigOps signature operations.
-// In addition, makes sure that the transaction's fee is above the minimum for acceptance
-// into the mempool and relay
-func (mp *mempool) checkTransactionStandardInContext(transaction *externalapi.DomainTransaction) error {
+// In addition, if checkRelayFee is set, makes sure that the transaction's fee is above the
+// minimum for acceptance into the mempool and relay
+func (mp *mempool) checkTransactionStandardInContext(transaction *externalapi.DomainTransaction, checkRelayFee bool) error {
 	for i, input := range transaction.Inputs {
 		// It is safe to elide existence and index checks here since
 		// they have already been checked prior to calling this
@@ -174,11 +174,13 @@ func (mp *mempool) checkTransactionStandardInContext(transaction *externalapi.Do
 		}
 	}
 
-	minimumFee := mp.minimumRequiredTransactionRelayFee(transaction.Mass)
-	if transaction.Fee < minimumFee {
-		str := fmt.Sprintf("transaction %s has %d fees which is under the required amount of %d",
-			consensushashing.TransactionID(transaction), transaction.Fee, minimumFee)
-		return transactionRuleError(RejectInsufficientFee, str)
+	if checkRelayFee {
+		minimumFee := mp.minimumRequiredTransactionRelayFee(transaction.Mass)
+		if transaction.Fee < minimumFee {
+			str := fmt.Sprintf("transaction %s has %d fees which is under the required amount of %d",
+				consensushashing.TransactionID(transaction), transaction.Fee, minimumFee)
+			return transactionRuleError(RejectInsufficientFee, str)
+		}
 	}
 
 	return nil
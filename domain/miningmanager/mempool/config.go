@@ -13,6 +13,29 @@ import (
 const (
 	defaultMaximumTransactionCount = 1_000_000
 
+	// defaultMaximumMempoolMass is the default limit on the total mass of the transactions in the mempool.
+	// It's in grams, and allows for about a million typical transactions
+	defaultMaximumMempoolMass = 2_000_000_000
+
+	// defaultMaximumReplacedTransactionCount is the default limit on the number of transactions, including
+	// descendants, that a single transaction may replace
+	defaultMaximumReplacedTransactionCount = 100
+
+	// defaultMaximumPackageTransactionCount is the default limit on the number of transactions
+	// submitted together as a package
+	defaultMaximumPackageTransactionCount = 25
+
+	// defaultMaximumAncestorCount and defaultMaximumDescendantCount are the default limits on the number of
+	// in-mempool ancestors and descendants of a mempool transaction, including the transaction itself
+	defaultMaximumAncestorCount   = 25
+	defaultMaximumDescendantCount = 25
+
+	// defaultMaximumAncestorMass and defaultMaximumDescendantMass are the default limits on the total mass
+	// of a mempool transaction along with its in-mempool ancestors or descendants. They allow for ten
+	// transactions of the maximum standard mass
+	defaultMaximumAncestorMass   = 1_000_000
+	defaultMaximumDescendantMass = 1_000_000
+
 	defaultTransactionExpireIntervalSeconds     uint64 = 60
 	defaultTransactionExpireScanIntervalSeconds uint64 = 10
 	defaultOrphanExpireIntervalSeconds          uint64 = 60
@@ -38,6 +61,13 @@ const (
 // Config represents a mempool configuration
 type Config struct {
 	MaximumTransactionCount               uint64
+	MaximumMempoolMass                    uint64
+	MaximumReplacedTransactionCount       uint64
+	MaximumPackageTransactionCount        uint64
+	MaximumAncestorCount                  uint64
+	MaximumAncestorMass                   uint64
+	MaximumDescendantCount                uint64
+	MaximumDescendantMass                 uint64
 	TransactionExpireIntervalDAAScore     uint64
 	TransactionExpireScanIntervalDAAScore uint64
 	TransactionExpireScanIntervalSeconds  uint64
@@ -58,6 +88,13 @@ func DefaultConfig(dagParams *dagconfig.Params) *Config {
 
 	return &Config{
 		MaximumTransactionCount:               defaultMaximumTransactionCount,
+		MaximumMempoolMass:                    defaultMaximumMempoolMass,
+		MaximumReplacedTransactionCount:       defaultMaximumReplacedTransactionCount,
+		MaximumPackageTransactionCount:        defaultMaximumPackageTransactionCount,
+		MaximumAncestorCount:                  defaultMaximumAncestorCount,
+		MaximumAncestorMass:                   defaultMaximumAncestorMass,
+		MaximumDescendantCount:                defaultMaximumDescendantCount,
+		MaximumDescendantMass:                 defaultMaximumDescendantMass,
 		TransactionExpireIntervalDAAScore:     uint64(float64(defaultTransactionExpireIntervalSeconds) / targetBlocksPerSecond),
 		TransactionExpireScanIntervalDAAScore: uint64(float64(defaultTransactionExpireScanIntervalSeconds) / targetBlocksPerSecond),
 		TransactionExpireScanIntervalSeconds:  defaultTransactionExpireScanIntervalSeconds,
@@ -0,0 +1,69 @@
+package mempool
+
+import (
+	"math"
+	"time"
+)
+
+// dynamicMinimumFeeRateHalfLife is the time it takes the dynamic minimum fee rate to decay to half its value
+const dynamicMinimumFeeRateHalfLife = time.Hour
+
+// dynamicMinimumFeeRate is a minimum fee rate that's raised whenever transactions are evicted from a
+// full mempool, so that transactions that would just be evicted again aren't accepted. It decays
+// back over time, so that the minimum returns to normal once the mempool is no longer under pressure
+type dynamicMinimumFeeRate struct {
+	config *Config
+
+	// feeRate is in sompi/gram, as of lastUpdate
+	feeRate    float64
+	lastUpdate time.Time
+}
+
+func newDynamicMinimumFeeRate(config *Config) *dynamicMinimumFeeRate {
+	return &dynamicMinimumFeeRate{config: config}
+}
+
+// raise raises the dynamic minimum fee rate above the given fee rate of an evicted transaction.
+// The minimum relay fee is added on top, so that a replacement transaction pays for its own relay
+func (d *dynamicMinimumFeeRate) raise(evictedFeeRate float64) {
+	d.raiseAt(evictedFeeRate, time.Now())
+}
+
+func (d *dynamicMinimumFeeRate) raiseAt(evictedFeeRate float64, now time.Time) {
+	newFeeRate := evictedFeeRate + d.staticMinimumFeeRate()
+	currentFeeRate := d.feeRateAt(now)
+	if newFeeRate < currentFeeRate {
+		newFeeRate = currentFeeRate
+	}
+	d.feeRate = newFeeRate
+	d.lastUpdate = now
+}
+
+// current returns the current dynamic minimum fee rate in sompi/gram. It's 0 when the
+// mempool isn't under pressure, in which case only the static minimum relay fee applies
+func (d *dynamicMinimumFeeRate) current() float64 {
+	return d.feeRateAt(time.Now())
+}
+
+func (d *dynamicMinimumFeeRate) feeRateAt(now time.Time) float64 {
+	if d.feeRate == 0 {
+		return 0
+	}
+	elapsed := now.Sub(d.lastUpdate)
+	feeRate := d.feeRate
+	if elapsed > 0 {
+		feeRate *= math.Exp2(-float64(elapsed) / float64(dynamicMinimumFeeRateHalfLife))
+	}
+
+	// Once the dynamic minimum has decayed close to the static minimum it no longer matters
+	if feeRate < d.staticMinimumFeeRate()/2 {
+		return 0
+	}
+	return feeRate
+}
+
+// staticMinimumFeeRate returns the configured minimum relay fee rate in sompi/gram
+func (d *dynamicMinimumFeeRate) staticMinimumFeeRate() float64 {
+	// MinimumRelayTransactionFee is in sompi/kg
+	return float64(d.config.MinimumRelayTransactionFee) / 1000
+}
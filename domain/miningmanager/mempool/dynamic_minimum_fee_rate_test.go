@@ -0,0 +1,36 @@
+package mempool
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDynamicMinimumFeeRate(t *testing.T) {
+	config := &Config{MinimumRelayTransactionFee: 1000} // 1 sompi/gram
+	dynamicMinimumFeeRate := newDynamicMinimumFeeRate(config)
+	start := time.Unix(1_000_000, 0)
+
+	if feeRate := dynamicMinimumFeeRate.feeRateAt(start); feeRate != 0 {
+		t.Fatalf("Expected no dynamic minimum fee rate before any eviction, but got %f", feeRate)
+	}
+
+	dynamicMinimumFeeRate.raiseAt(7, start)
+	if feeRate := dynamicMinimumFeeRate.feeRateAt(start); feeRate != 8 {
+		t.Fatalf("Expected a fee rate of 8 after evicting a fee rate of 7, but got %f", feeRate)
+	}
+
+	// Evicting a lower fee rate doesn't lower the minimum
+	dynamicMinimumFeeRate.raiseAt(3, start)
+	if feeRate := dynamicMinimumFeeRate.feeRateAt(start); feeRate != 8 {
+		t.Fatalf("Expected the fee rate to remain 8, but got %f", feeRate)
+	}
+
+	if feeRate := dynamicMinimumFeeRate.feeRateAt(start.Add(dynamicMinimumFeeRateHalfLife)); feeRate != 4 {
+		t.Fatalf("Expected a fee rate of 4 after one half-life, but got %f", feeRate)
+	}
+
+	// Once the fee rate decays below half the static minimum it's dropped
+	if feeRate := dynamicMinimumFeeRate.feeRateAt(start.Add(5 * dynamicMinimumFeeRateHalfLife)); feeRate != 0 {
+		t.Fatalf("Expected no dynamic minimum fee rate after five half-lives, but got %f", feeRate)
+	}
+}
@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"math"
 	"sync"
 
 	"github.com/wombatlabs/coinsecd/domain/consensus/ruleerrors"
@@ -23,6 +24,10 @@ type mempool struct {
 	mempoolUTXOSet   *mempoolUTXOSet
 	transactionsPool *transactionsPool
 	orphansPool      *orphansPool
+
+	onChangedHandler miningmanagermodel.OnMempoolChangedHandler
+	pendingChanges   []*miningmanagermodel.MempoolChange
+	dispatchMtx      sync.Mutex
 }
 
 // New constructs a new mempool
@@ -42,10 +47,58 @@ func New(config *Config, consensusReference consensusreference.ConsensusReferenc
 func (mp *mempool) ValidateAndInsertTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool, allowOrphan bool) (
 	acceptedTransactions []*externalapi.DomainTransaction, err error) {
 
+	defer mp.dispatchChanges()
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	acceptedTransactions, _, err = mp.validateAndInsertTransaction(
+		transaction, isHighPriority, allowOrphan, miningmanagermodel.RBFPolicyForbidden, false)
+	return acceptedTransactions, err
+}
+
+func (mp *mempool) ValidateAndInsertTransactionWithReplacement(transaction *externalapi.DomainTransaction,
+	isHighPriority bool, allowOrphan bool, rbfPolicy miningmanagermodel.RBFPolicy) (
+	acceptedTransactions []*externalapi.DomainTransaction, replacedTransactions []*externalapi.DomainTransaction, err error) {
+
+	defer mp.dispatchChanges()
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return mp.validateAndInsertTransaction(transaction, isHighPriority, allowOrphan, rbfPolicy, false)
+}
+
+// ValidateAndInsertTransactionEntry validates and inserts a transaction that was saved from a mempool,
+// keeping the time and the virtual DAA score at which it was originally added, so that it
+// expires on the same schedule as it would have in the mempool it was saved from
+func (mp *mempool) ValidateAndInsertTransactionEntry(entry *miningmanagermodel.MempoolTransactionEntry) (
+	acceptedTransactions []*externalapi.DomainTransaction, err error) {
+
+	defer mp.dispatchChanges()
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	acceptedTransactions, _, err = mp.validateAndInsertTransaction(
+		entry.Transaction, entry.IsHighPriority, false, miningmanagermodel.RBFPolicyForbidden, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// The transaction might have been evicted right away if the mempool is full
+	mempoolTransaction, ok := mp.transactionsPool.allTransactions[*consensushashing.TransactionID(entry.Transaction)]
+	if ok {
+		mempoolTransaction.RestoreAddedAt(entry.AddedAt, entry.AddedAtDAAScore)
+	}
+	return acceptedTransactions, nil
+}
+
+func (mp *mempool) ValidateAndInsertTransactionPackage(transactions []*externalapi.DomainTransaction,
+	isHighPriority bool) (acceptedTransactions []*externalapi.DomainTransaction, err error) {
+
+	defer mp.dispatchChanges()
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
 
-	return mp.validateAndInsertTransaction(transaction, isHighPriority, allowOrphan)
+	return mp.validateAndInsertTransactionPackage(transactions, isHighPriority)
 }
 
 func (mp *mempool) GetTransaction(transactionID *externalapi.DomainTransactionID,
@@ -117,6 +170,13 @@ func (mp *mempool) AllTransactions(includeTransactionPool bool, includeOrphanPoo
 	return transactionPoolTransactions, orphanPoolTransactions
 }
 
+func (mp *mempool) TransactionEntries() []*miningmanagermodel.MempoolTransactionEntry {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.transactionsPool.allTransactionEntries()
+}
+
 func (mp *mempool) TransactionCount(includeTransactionPool bool, includeOrphanPool bool) int {
 	mp.mtx.RLock()
 	defer mp.mtx.RUnlock()
@@ -136,21 +196,23 @@ func (mp *mempool) TransactionCount(includeTransactionPool bool, includeOrphanPo
 func (mp *mempool) HandleNewBlockTransactions(transactions []*externalapi.DomainTransaction) (
 	acceptedOrphans []*externalapi.DomainTransaction, err error) {
 
+	defer mp.dispatchChanges()
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
 
 	return mp.handleNewBlockTransactions(transactions)
 }
 
-func (mp *mempool) BlockCandidateTransactions() []*externalapi.DomainTransaction {
+func (mp *mempool) BlockCandidateTransactions() []*miningmanagermodel.BlockCandidateTransaction {
 	mp.mtx.RLock()
 	defer mp.mtx.RUnlock()
 
 	readyTxs := mp.transactionsPool.allReadyTransactions()
-	var candidateTxs []*externalapi.DomainTransaction
-	var spamTx *externalapi.DomainTransaction
+	var candidateTxs []*miningmanagermodel.BlockCandidateTransaction
+	var spamTx *miningmanagermodel.BlockCandidateTransaction
 	var spamTxNewestUTXODaaScore uint64
-	for _, tx := range readyTxs {
+	for _, candidate := range readyTxs {
+		tx := candidate.Transaction
 		if len(tx.Outputs) > len(tx.Inputs) {
 			hasCoinbaseInput := false
 			for _, input := range tx.Inputs {
@@ -167,7 +229,7 @@ func (mp *mempool) BlockCandidateTransactions() []*externalapi.DomainTransaction
 			}
 
 			if hasCoinbaseInput || tx.Fee > uint64(numExtraOuts)*constants.SompiPerCoinsec {
-				candidateTxs = append(candidateTxs, tx)
+				candidateTxs = append(candidateTxs, candidate)
 			} else {
 				txNewestUTXODaaScore := tx.Inputs[0].UTXOEntry.BlockDAAScore()
 				for _, input := range tx.Inputs {
@@ -178,21 +240,21 @@ func (mp *mempool) BlockCandidateTransactions() []*externalapi.DomainTransaction
 
 				if spamTx != nil {
 					if txNewestUTXODaaScore < spamTxNewestUTXODaaScore {
-						spamTx = tx
+						spamTx = candidate
 						spamTxNewestUTXODaaScore = txNewestUTXODaaScore
 					}
 				} else {
-					spamTx = tx
+					spamTx = candidate
 					spamTxNewestUTXODaaScore = txNewestUTXODaaScore
 				}
 			}
 		} else {
-			candidateTxs = append(candidateTxs, tx)
+			candidateTxs = append(candidateTxs, candidate)
 		}
 	}
 
 	if spamTx != nil {
-		log.Debugf("Adding spam tx candidate %s", consensushashing.TransactionID(spamTx))
+		log.Debugf("Adding spam tx candidate %s", consensushashing.TransactionID(spamTx.Transaction))
 		candidateTxs = append(candidateTxs, spamTx)
 	}
 
@@ -200,6 +262,7 @@ func (mp *mempool) BlockCandidateTransactions() []*externalapi.DomainTransaction
 }
 
 func (mp *mempool) RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error) {
+	defer mp.dispatchChanges()
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
 
@@ -207,12 +270,14 @@ func (mp *mempool) RevalidateHighPriorityTransactions() (validTransactions []*ex
 }
 
 func (mp *mempool) RemoveInvalidTransactions(err *ruleerrors.ErrInvalidTransactionsInNewBlock) error {
+	defer mp.dispatchChanges()
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
 
 	for _, tx := range err.InvalidTransactions {
 		removeRedeemers := !errors.As(tx.Error, &ruleerrors.ErrMissingTxOut{})
-		err := mp.removeTransaction(consensushashing.TransactionID(tx.Transaction), removeRedeemers)
+		err := mp.removeTransaction(consensushashing.TransactionID(tx.Transaction), removeRedeemers,
+			miningmanagermodel.MempoolChangeReasonInvalidated)
 		if err != nil {
 			return err
 		}
@@ -222,8 +287,31 @@ func (mp *mempool) RemoveInvalidTransactions(err *ruleerrors.ErrInvalidTransacti
 }
 
 func (mp *mempool) RemoveTransaction(transactionID *externalapi.DomainTransactionID, removeRedeemers bool) error {
+	defer mp.dispatchChanges()
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
 
-	return mp.removeTransaction(transactionID, removeRedeemers)
+	return mp.removeTransaction(transactionID, removeRedeemers, miningmanagermodel.MempoolChangeReasonInvalidated)
+}
+
+// MinimumFeeRate returns the fee rate, in sompi/gram, a transaction currently has to pay
+// in order to be accepted to the mempool
+func (mp *mempool) MinimumFeeRate() float64 {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.minimumFeeRate()
+}
+
+func (mp *mempool) minimumFeeRate() float64 {
+	return math.Max(mp.transactionsPool.dynamicMinimumFeeRate.staticMinimumFeeRate(),
+		mp.transactionsPool.dynamicMinimumFeeRate.current())
+}
+
+// MempoolInfo returns aggregate statistics about the mempool
+func (mp *mempool) MempoolInfo() *miningmanagermodel.MempoolInfo {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.mempoolInfo()
 }
@@ -0,0 +1,78 @@
+package mempool
+
+import (
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/wombatlabs/coinsecd/domain/miningmanager/model"
+)
+
+// SetOnChangedHandler sets the handler that's called with the transactions every mempool
+// operation adds or removes. The handler is called after the mempool lock is released,
+// so it's allowed to query the mempool
+func (mp *mempool) SetOnChangedHandler(onChangedHandler miningmanagermodel.OnMempoolChangedHandler) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.onChangedHandler = onChangedHandler
+}
+
+// recordChange queues a change to be passed to the changed handler once the current
+// operation is done. Nothing is queued while there's no handler
+func (mp *mempool) recordChange(transaction *model.MempoolTransaction, reason miningmanagermodel.MempoolChangeReason) {
+	if mp.onChangedHandler == nil {
+		return
+	}
+	mp.pendingChanges = append(mp.pendingChanges, &miningmanagermodel.MempoolChange{
+		TransactionID: transaction.TransactionID(),
+		Transaction:   transaction.Transaction().Clone(), //these pointer leave the mempool, hence we clone.
+		Reason:        reason,
+	})
+}
+
+// discardChangesOfTransactionsAddedSince drops every change queued from the given index on
+// that belongs to a transaction added in that time. It's used when a rejected package is
+// rolled back, so that its transactions are not reported at all
+func (mp *mempool) discardChangesOfTransactionsAddedSince(index int) {
+	if index >= len(mp.pendingChanges) {
+		return
+	}
+
+	addedTransactionIDs := make(map[externalapi.DomainTransactionID]struct{})
+	for _, change := range mp.pendingChanges[index:] {
+		if change.Reason == miningmanagermodel.MempoolChangeReasonAdded {
+			addedTransactionIDs[*change.TransactionID] = struct{}{}
+		}
+	}
+
+	keptChanges := mp.pendingChanges[:index]
+	for _, change := range mp.pendingChanges[index:] {
+		if _, ok := addedTransactionIDs[*change.TransactionID]; !ok {
+			keptChanges = append(keptChanges, change)
+		}
+	}
+	mp.pendingChanges = keptChanges
+}
+
+// dispatchChanges passes the queued changes to the changed handler. Every public method
+// that modifies the mempool defers it before taking the mempool lock, so that it runs after
+// the lock is released. dispatchMtx makes sure concurrent operations report their changes
+// in the order they were made
+func (mp *mempool) dispatchChanges() {
+	mp.dispatchMtx.Lock()
+	defer mp.dispatchMtx.Unlock()
+
+	mp.mtx.Lock()
+	changes := mp.pendingChanges
+	mp.pendingChanges = nil
+	onChangedHandler := mp.onChangedHandler
+	mp.mtx.Unlock()
+
+	if len(changes) == 0 || onChangedHandler == nil {
+		return
+	}
+	// The changes were already made, so a failure to report them is only logged
+	err := onChangedHandler(changes)
+	if err != nil {
+		log.Errorf("Error handling %d mempool changes: %+v", len(changes), err)
+	}
+}
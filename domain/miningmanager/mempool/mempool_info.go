@@ -0,0 +1,40 @@
+package mempool
+
+import (
+	"sort"
+
+	miningmanagermodel "github.com/wombatlabs/coinsecd/domain/miningmanager/model"
+)
+
+// feeRateHistogramBoundaries are the lowest fee rates, in sompi/gram, of the buckets of the fee rate histogram
+var feeRateHistogramBoundaries = []float64{0, 1, 2, 5, 10, 20, 50, 100, 200, 500, 1000}
+
+func (mp *mempool) mempoolInfo() *miningmanagermodel.MempoolInfo {
+	return &miningmanagermodel.MempoolInfo{
+		TransactionCount: uint64(mp.transactionsPool.transactionCount()),
+		OrphanCount:      uint64(mp.orphansPool.orphanTransactionCount()),
+		TotalMass:        mp.transactionsPool.totalMass,
+		MinimumFeeRate:   mp.minimumFeeRate(),
+		FeeRateHistogram: mp.transactionsPool.feeRateHistogram(),
+	}
+}
+
+// feeRateHistogram returns the number and total mass of the transactions in the pool in each
+// of the buckets defined by feeRateHistogramBoundaries
+func (tp *transactionsPool) feeRateHistogram() []miningmanagermodel.FeeRateHistogramBucket {
+	histogram := make([]miningmanagermodel.FeeRateHistogramBucket, len(feeRateHistogramBoundaries))
+	for i, boundary := range feeRateHistogramBoundaries {
+		histogram[i].MinimumFeeRate = boundary
+	}
+
+	for _, mempoolTransaction := range tp.allTransactions {
+		feeRate := transactionFeeRate(mempoolTransaction.Transaction())
+		bucketIndex := sort.Search(len(feeRateHistogramBoundaries), func(i int) bool {
+			return feeRateHistogramBoundaries[i] > feeRate
+		}) - 1
+		histogram[bucketIndex].TransactionCount++
+		histogram[bucketIndex].TotalMass += mempoolTransaction.Transaction().Mass
+	}
+
+	return histogram
+}
@@ -65,6 +65,25 @@ func (mpus *mempoolUTXOSet) removeTransaction(transaction *model.MempoolTransact
 	}
 }
 
+// getConflictingTransactions returns the mempool transactions that spend any of the outputs
+// the given transaction spends
+func (mpus *mempoolUTXOSet) getConflictingTransactions(transaction *externalapi.DomainTransaction) []*model.MempoolTransaction {
+	conflictingTransactions := []*model.MempoolTransaction{}
+	seen := make(map[externalapi.DomainTransactionID]struct{})
+	for _, input := range transaction.Inputs {
+		existingTransaction, exists := mpus.transactionByPreviousOutpoint[input.PreviousOutpoint]
+		if !exists {
+			continue
+		}
+		if _, ok := seen[*existingTransaction.TransactionID()]; ok {
+			continue
+		}
+		seen[*existingTransaction.TransactionID()] = struct{}{}
+		conflictingTransactions = append(conflictingTransactions, existingTransaction)
+	}
+	return conflictingTransactions
+}
+
 func (mpus *mempoolUTXOSet) checkDoubleSpends(transaction *externalapi.DomainTransaction) error {
 	outpoint := externalapi.DomainOutpoint{TransactionID: *consensushashing.TransactionID(transaction)}
 
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
 	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
 )
@@ -11,6 +13,9 @@ type MempoolTransaction struct {
 	parentTransactionsInPool IDToTransactionMap
 	isHighPriority           bool
 	addedAtDAAScore          uint64
+	addedAt                  time.Time
+	ancestorFee              uint64
+	ancestorMass             uint64
 }
 
 // NewMempoolTransaction constructs a new MempoolTransaction
@@ -25,6 +30,7 @@ func NewMempoolTransaction(
 		parentTransactionsInPool: parentTransactionsInPool,
 		isHighPriority:           isHighPriority,
 		addedAtDAAScore:          addedAtDAAScore,
+		addedAt:                  time.Now(),
 	}
 }
 
@@ -57,3 +63,40 @@ func (mt *MempoolTransaction) IsHighPriority() bool {
 func (mt *MempoolTransaction) AddedAtDAAScore() uint64 {
 	return mt.addedAtDAAScore
 }
+
+// AddedAt returns the time at which this MempoolTransaction was added to the mempool
+func (mt *MempoolTransaction) AddedAt() time.Time {
+	return mt.addedAt
+}
+
+// RestoreAddedAt sets the time and the virtual DAA score at which this MempoolTransaction was
+// added to the mempool to the ones of an earlier mempool it was saved from. A DAA score above the
+// one this MempoolTransaction was added at, which happens if the virtual went back since, is ignored
+func (mt *MempoolTransaction) RestoreAddedAt(addedAt time.Time, addedAtDAAScore uint64) {
+	mt.addedAt = addedAt
+	if addedAtDAAScore < mt.addedAtDAAScore {
+		mt.addedAtDAAScore = addedAtDAAScore
+	}
+}
+
+// AncestorFee returns the total fee of this MempoolTransaction and all its ancestors in the mempool
+func (mt *MempoolTransaction) AncestorFee() uint64 {
+	return mt.ancestorFee
+}
+
+// AncestorMass returns the total mass of this MempoolTransaction and all its ancestors in the mempool
+func (mt *MempoolTransaction) AncestorMass() uint64 {
+	return mt.ancestorMass
+}
+
+// AncestorFeeRate returns the fee rate, in sompi/gram, of this MempoolTransaction together with
+// all its ancestors in the mempool. This is the fee rate a miner gets for mining this transaction
+func (mt *MempoolTransaction) AncestorFeeRate() float64 {
+	return float64(mt.ancestorFee) / float64(mt.ancestorMass)
+}
+
+// SetAncestorStats sets the total fee and mass of this MempoolTransaction and all its ancestors in the mempool
+func (mt *MempoolTransaction) SetAncestorStats(ancestorFee uint64, ancestorMass uint64) {
+	mt.ancestorFee = ancestorFee
+	mt.ancestorMass = ancestorMass
+}
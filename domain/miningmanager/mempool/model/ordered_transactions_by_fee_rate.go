@@ -63,7 +63,8 @@ func (tobf *TransactionsOrderedByFeeRate) RemoveAtIndex(index int) error {
 // If the transaction was not found, will return wasFound=false and index=the index at which transaction can be inserted
 // while preserving the order.
 func (tobf *TransactionsOrderedByFeeRate) findTransactionIndex(transaction *MempoolTransaction) (index int, wasFound bool, err error) {
-	if transaction.Transaction().Fee == 0 || transaction.Transaction().Mass == 0 {
+	// A zero fee is valid for a transaction that's paid for by its descendants, so only the mass is checked
+	if transaction.Transaction().Mass == 0 {
 		return 0, false, errors.Errorf("findTransactionIndex expects a transaction with " +
 			"populated fee and mass")
 	}
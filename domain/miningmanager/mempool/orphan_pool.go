@@ -204,7 +204,13 @@ func (op *orphansPool) unorphanTransaction(transaction *model.OrphanTransaction)
 		return err
 	}
 
-	err = op.mempool.validateTransactionInContext(transaction.Transaction())
+	err = op.mempool.validateTransactionInContext(transaction.Transaction(), true)
+	if err != nil {
+		return err
+	}
+
+	parentTransactionsInPool := op.mempool.transactionsPool.getParentTransactionsInPool(transaction.Transaction())
+	err = op.mempool.transactionsPool.checkChainLimits(transaction.Transaction(), parentTransactionsInPool)
 	if err != nil {
 		return err
 	}
@@ -215,7 +221,7 @@ func (op *orphansPool) unorphanTransaction(transaction *model.OrphanTransaction)
 	}
 	mempoolTransaction := model.NewMempoolTransaction(
 		transaction.Transaction(),
-		op.mempool.transactionsPool.getParentTransactionsInPool(transaction.Transaction()),
+		parentTransactionsInPool,
 		false,
 		virtualDAAScore,
 	)
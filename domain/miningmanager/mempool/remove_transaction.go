@@ -3,9 +3,14 @@ package mempool
 import (
 	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
 	"github.com/wombatlabs/coinsecd/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/wombatlabs/coinsecd/domain/miningmanager/model"
 )
 
-func (mp *mempool) removeTransaction(transactionID *externalapi.DomainTransactionID, removeRedeemers bool) error {
+// removeTransaction removes the given transaction from the mempool. The removal of the transaction,
+// and of its redeemers if removeRedeemers is set, is reported to the changed handler with the given reason
+func (mp *mempool) removeTransaction(transactionID *externalapi.DomainTransactionID, removeRedeemers bool,
+	reason miningmanagermodel.MempoolChangeReason) error {
+
 	if _, ok := mp.orphansPool.allOrphans[*transactionID]; ok {
 		return mp.orphansPool.removeOrphan(transactionID, true)
 	}
@@ -30,6 +35,14 @@ func (mp *mempool) removeTransaction(transactionID *externalapi.DomainTransactio
 		if err != nil {
 			return err
 		}
+		mp.recordChange(transactionToRemove, reason)
+	}
+
+	// The remaining redeemers no longer have the removed transaction as an ancestor
+	if !removeRedeemers {
+		for _, redeemer := range redeemers {
+			mp.transactionsPool.updateAncestorStats(redeemer)
+		}
 	}
 
 	if removeRedeemers {
@@ -0,0 +1,83 @@
+package mempool
+
+import (
+	"fmt"
+
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/wombatlabs/coinsecd/domain/miningmanager/model"
+)
+
+// validateReplacement checks that the given transaction may replace the given conflicting
+// mempool transactions, and returns all the transactions it would replace: the conflicting
+// transactions along with their descendants.
+//
+// A replacement must pay a strictly higher fee rate than each of the transactions it
+// replaces, and a higher total fee than all of them combined. The difference in total
+// fee must also cover the minimum relay fee of the replacement, so that a transaction
+// can't be replaced over and over again for free.
+func (mp *mempool) validateReplacement(transaction *externalapi.DomainTransaction,
+	parentsInPool model.IDToTransactionMap, conflictingTransactions []*model.MempoolTransaction) (
+	model.IDToTransactionMap, error) {
+
+	transactionID := consensushashing.TransactionID(transaction)
+
+	replacedTransactions := model.IDToTransactionMap{}
+	for _, conflictingTransaction := range conflictingTransactions {
+		replacedTransactions[*conflictingTransaction.TransactionID()] = conflictingTransaction
+		for _, redeemer := range mp.transactionsPool.getRedeemers(conflictingTransaction) {
+			replacedTransactions[*redeemer.TransactionID()] = redeemer
+		}
+	}
+	if uint64(len(replacedTransactions)) > mp.config.MaximumReplacedTransactionCount {
+		return nil, transactionRuleError(RejectNonstandard, fmt.Sprintf(
+			"transaction %s would replace %d transactions, which is over the maximum of %d",
+			transactionID, len(replacedTransactions), mp.config.MaximumReplacedTransactionCount))
+	}
+
+	for parentID := range parentsInPool {
+		if _, ok := replacedTransactions[parentID]; ok {
+			return nil, transactionRuleError(RejectNonstandard, fmt.Sprintf(
+				"transaction %s spends an output of transaction %s, which it replaces", transactionID, parentID))
+		}
+	}
+
+	feeRate := transactionFeeRate(transaction)
+	replacedFee := uint64(0)
+	for replacedTransactionID, replacedTransaction := range replacedTransactions {
+		replacedFeeRate := transactionFeeRate(replacedTransaction.Transaction())
+		if feeRate <= replacedFeeRate {
+			return nil, transactionRuleError(RejectInsufficientFee, fmt.Sprintf(
+				"transaction %s has a fee rate of %f sompi/gram, which is not higher than the fee rate "+
+					"of %f sompi/gram of transaction %s, which it replaces",
+				transactionID, feeRate, replacedFeeRate, replacedTransactionID))
+		}
+		replacedFee += replacedTransaction.Transaction().Fee
+	}
+
+	minimumFee := replacedFee + mp.minimumRequiredTransactionRelayFee(transaction.Mass)
+	if transaction.Fee < minimumFee {
+		return nil, transactionRuleError(RejectInsufficientFee, fmt.Sprintf(
+			"transaction %s has %d fees, but replacing %d transactions with a total of %d fees "+
+				"requires at least %d fees", transactionID, transaction.Fee, len(replacedTransactions),
+			replacedFee, minimumFee))
+	}
+
+	return replacedTransactions, nil
+}
+
+// removeReplacedTransactions removes the given conflicting transactions, along with their
+// descendants, from the mempool
+func (mp *mempool) removeReplacedTransactions(transactionID *externalapi.DomainTransactionID,
+	conflictingTransactions []*model.MempoolTransaction) error {
+
+	for _, conflictingTransaction := range conflictingTransactions {
+		log.Debugf("Transaction %s replaces transaction %s", transactionID, conflictingTransaction.TransactionID())
+		err := mp.removeTransaction(conflictingTransaction.TransactionID(), true, miningmanagermodel.MempoolChangeReasonReplaced)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
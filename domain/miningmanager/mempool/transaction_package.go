@@ -0,0 +1,158 @@
+package mempool
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/wombatlabs/coinsecd/domain/miningmanager/model"
+)
+
+// validateAndInsertTransactionPackage inserts the given transactions to the mempool as a single unit:
+// either all of them are accepted or none is. The transactions must be sorted so that parents come
+// before their children, and every transaction must be an ancestor of the last one.
+// Fee requirements apply to the package as a whole, so a transaction paying less than the minimum
+// relay fee is accepted as long as its descendants in the package pay for it.
+// Transactions that are already in the mempool are skipped.
+func (mp *mempool) validateAndInsertTransactionPackage(transactions []*externalapi.DomainTransaction,
+	isHighPriority bool) (acceptedTransactions []*externalapi.DomainTransaction, err error) {
+
+	err = mp.validatePackageTopology(transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	insertedTransactionIDs := make([]*externalapi.DomainTransactionID, 0, len(transactions))
+	pendingChangesCount := len(mp.pendingChanges)
+	defer func() {
+		if err == nil {
+			return
+		}
+		// Children are removed before their parents, so that no inserted transaction is left orphaned
+		for i := len(insertedTransactionIDs) - 1; i >= 0; i-- {
+			removeErr := mp.removeTransaction(insertedTransactionIDs[i], true,
+				miningmanagermodel.MempoolChangeReasonInvalidated)
+			if removeErr != nil {
+				log.Errorf("Error removing transaction %s of a rejected package: %+v", insertedTransactionIDs[i], removeErr)
+			}
+		}
+		mp.discardChangesOfTransactionsAddedSince(pendingChangesCount)
+	}()
+
+	packageFee := uint64(0)
+	packageMass := uint64(0)
+	packageTransactions := model.IDToTransactionMap{}
+	acceptedTransactions = make([]*externalapi.DomainTransaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		transactionID := consensushashing.TransactionID(transaction)
+		if _, ok := mp.transactionsPool.allTransactions[*transactionID]; ok {
+			continue
+		}
+
+		accepted, _, err := mp.validateAndInsertTransaction(
+			transaction, isHighPriority, false, miningmanagermodel.RBFPolicyForbidden, true)
+		if err != nil {
+			return nil, err
+		}
+		insertedTransactionIDs = append(insertedTransactionIDs, transactionID)
+		acceptedTransactions = append(acceptedTransactions, accepted...)
+
+		packageFee += transaction.Fee
+		packageMass += transaction.Mass
+		packageTransactions[*transactionID] = mp.transactionsPool.allTransactions[*transactionID]
+	}
+	if len(packageTransactions) == 0 {
+		return acceptedTransactions, nil
+	}
+
+	lastTransactionID := consensushashing.TransactionID(transactions[len(transactions)-1])
+	packageDescription := fmt.Sprintf("the package of transaction %s", lastTransactionID)
+	if !mp.config.AcceptNonStandard {
+		minimumFee := mp.minimumRequiredTransactionRelayFee(packageMass)
+		if packageFee < minimumFee {
+			return nil, transactionRuleError(RejectInsufficientFee, fmt.Sprintf(
+				"%s has %d fees which is under the required amount of %d", packageDescription, packageFee, minimumFee))
+		}
+	}
+
+	if !isHighPriority {
+		minimumFee := uint64(math.Ceil(float64(packageMass) * mp.transactionsPool.dynamicMinimumFeeRate.current()))
+		if packageFee < minimumFee {
+			return nil, transactionRuleError(RejectInsufficientFee, fmt.Sprintf(
+				"%s has %d fees which is under the required amount of %d while the mempool is under pressure",
+				packageDescription, packageFee, minimumFee))
+		}
+
+		// The package is already in the pool, so it only needs room to stay there. Evicting
+		// any of its ancestors would orphan it
+		protectedTransactions := mp.transactionsPool.getAncestors(packageTransactions)
+		packageFeeRate := float64(packageFee) / float64(packageMass)
+		err = mp.transactionsPool.makeRoom(packageDescription, 0, 0, packageFeeRate, protectedTransactions, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = mp.transactionsPool.limitTransactionsPoolSize()
+	if err != nil {
+		return nil, err
+	}
+
+	return acceptedTransactions, nil
+}
+
+// validatePackageTopology makes sure the given transactions are sorted so that parents come before
+// their children, and that every transaction is an ancestor of the last one
+func (mp *mempool) validatePackageTopology(transactions []*externalapi.DomainTransaction) error {
+	if len(transactions) == 0 {
+		return transactionRuleError(RejectInvalid, "the package has no transactions")
+	}
+	if uint64(len(transactions)) > mp.config.MaximumPackageTransactionCount {
+		return transactionRuleError(RejectNonstandard, fmt.Sprintf(
+			"the package has %d transactions, which is more than the maximum of %d",
+			len(transactions), mp.config.MaximumPackageTransactionCount))
+	}
+
+	indexes := make(map[externalapi.DomainTransactionID]int, len(transactions))
+	for i, transaction := range transactions {
+		transactionID := consensushashing.TransactionID(transaction)
+		if _, ok := indexes[*transactionID]; ok {
+			return transactionRuleError(RejectInvalid, fmt.Sprintf(
+				"transaction %s appears more than once in the package", transactionID))
+		}
+		indexes[*transactionID] = i
+	}
+
+	parentIndexes := make([][]int, len(transactions))
+	for i, transaction := range transactions {
+		for _, input := range transaction.Inputs {
+			parentIndex, ok := indexes[input.PreviousOutpoint.TransactionID]
+			if !ok {
+				continue
+			}
+			if parentIndex >= i {
+				return transactionRuleError(RejectInvalid, fmt.Sprintf(
+					"transaction %s appears in the package before its parent %s",
+					consensushashing.TransactionID(transaction), input.PreviousOutpoint.TransactionID))
+			}
+			parentIndexes[i] = append(parentIndexes[i], parentIndex)
+		}
+	}
+
+	isAncestorOfLast := make([]bool, len(transactions))
+	isAncestorOfLast[len(transactions)-1] = true
+	for i := len(transactions) - 1; i >= 0; i-- {
+		if !isAncestorOfLast[i] {
+			return transactionRuleError(RejectNonstandard, fmt.Sprintf(
+				"transaction %s in the package is not an ancestor of the last transaction",
+				consensushashing.TransactionID(transactions[i])))
+		}
+		for _, parentIndex := range parentIndexes[i] {
+			isAncestorOfLast[parentIndex] = true
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package mempool
 
 import (
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/pkg/errors"
@@ -8,6 +10,7 @@ import (
 	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
 	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
 	"github.com/wombatlabs/coinsecd/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/wombatlabs/coinsecd/domain/miningmanager/model"
 )
 
 type transactionsPool struct {
@@ -16,6 +19,8 @@ type transactionsPool struct {
 	highPriorityTransactions      model.IDToTransactionMap
 	chainedTransactionsByParentID model.IDToTransactionsSliceMap
 	transactionsOrderedByFeeRate  model.TransactionsOrderedByFeeRate
+	totalMass                     uint64
+	dynamicMinimumFeeRate         *dynamicMinimumFeeRate
 	lastExpireScanDAAScore        uint64
 	lastExpireScanTime            time.Time
 }
@@ -27,6 +32,8 @@ func newTransactionsPool(mp *mempool) *transactionsPool {
 		highPriorityTransactions:      model.IDToTransactionMap{},
 		chainedTransactionsByParentID: model.IDToTransactionsSliceMap{},
 		transactionsOrderedByFeeRate:  model.TransactionsOrderedByFeeRate{},
+		totalMass:                     0,
+		dynamicMinimumFeeRate:         newDynamicMinimumFeeRate(mp.config),
 		lastExpireScanDAAScore:        0,
 		lastExpireScanTime:            time.Now(),
 	}
@@ -52,7 +59,9 @@ func (tp *transactionsPool) addTransaction(transaction *externalapi.DomainTransa
 }
 
 func (tp *transactionsPool) addMempoolTransaction(transaction *model.MempoolTransaction) error {
+	tp.updateAncestorStats(transaction)
 	tp.allTransactions[*transaction.TransactionID()] = transaction
+	tp.totalMass += transaction.Transaction().Mass
 
 	for _, parentTransactionInPool := range transaction.ParentTransactionsInPool() {
 		parentTransactionID := *parentTransactionInPool.TransactionID()
@@ -74,10 +83,15 @@ func (tp *transactionsPool) addMempoolTransaction(transaction *model.MempoolTran
 		tp.highPriorityTransactions[*transaction.TransactionID()] = transaction
 	}
 
+	tp.mempool.recordChange(transaction, miningmanagermodel.MempoolChangeReasonAdded)
+
 	return nil
 }
 
 func (tp *transactionsPool) removeTransaction(transaction *model.MempoolTransaction) error {
+	if _, ok := tp.allTransactions[*transaction.TransactionID()]; ok {
+		tp.totalMass -= transaction.Transaction().Mass
+	}
 	delete(tp.allTransactions, *transaction.TransactionID())
 
 	err := tp.transactionsOrderedByFeeRate.Remove(transaction)
@@ -119,7 +133,8 @@ func (tp *transactionsPool) expireOldTransactions() error {
 		if daaScoreSinceAdded > tp.mempool.config.TransactionExpireIntervalDAAScore {
 			log.Debugf("Removing transaction %s, because it expired. DAAScore moved by %d, expire interval: %d",
 				mempoolTransaction.TransactionID(), daaScoreSinceAdded, tp.mempool.config.TransactionExpireIntervalDAAScore)
-			err = tp.mempool.removeTransaction(mempoolTransaction.TransactionID(), true)
+			err = tp.mempool.removeTransaction(mempoolTransaction.TransactionID(), true,
+				miningmanagermodel.MempoolChangeReasonExpired)
 			if err != nil {
 				return err
 			}
@@ -131,12 +146,15 @@ func (tp *transactionsPool) expireOldTransactions() error {
 	return nil
 }
 
-func (tp *transactionsPool) allReadyTransactions() []*externalapi.DomainTransaction {
-	result := []*externalapi.DomainTransaction{}
+func (tp *transactionsPool) allReadyTransactions() []*miningmanagermodel.BlockCandidateTransaction {
+	result := []*miningmanagermodel.BlockCandidateTransaction{}
 
 	for _, mempoolTransaction := range tp.allTransactions {
 		if len(mempoolTransaction.ParentTransactionsInPool()) == 0 {
-			result = append(result, mempoolTransaction.Transaction().Clone()) //this pointer leaves the mempool, and gets its utxo set to nil, hence we clone.
+			result = append(result, &miningmanagermodel.BlockCandidateTransaction{
+				Transaction:    mempoolTransaction.Transaction().Clone(), //this pointer leaves the mempool, and gets its utxo set to nil, hence we clone.
+				PackageFeeRate: tp.packageFeeRate(mempoolTransaction),
+			})
 		}
 	}
 
@@ -173,38 +191,299 @@ func (tp *transactionsPool) getRedeemers(transaction *model.MempoolTransaction)
 	return redeemers
 }
 
-func (tp *transactionsPool) limitTransactionCount() error {
-	currentIndex := 0
+// checkChainLimits rejects the given transaction if adding it to the pool would give it too many
+// in-pool ancestors, or give any of its ancestors too many in-pool descendants. The counts and
+// masses include the transactions themselves
+func (tp *transactionsPool) checkChainLimits(transaction *externalapi.DomainTransaction,
+	parentTransactionsInPool model.IDToTransactionMap) error {
+
+	config := tp.mempool.config
+	transactionID := consensushashing.TransactionID(transaction)
+	ancestors := tp.getAncestors(parentTransactionsInPool)
+
+	ancestorCount := uint64(len(ancestors)) + 1
+	if ancestorCount > config.MaximumAncestorCount {
+		return transactionRuleError(RejectNonstandard, fmt.Sprintf(
+			"transaction %s has %d ancestors in the mempool, including itself, which is more than the maximum of %d",
+			transactionID, ancestorCount, config.MaximumAncestorCount))
+	}
+	ancestorMass := transaction.Mass
+	for _, ancestor := range ancestors {
+		ancestorMass += ancestor.Transaction().Mass
+	}
+	if ancestorMass > config.MaximumAncestorMass {
+		return transactionRuleError(RejectNonstandard, fmt.Sprintf(
+			"transaction %s has ancestors in the mempool with a total mass of %d, including itself, "+
+				"which is more than the maximum of %d", transactionID, ancestorMass, config.MaximumAncestorMass))
+	}
 
-	for uint64(len(tp.allTransactions)) > tp.mempool.config.MaximumTransactionCount {
-		var transactionToRemove *model.MempoolTransaction
-		for {
-			transactionToRemove = tp.transactionsOrderedByFeeRate.GetByIndex(currentIndex)
-			if !transactionToRemove.IsHighPriority() {
-				break
-			}
-			currentIndex++
-			if currentIndex >= len(tp.allTransactions) {
-				log.Warnf(
-					"Number of high-priority transactions in mempool (%d) is higher than maximum allowed (%d)",
-					len(tp.allTransactions), tp.mempool.config.MaximumTransactionCount)
-				return nil
+	for _, ancestor := range ancestors {
+		descendants := tp.getDescendants(ancestor)
+
+		// The ancestor itself and the given transaction are counted as well
+		descendantCount := uint64(len(descendants)) + 2
+		if descendantCount > config.MaximumDescendantCount {
+			return transactionRuleError(RejectNonstandard, fmt.Sprintf(
+				"transaction %s would give its ancestor %s %d descendants in the mempool, including itself, "+
+					"which is more than the maximum of %d",
+				transactionID, ancestor.TransactionID(), descendantCount, config.MaximumDescendantCount))
+		}
+		descendantMass := ancestor.Transaction().Mass + transaction.Mass
+		for _, descendant := range descendants {
+			descendantMass += descendant.Transaction().Mass
+		}
+		if descendantMass > config.MaximumDescendantMass {
+			return transactionRuleError(RejectNonstandard, fmt.Sprintf(
+				"transaction %s would give its ancestor %s descendants in the mempool with a total mass of %d, "+
+					"including itself, which is more than the maximum of %d",
+				transactionID, ancestor.TransactionID(), descendantMass, config.MaximumDescendantMass))
+		}
+	}
+
+	return nil
+}
+
+// exceedsLimits returns whether a pool with the given transaction count and total mass
+// would exceed the transaction count or mass limits
+func (tp *transactionsPool) exceedsLimits(transactionCount int, totalMass uint64) bool {
+	return uint64(transactionCount) > tp.mempool.config.MaximumTransactionCount ||
+		totalMass > tp.mempool.config.MaximumMempoolMass
+}
+
+// checkDynamicMinimumFee rejects the given transaction if it doesn't pay the dynamic minimum fee,
+// which is raised while the pool is under pressure
+func (tp *transactionsPool) checkDynamicMinimumFee(transaction *externalapi.DomainTransaction) error {
+	minimumFee := uint64(math.Ceil(float64(transaction.Mass) * tp.dynamicMinimumFeeRate.current()))
+	if transaction.Fee < minimumFee {
+		return transactionRuleError(RejectInsufficientFee, fmt.Sprintf(
+			"transaction %s has %d fees which is under the required amount of %d while the mempool is under pressure",
+			consensushashing.TransactionID(transaction), transaction.Fee, minimumFee))
+	}
+	return nil
+}
+
+// makeRoomForTransaction evicts the transactions with the lowest fee rate, along with their
+// redeemers, until the given transaction fits in the pool. If that would require evicting a
+// transaction whose fee rate isn't lower than the given transaction's, nothing is evicted and
+// the given transaction is rejected.
+// replacedTransactions are the transactions the given transaction replaces. They are counted as
+// already removed, and are left for the caller to remove.
+func (tp *transactionsPool) makeRoomForTransaction(transaction *externalapi.DomainTransaction,
+	parentTransactionsInPool model.IDToTransactionMap, replacedTransactions model.IDToTransactionMap) error {
+
+	transactionID := consensushashing.TransactionID(transaction)
+	if transaction.Mass > tp.mempool.config.MaximumMempoolMass {
+		return transactionRuleError(RejectInsufficientFee, fmt.Sprintf(
+			"transaction %s has mass %d which is over the maximum mempool mass %d",
+			transactionID, transaction.Mass, tp.mempool.config.MaximumMempoolMass))
+	}
+
+	// Evicting an ancestor of the transaction would orphan it
+	ancestors := tp.getAncestors(parentTransactionsInPool)
+	return tp.makeRoom(fmt.Sprintf("transaction %s", transactionID), 1, transaction.Mass,
+		transactionFeeRate(transaction), ancestors, replacedTransactions)
+}
+
+// makeRoom evicts the transactions with the lowest fee rate, along with their redeemers, until
+// incomingCount transactions with a total mass of incomingMass fit in the pool. Transactions whose
+// package fee rate isn't lower than feeRate, and protectedTransactions, are never evicted. If there's
+// not enough room without evicting them, nothing is evicted and an error is returned.
+// replacedTransactions are counted as already removed, and are left for the caller to remove.
+func (tp *transactionsPool) makeRoom(incomingDescription string, incomingCount int, incomingMass uint64,
+	feeRate float64, protectedTransactions model.IDToTransactionMap, replacedTransactions model.IDToTransactionMap) error {
+
+	selectedForEviction := model.IDToTransactionMap{}
+	evictedCount := 0
+	evictedMass := uint64(0)
+	for replacedTransactionID, replacedTransaction := range replacedTransactions {
+		selectedForEviction[replacedTransactionID] = replacedTransaction
+		evictedCount++
+		evictedMass += replacedTransaction.Transaction().Mass
+	}
+	exceedsLimits := func() bool {
+		return tp.exceedsLimits(len(tp.allTransactions)+incomingCount-evictedCount, tp.totalMass+incomingMass-evictedMass)
+	}
+	if !exceedsLimits() {
+		return nil
+	}
+
+	transactionsToEvict := []*model.MempoolTransaction{}
+	highestEvictedFeeRate := 0.0
+	for i := 0; i < len(tp.allTransactions); i++ {
+		if !exceedsLimits() {
+			break
+		}
+
+		candidate := tp.transactionsOrderedByFeeRate.GetByIndex(i)
+		candidateID := *candidate.TransactionID()
+		if _, ok := selectedForEviction[candidateID]; ok {
+			continue
+		}
+		if _, ok := protectedTransactions[candidateID]; ok || candidate.IsHighPriority() {
+			continue
+		}
+
+		candidateFeeRate := transactionFeeRate(candidate.Transaction())
+		if candidateFeeRate >= feeRate {
+			return transactionRuleError(RejectInsufficientFee, fmt.Sprintf(
+				"the mempool is full and %s has a fee rate of %f sompi/gram, "+
+					"which is not higher than the lowest fee rate in the mempool (%f sompi/gram)",
+				incomingDescription, feeRate, candidateFeeRate))
+		}
+		// A transaction whose descendants pay for it is worth their package fee rate
+		if tp.packageFeeRate(candidate) >= feeRate {
+			continue
+		}
+
+		transactionsToEvict = append(transactionsToEvict, candidate)
+		for _, evictedTransaction := range append([]*model.MempoolTransaction{candidate}, tp.getRedeemers(candidate)...) {
+			evictedTransactionID := *evictedTransaction.TransactionID()
+			if _, ok := selectedForEviction[evictedTransactionID]; ok {
+				continue
 			}
+			selectedForEviction[evictedTransactionID] = evictedTransaction
+			evictedCount++
+			evictedMass += evictedTransaction.Transaction().Mass
 		}
+		if candidateFeeRate > highestEvictedFeeRate {
+			highestEvictedFeeRate = candidateFeeRate
+		}
+	}
+	if exceedsLimits() {
+		return transactionRuleError(RejectInsufficientFee, fmt.Sprintf(
+			"the mempool is full and there are not enough transactions with a fee rate lower than "+
+				"%s to evict", incomingDescription))
+	}
 
-		log.Debugf("Removing transaction %s, because mempoolTransaction count (%d) exceeded the limit (%d)",
-			transactionToRemove.TransactionID(), len(tp.allTransactions), tp.mempool.config.MaximumTransactionCount)
-		err := tp.mempool.removeTransaction(transactionToRemove.TransactionID(), true)
+	for _, transactionToEvict := range transactionsToEvict {
+		log.Debugf("Evicting transaction %s with fee rate %f sompi/gram to make room for %s",
+			transactionToEvict.TransactionID(), transactionFeeRate(transactionToEvict.Transaction()), incomingDescription)
+		err := tp.mempool.removeTransaction(transactionToEvict.TransactionID(), true,
+			miningmanagermodel.MempoolChangeReasonEvicted)
 		if err != nil {
 			return err
 		}
-		if currentIndex >= len(tp.allTransactions) {
-			break
+	}
+	if len(transactionsToEvict) > 0 {
+		tp.dynamicMinimumFeeRate.raise(highestEvictedFeeRate)
+	}
+
+	return nil
+}
+
+// limitTransactionsPoolSize evicts the transactions with the lowest package fee rate, along with
+// their redeemers, until the pool is within its transaction count and mass limits
+func (tp *transactionsPool) limitTransactionsPoolSize() error {
+	for tp.exceedsLimits(len(tp.allTransactions), tp.totalMass) {
+		transactionToRemove, packageFeeRate, ok := tp.lowestPackageFeeRateTransaction()
+		if !ok {
+			log.Warnf(
+				"High-priority transactions in mempool (count: %d, mass: %d) exceed the maximum allowed "+
+					"(count: %d, mass: %d)", len(tp.allTransactions), tp.totalMass,
+				tp.mempool.config.MaximumTransactionCount, tp.mempool.config.MaximumMempoolMass)
+			return nil
+		}
+
+		log.Debugf("Removing transaction %s with package fee rate %f sompi/gram, because the mempool "+
+			"(count: %d, mass: %d) exceeded its limits (count: %d, mass: %d)", transactionToRemove.TransactionID(),
+			packageFeeRate, len(tp.allTransactions), tp.totalMass,
+			tp.mempool.config.MaximumTransactionCount, tp.mempool.config.MaximumMempoolMass)
+		err := tp.mempool.removeTransaction(transactionToRemove.TransactionID(), true,
+			miningmanagermodel.MempoolChangeReasonEvicted)
+		if err != nil {
+			return err
 		}
+		tp.dynamicMinimumFeeRate.raise(packageFeeRate)
 	}
 	return nil
 }
 
+// lowestPackageFeeRateTransaction returns the transaction that isn't high priority with the lowest
+// package fee rate, along with that rate. ok is false if all the transactions are high priority.
+// A transaction's package fee rate is never lower than its own fee rate, so transactions are only
+// checked until their own fee rate reaches the lowest package fee rate found
+func (tp *transactionsPool) lowestPackageFeeRateTransaction() (
+	transaction *model.MempoolTransaction, packageFeeRate float64, ok bool) {
+
+	packageFeeRate = math.Inf(1)
+	for i := 0; i < len(tp.allTransactions); i++ {
+		candidate := tp.transactionsOrderedByFeeRate.GetByIndex(i)
+		if candidate.IsHighPriority() {
+			continue
+		}
+		if transactionFeeRate(candidate.Transaction()) >= packageFeeRate {
+			break
+		}
+		candidatePackageFeeRate := tp.packageFeeRate(candidate)
+		if candidatePackageFeeRate < packageFeeRate {
+			transaction = candidate
+			packageFeeRate = candidatePackageFeeRate
+		}
+	}
+	return transaction, packageFeeRate, transaction != nil
+}
+
+// getAncestors returns the given in-pool parents along with all their in-pool ancestors
+func (tp *transactionsPool) getAncestors(parentTransactionsInPool model.IDToTransactionMap) model.IDToTransactionMap {
+	ancestors := model.IDToTransactionMap{}
+	stack := make([]*model.MempoolTransaction, 0, len(parentTransactionsInPool))
+	for _, parent := range parentTransactionsInPool {
+		stack = append(stack, parent)
+	}
+	for len(stack) > 0 {
+		var current *model.MempoolTransaction
+		last := len(stack) - 1
+		current, stack = stack[last], stack[:last]
+
+		if _, ok := ancestors[*current.TransactionID()]; ok {
+			continue
+		}
+		ancestors[*current.TransactionID()] = current
+		for _, parent := range current.ParentTransactionsInPool() {
+			stack = append(stack, parent)
+		}
+	}
+	return ancestors
+}
+
+// getDescendants returns all the in-pool descendants of the given transaction. Unlike getRedeemers,
+// every descendant appears once even if it's reachable through several paths
+func (tp *transactionsPool) getDescendants(transaction *model.MempoolTransaction) model.IDToTransactionMap {
+	descendants := model.IDToTransactionMap{}
+	for _, redeemer := range tp.getRedeemers(transaction) {
+		descendants[*redeemer.TransactionID()] = redeemer
+	}
+	return descendants
+}
+
+// updateAncestorStats sets the total fee and mass of the given transaction and all its
+// ancestors in the pool
+func (tp *transactionsPool) updateAncestorStats(transaction *model.MempoolTransaction) {
+	ancestorFee := transaction.Transaction().Fee
+	ancestorMass := transaction.Transaction().Mass
+	for _, ancestor := range tp.getAncestors(transaction.ParentTransactionsInPool()) {
+		ancestorFee += ancestor.Transaction().Fee
+		ancestorMass += ancestor.Transaction().Mass
+	}
+	transaction.SetAncestorStats(ancestorFee, ancestorMass)
+}
+
+// packageFeeRate returns the fee rate, in sompi/gram, miners get for mining the given transaction.
+// That's the higher between the transaction's own fee rate and the ancestor fee rate of any of its
+// redeemers, since mining the transaction is what allows a redeemer to pay for its ancestors
+func (tp *transactionsPool) packageFeeRate(transaction *model.MempoolTransaction) float64 {
+	feeRate := transactionFeeRate(transaction.Transaction())
+	for _, redeemer := range tp.getRedeemers(transaction) {
+		feeRate = math.Max(feeRate, redeemer.AncestorFeeRate())
+	}
+	return feeRate
+}
+
+// transactionFeeRate returns the fee rate of the given transaction in sompi/gram
+func transactionFeeRate(transaction *externalapi.DomainTransaction) float64 {
+	return float64(transaction.Fee) / float64(transaction.Mass)
+}
+
 func (tp *transactionsPool) getTransaction(transactionID *externalapi.DomainTransactionID, clone bool) (*externalapi.DomainTransaction, bool) {
 	if mempoolTransaction, ok := tp.allTransactions[*transactionID]; ok {
 		if clone {
@@ -247,6 +526,35 @@ func (tp *transactionsPool) getAllTransactions() []*externalapi.DomainTransactio
 	return allTransactions
 }
 
+// allTransactionEntries returns all the transactions in the pool along with their metadata,
+// ordered so that every transaction comes after its parents in the pool
+func (tp *transactionsPool) allTransactionEntries() []*miningmanagermodel.MempoolTransactionEntry {
+	entries := make([]*miningmanagermodel.MempoolTransactionEntry, 0, len(tp.allTransactions))
+	visited := make(map[externalapi.DomainTransactionID]struct{}, len(tp.allTransactions))
+
+	var visit func(mempoolTransaction *model.MempoolTransaction)
+	visit = func(mempoolTransaction *model.MempoolTransaction) {
+		if _, ok := visited[*mempoolTransaction.TransactionID()]; ok {
+			return
+		}
+		visited[*mempoolTransaction.TransactionID()] = struct{}{}
+
+		for _, parent := range mempoolTransaction.ParentTransactionsInPool() {
+			visit(parent)
+		}
+		entries = append(entries, &miningmanagermodel.MempoolTransactionEntry{
+			Transaction:     mempoolTransaction.Transaction().Clone(), //this pointer leaves the mempool, hence we clone.
+			IsHighPriority:  mempoolTransaction.IsHighPriority(),
+			AddedAt:         mempoolTransaction.AddedAt(),
+			AddedAtDAAScore: mempoolTransaction.AddedAtDAAScore(),
+		})
+	}
+	for _, mempoolTransaction := range tp.allTransactions {
+		visit(mempoolTransaction)
+	}
+	return entries
+}
+
 func (tp *transactionsPool) transactionCount() int {
 	return len(tp.allTransactions)
 }
@@ -6,10 +6,16 @@ import (
 
 	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
 	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/mempool/model"
+	miningmanagermodel "github.com/wombatlabs/coinsecd/domain/miningmanager/model"
 )
 
+// validateAndInsertTransaction validates the given transaction and inserts it to the mempool.
+// Fee requirements of a package transaction are checked for the whole package by
+// validateAndInsertTransactionPackage, so they're skipped when isPackageTransaction is set
 func (mp *mempool) validateAndInsertTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool,
-	allowOrphan bool) (acceptedTransactions []*externalapi.DomainTransaction, err error) {
+	allowOrphan bool, rbfPolicy miningmanagermodel.RBFPolicy, isPackageTransaction bool) (
+	acceptedTransactions []*externalapi.DomainTransaction, replacedTransactions []*externalapi.DomainTransaction, err error) {
 
 	onEnd := logger.LogAndMeasureExecutionTime(log,
 		fmt.Sprintf("validateAndInsertTransaction %s", consensushashing.TransactionID(transaction)))
@@ -18,47 +24,96 @@ func (mp *mempool) validateAndInsertTransaction(transaction *externalapi.DomainT
 	// Populate mass in the beginning, it will be used in multiple places throughout the validation and insertion.
 	mp.consensusReference.Consensus().PopulateMass(transaction)
 
-	err = mp.validateTransactionPreUTXOEntry(transaction)
+	err = mp.validateTransactionPreUTXOEntry(transaction, rbfPolicy)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	conflictingTransactions := mp.mempoolUTXOSet.getConflictingTransactions(transaction)
+	if rbfPolicy == miningmanagermodel.RBFPolicyMandatory && len(conflictingTransactions) == 0 {
+		str := fmt.Sprintf("Transaction %s doesn't double spend any transaction in the mempool, "+
+			"so there's nothing for it to replace", consensushashing.TransactionID(transaction))
+		return nil, nil, transactionRuleError(RejectNonstandard, str)
 	}
 
 	parentsInPool, missingOutpoints, err := mp.fillInputsAndGetMissingParents(transaction)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if len(missingOutpoints) > 0 {
+		// An orphan's fee is unknown, so it can't replace anything
+		if len(conflictingTransactions) > 0 {
+			return nil, nil, mp.mempoolUTXOSet.checkDoubleSpends(transaction)
+		}
 		if !allowOrphan {
 			str := fmt.Sprintf("Transaction %s is an orphan, where allowOrphan = false",
 				consensushashing.TransactionID(transaction))
-			return nil, transactionRuleError(RejectBadOrphan, str)
+			return nil, nil, transactionRuleError(RejectBadOrphan, str)
 		}
 
-		return nil, mp.orphansPool.maybeAddOrphan(transaction, isHighPriority)
+		return nil, nil, mp.orphansPool.maybeAddOrphan(transaction, isHighPriority)
+	}
+
+	err = mp.validateTransactionInContext(transaction, !isPackageTransaction)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	err = mp.validateTransactionInContext(transaction)
+	err = mp.transactionsPool.checkChainLimits(transaction, parentsInPool)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	replacedTransactionsInPool := model.IDToTransactionMap{}
+	if len(conflictingTransactions) > 0 {
+		replacedTransactionsInPool, err = mp.validateReplacement(transaction, parentsInPool, conflictingTransactions)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// High-priority transactions don't compete on fee rate. If the pool
+	// is full they're inserted anyway, and limitTransactionsPoolSize below
+	// evicts low-priority transactions instead.
+	if !isHighPriority && !isPackageTransaction {
+		err = mp.transactionsPool.checkDynamicMinimumFee(transaction)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = mp.transactionsPool.makeRoomForTransaction(transaction, parentsInPool, replacedTransactionsInPool)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	replacedTransactions = make([]*externalapi.DomainTransaction, 0, len(replacedTransactionsInPool))
+	for _, replacedTransaction := range replacedTransactionsInPool {
+		replacedTransactions = append(replacedTransactions, replacedTransaction.Transaction().Clone()) //these pointer leave the mempool, hence we clone.
+	}
+	err = mp.removeReplacedTransactions(consensushashing.TransactionID(transaction), conflictingTransactions)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	mempoolTransaction, err := mp.transactionsPool.addTransaction(transaction, parentsInPool, isHighPriority)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	acceptedOrphans, err := mp.orphansPool.processOrphansAfterAcceptedTransaction(mempoolTransaction.Transaction())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	acceptedTransactions = append([]*externalapi.DomainTransaction{transaction.Clone()}, acceptedOrphans...) //these pointer leave the mempool, hence we clone.
 
-	err = mp.transactionsPool.limitTransactionCount()
-	if err != nil {
-		return nil, err
+	if !isPackageTransaction {
+		err = mp.transactionsPool.limitTransactionsPoolSize()
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
-	return acceptedTransactions, nil
+	return acceptedTransactions, replacedTransactions, nil
 }
@@ -6,16 +6,22 @@ import (
 
 	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
 	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	miningmanagermodel "github.com/wombatlabs/coinsecd/domain/miningmanager/model"
 )
 
-func (mp *mempool) validateTransactionPreUTXOEntry(transaction *externalapi.DomainTransaction) error {
+func (mp *mempool) validateTransactionPreUTXOEntry(transaction *externalapi.DomainTransaction,
+	rbfPolicy miningmanagermodel.RBFPolicy) error {
+
 	err := mp.validateTransactionInIsolation(transaction)
 	if err != nil {
 		return err
 	}
 
-	if err := mp.mempoolUTXOSet.checkDoubleSpends(transaction); err != nil {
-		return err
+	// Double spends are checked against the replace-by-fee rules later on, once the fee is known
+	if rbfPolicy == miningmanagermodel.RBFPolicyForbidden {
+		if err := mp.mempoolUTXOSet.checkDoubleSpends(transaction); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -44,7 +50,9 @@ func (mp *mempool) validateTransactionInIsolation(transaction *externalapi.Domai
 	return nil
 }
 
-func (mp *mempool) validateTransactionInContext(transaction *externalapi.DomainTransaction) error {
+// validateTransactionInContext validates the given transaction against its populated inputs.
+// The minimum relay fee is only checked if checkRelayFee is set
+func (mp *mempool) validateTransactionInContext(transaction *externalapi.DomainTransaction, checkRelayFee bool) error {
 	hasCoinbaseInput := false
 	for _, input := range transaction.Inputs {
 		if input.UTXOEntry.IsCoinbase() {
@@ -60,7 +68,7 @@ func (mp *mempool) validateTransactionInContext(transaction *externalapi.DomainT
 	}
 
 	if !mp.config.AcceptNonStandard {
-		err := mp.checkTransactionStandardInContext(transaction)
+		err := mp.checkTransactionStandardInContext(transaction, checkRelayFee)
 		if err != nil {
 			// Attempt to extract a reject code from the error so
 			// it can be retained. When not possible, fall back to
@@ -0,0 +1,7 @@
+package mempoolpersistence
+
+import (
+	"github.com/wombatlabs/coinsecd/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("TXMP")
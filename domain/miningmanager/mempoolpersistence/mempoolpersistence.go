@@ -0,0 +1,211 @@
+// Package mempoolpersistence reads and writes mempool transactions to a file,
+// so that the mempool survives node restarts.
+//
+// The file starts with a magic and a version, followed by the number of entries.
+// Every entry is its insertion time in unix nanoseconds, the virtual DAA score at its
+// insertion, a high-priority flag and the length-prefixed serialized transaction. All
+// integers are little-endian.
+package mempoolpersistence
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/wombatlabs/coinsecd/domain/consensus/database/serialization"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	fileMagic   uint32 = 0x504d5343 // "CSMP"
+	fileVersion uint32 = 2
+
+	// maxSerializedTransactionSize guards against allocating absurd amounts of
+	// memory when reading a corrupted file
+	maxSerializedTransactionSize = 10_000_000
+)
+
+// ErrUnsupportedVersion indicates that a mempool file was written with a version this
+// node doesn't know how to read
+var ErrUnsupportedVersion = errors.New("unsupported mempool file version")
+
+// Save writes the given entries to the file at the given path. The file is written to a
+// temporary path first and then renamed, so that a crash never leaves a partial file behind
+func Save(path string, entries []*model.MempoolTransactionEntry) error {
+	temporaryPath := path + ".new"
+	file, err := os.OpenFile(temporaryPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	isRenamed := false
+	defer func() {
+		if !isRenamed {
+			removeErr := os.Remove(temporaryPath)
+			if removeErr != nil && !os.IsNotExist(removeErr) {
+				log.Warnf("Error removing the incomplete mempool file %s: %s", temporaryPath, removeErr)
+			}
+		}
+	}()
+
+	err = write(file, entries)
+	if err != nil {
+		file.Close()
+		return err
+	}
+	err = file.Close()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	err = os.Rename(temporaryPath, path)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	isRenamed = true
+	return nil
+}
+
+func write(file *os.File, entries []*model.MempoolTransactionEntry) error {
+	writer := bufio.NewWriter(file)
+
+	err := writeUint32s(writer, fileMagic, fileVersion)
+	if err != nil {
+		return err
+	}
+	err = binary.Write(writer, binary.LittleEndian, uint64(len(entries)))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, entry := range entries {
+		serializedTransaction, err := proto.Marshal(serialization.DomainTransactionToDbTransaction(entry.Transaction))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		isHighPriority := uint8(0)
+		if entry.IsHighPriority {
+			isHighPriority = 1
+		}
+		err = binary.Write(writer, binary.LittleEndian, entry.AddedAt.UnixNano())
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		err = binary.Write(writer, binary.LittleEndian, entry.AddedAtDAAScore)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		err = binary.Write(writer, binary.LittleEndian, isHighPriority)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		err = writeUint32s(writer, uint32(len(serializedTransaction)))
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(serializedTransaction)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	err = writer.Flush()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(file.Sync())
+}
+
+// Load reads the entries from the file at the given path. It returns an error that
+// satisfies os.IsNotExist if there's no such file
+func Load(path string) ([]*model.MempoolTransactionEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+
+	var magic, version uint32
+	err = readValues(reader, &magic, &version)
+	if err != nil {
+		return nil, err
+	}
+	if magic != fileMagic {
+		return nil, errors.Errorf("%s is not a mempool file", path)
+	}
+	if version != fileVersion {
+		return nil, errors.Wrapf(ErrUnsupportedVersion, "version %d", version)
+	}
+
+	var entryCount uint64
+	err = readValues(reader, &entryCount)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*model.MempoolTransactionEntry, 0)
+	for i := uint64(0); i < entryCount; i++ {
+		var addedAtUnixNano int64
+		var addedAtDAAScore uint64
+		var isHighPriority uint8
+		var serializedTransactionSize uint32
+		err = readValues(reader, &addedAtUnixNano, &addedAtDAAScore, &isHighPriority, &serializedTransactionSize)
+		if err != nil {
+			return nil, err
+		}
+		if serializedTransactionSize > maxSerializedTransactionSize {
+			return nil, errors.Errorf("mempool file entry %d has a transaction of %d bytes, which is over "+
+				"the maximum of %d", i, serializedTransactionSize, maxSerializedTransactionSize)
+		}
+
+		serializedTransaction := make([]byte, serializedTransactionSize)
+		_, err = io.ReadFull(reader, serializedTransaction)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		dbTransaction := &serialization.DbTransaction{}
+		err = proto.Unmarshal(serializedTransaction, dbTransaction)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		transaction, err := serialization.DbTransactionToDomainTransaction(dbTransaction)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &model.MempoolTransactionEntry{
+			Transaction:     transaction,
+			IsHighPriority:  isHighPriority != 0,
+			AddedAt:         time.Unix(0, addedAtUnixNano),
+			AddedAtDAAScore: addedAtDAAScore,
+		})
+	}
+
+	return entries, nil
+}
+
+func writeUint32s(writer io.Writer, values ...uint32) error {
+	for _, value := range values {
+		err := binary.Write(writer, binary.LittleEndian, value)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+func readValues(reader io.Reader, values ...interface{}) error {
+	for _, value := range values {
+		err := binary.Read(reader, binary.LittleEndian, value)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
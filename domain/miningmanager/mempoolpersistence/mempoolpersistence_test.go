@@ -0,0 +1,122 @@
+package mempoolpersistence
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/subnetworks"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/model"
+	"github.com/pkg/errors"
+)
+
+func TestSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mempool.dat")
+
+	entries := []*model.MempoolTransactionEntry{
+		{Transaction: testTransaction(1), IsHighPriority: false, AddedAt: time.Unix(1_600_000_000, 123), AddedAtDAAScore: 1000},
+		{Transaction: testTransaction(2), IsHighPriority: true, AddedAt: time.Unix(1_700_000_000, 456), AddedAtDAAScore: 2000},
+	}
+	err := Save(path, entries)
+	if err != nil {
+		t.Fatalf("Save: %+v", err)
+	}
+	_, err = os.Stat(path + ".new")
+	if !os.IsNotExist(err) {
+		t.Fatalf("Expected the temporary file to be removed, but got %v", err)
+	}
+
+	loadedEntries, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %+v", err)
+	}
+	if len(loadedEntries) != len(entries) {
+		t.Fatalf("Expected %d entries, but got %d", len(entries), len(loadedEntries))
+	}
+	for i, entry := range entries {
+		loadedEntry := loadedEntries[i]
+		if !consensushashing.TransactionID(loadedEntry.Transaction).Equal(consensushashing.TransactionID(entry.Transaction)) {
+			t.Errorf("Entry %d: unexpected transaction", i)
+		}
+		if loadedEntry.IsHighPriority != entry.IsHighPriority {
+			t.Errorf("Entry %d: expected IsHighPriority %t, but got %t", i, entry.IsHighPriority, loadedEntry.IsHighPriority)
+		}
+		if !loadedEntry.AddedAt.Equal(entry.AddedAt) {
+			t.Errorf("Entry %d: expected AddedAt %s, but got %s", i, entry.AddedAt, loadedEntry.AddedAt)
+		}
+		if loadedEntry.AddedAtDAAScore != entry.AddedAtDAAScore {
+			t.Errorf("Entry %d: expected AddedAtDAAScore %d, but got %d", i, entry.AddedAtDAAScore, loadedEntry.AddedAtDAAScore)
+		}
+	}
+}
+
+func TestSaveFailure(t *testing.T) {
+	// The file can't be renamed over a non-empty directory
+	path := filepath.Join(t.TempDir(), "mempool.dat")
+	err := os.MkdirAll(filepath.Join(path, "occupied"), 0700)
+	if err != nil {
+		t.Fatalf("MkdirAll: %s", err)
+	}
+
+	err = Save(path, []*model.MempoolTransactionEntry{{Transaction: testTransaction(1), AddedAt: time.Now()}})
+	if err == nil {
+		t.Fatalf("Save over a directory unexpectedly succeeded")
+	}
+	_, err = os.Stat(path + ".new")
+	if !os.IsNotExist(err) {
+		t.Fatalf("Expected the temporary file of a failed save to be removed, but got %v", err)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	directory := t.TempDir()
+
+	_, err := Load(filepath.Join(directory, "missing.dat"))
+	if !os.IsNotExist(err) {
+		t.Fatalf("Expected a not-exist error, but got %v", err)
+	}
+
+	notMempoolFilePath := filepath.Join(directory, "not-mempool.dat")
+	err = os.WriteFile(notMempoolFilePath, []byte("this is not a mempool file"), 0600)
+	if err != nil {
+		t.Fatalf("WriteFile: %+v", err)
+	}
+	_, err = Load(notMempoolFilePath)
+	if err == nil {
+		t.Fatalf("Expected an error when loading a file that is not a mempool file")
+	}
+
+	futureVersionPath := filepath.Join(directory, "future-version.dat")
+	err = os.WriteFile(futureVersionPath, []byte{0x43, 0x53, 0x4d, 0x50, 0x03, 0x00, 0x00, 0x00}, 0600)
+	if err != nil {
+		t.Fatalf("WriteFile: %+v", err)
+	}
+	_, err = Load(futureVersionPath)
+	if !errors.Is(err, ErrUnsupportedVersion) {
+		t.Fatalf("Expected ErrUnsupportedVersion, but got %v", err)
+	}
+}
+
+func testTransaction(index byte) *externalapi.DomainTransaction {
+	return &externalapi.DomainTransaction{
+		Version: 0,
+		Inputs: []*externalapi.DomainTransactionInput{{
+			PreviousOutpoint: externalapi.DomainOutpoint{
+				TransactionID: *externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{index}),
+				Index:         uint32(index),
+			},
+			SignatureScript: []byte{index},
+			Sequence:        1,
+			SigOpCount:      1,
+		}},
+		Outputs: []*externalapi.DomainTransactionOutput{{
+			Value:           uint64(index) * 1000,
+			ScriptPublicKey: &externalapi.ScriptPublicKey{Script: []byte{index}, Version: 0},
+		}},
+		SubnetworkID: subnetworks.SubnetworkIDNative,
+		Payload:      []byte{},
+	}
+}
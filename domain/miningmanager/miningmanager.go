@@ -1,12 +1,19 @@
 package miningmanager
 
 import (
+	"os"
 	"sync"
 	"time"
 
 	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/transactionhelper"
 	"github.com/wombatlabs/coinsecd/domain/consensusreference"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/feeestimator"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/mempool"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/mempoolpersistence"
 	miningmanagermodel "github.com/wombatlabs/coinsecd/domain/miningmanager/model"
+	"github.com/pkg/errors"
 )
 
 // MiningManager creates block templates for mining as well as maintaining
@@ -32,16 +39,31 @@ type MiningManager interface {
 	HandleNewBlockTransactions(txs []*externalapi.DomainTransaction) ([]*externalapi.DomainTransaction, error)
 	ValidateAndInsertTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool, allowOrphan bool) (
 		acceptedTransactions []*externalapi.DomainTransaction, err error)
+	ValidateAndInsertTransactionWithReplacement(transaction *externalapi.DomainTransaction, isHighPriority bool,
+		allowOrphan bool, rbfPolicy miningmanagermodel.RBFPolicy) (
+		acceptedTransactions []*externalapi.DomainTransaction, replacedTransactions []*externalapi.DomainTransaction, err error)
+	ValidateAndInsertTransactionPackage(transactions []*externalapi.DomainTransaction, isHighPriority bool) (
+		acceptedTransactions []*externalapi.DomainTransaction, err error)
 	RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error)
+	GetFeeEstimate() *miningmanagermodel.FeeEstimate
+	GetMempoolInfo() *miningmanagermodel.MempoolInfo
+	SaveMempool(path string) (savedCount int, err error)
+	LoadMempool(path string) (loadedCount int, discardedCount int, err error)
+	SetOnMempoolChangedHandler(onMempoolChangedHandler miningmanagermodel.OnMempoolChangedHandler)
 }
 
 type miningManager struct {
 	consensusReference   consensusreference.ConsensusReference
 	mempool              miningmanagermodel.Mempool
 	blockTemplateBuilder miningmanagermodel.BlockTemplateBuilder
+	feeEstimator         *feeestimator.FeeEstimator
 	cachedBlockTemplate  *externalapi.DomainBlockTemplate
 	cachingTime          time.Time
 	cacheLock            *sync.Mutex
+
+	// transactionExpireInterval is the time after which low-priority transactions
+	// expire from the mempool
+	transactionExpireInterval time.Duration
 }
 
 // GetBlockTemplate obtains a block template for a miner to consume
@@ -105,6 +127,17 @@ func (mm *miningManager) GetBlockTemplateBuilder() miningmanagermodel.BlockTempl
 
 // HandleNewBlockTransactions handles the transactions for a new block that was just added to the DAG
 func (mm *miningManager) HandleNewBlockTransactions(txs []*externalapi.DomainTransaction) ([]*externalapi.DomainTransaction, error) {
+	// The fee of a block transaction is only known if it was in the mempool, so it has to be
+	// looked up before the mempool removes it
+	knownTransactions := make([]*externalapi.DomainTransaction, 0, len(txs))
+	for _, tx := range txs[transactionhelper.CoinbaseTransactionIndex+1:] {
+		mempoolTransaction, _, found := mm.mempool.GetTransaction(consensushashing.TransactionID(tx), true, false)
+		if found {
+			knownTransactions = append(knownTransactions, mempoolTransaction)
+		}
+	}
+	mm.feeEstimator.AddBlock(knownTransactions)
+
 	return mm.mempool.HandleNewBlockTransactions(txs)
 }
 
@@ -117,6 +150,26 @@ func (mm *miningManager) ValidateAndInsertTransaction(transaction *externalapi.D
 	return mm.mempool.ValidateAndInsertTransaction(transaction, isHighPriority, allowOrphan)
 }
 
+// ValidateAndInsertTransactionWithReplacement validates the given transaction, and adds it
+// to the set of known transactions that have not yet been added to any block. Depending on
+// rbfPolicy, the transaction may replace the mempool transactions it double spends, in which
+// case the replaced transactions are returned
+func (mm *miningManager) ValidateAndInsertTransactionWithReplacement(transaction *externalapi.DomainTransaction,
+	isHighPriority bool, allowOrphan bool, rbfPolicy miningmanagermodel.RBFPolicy) (
+	acceptedTransactions []*externalapi.DomainTransaction, replacedTransactions []*externalapi.DomainTransaction, err error) {
+
+	return mm.mempool.ValidateAndInsertTransactionWithReplacement(transaction, isHighPriority, allowOrphan, rbfPolicy)
+}
+
+// ValidateAndInsertTransactionPackage validates the given transactions, sorted parents first, and adds
+// all of them to the mempool, or none of them if any is rejected. Fee requirements apply to the package
+// as a whole, so that a child may pay for a parent that doesn't pay enough fees on its own
+func (mm *miningManager) ValidateAndInsertTransactionPackage(transactions []*externalapi.DomainTransaction,
+	isHighPriority bool) (acceptedTransactions []*externalapi.DomainTransaction, err error) {
+
+	return mm.mempool.ValidateAndInsertTransactionPackage(transactions, isHighPriority)
+}
+
 func (mm *miningManager) GetTransaction(
 	transactionID *externalapi.DomainTransactionID,
 	includeTransactionPool bool,
@@ -149,8 +202,71 @@ func (mm *miningManager) TransactionCount(includeTransactionPool bool, includeOr
 	return mm.mempool.TransactionCount(includeTransactionPool, includeOrphanPool)
 }
 
+// GetFeeEstimate returns the fee rates a transaction should pay in order to be
+// included in a block within different time frames
+func (mm *miningManager) GetFeeEstimate() *miningmanagermodel.FeeEstimate {
+	return mm.feeEstimator.Estimate(mm.mempool.BlockCandidateTransactions(), mm.mempool.MinimumFeeRate())
+}
+
+// GetMempoolInfo returns aggregate statistics about the mempool
+func (mm *miningManager) GetMempoolInfo() *miningmanagermodel.MempoolInfo {
+	return mm.mempool.MempoolInfo()
+}
+
+// SetOnMempoolChangedHandler sets the handler that's called with the transactions
+// every mempool operation adds or removes
+func (mm *miningManager) SetOnMempoolChangedHandler(onMempoolChangedHandler miningmanagermodel.OnMempoolChangedHandler) {
+	mm.mempool.SetOnChangedHandler(onMempoolChangedHandler)
+}
+
 func (mm *miningManager) RevalidateHighPriorityTransactions() (
 	validTransactions []*externalapi.DomainTransaction, err error) {
 
 	return mm.mempool.RevalidateHighPriorityTransactions()
 }
+
+// SaveMempool writes the transactions in the mempool to the file at the given path,
+// so that they can be restored with LoadMempool
+func (mm *miningManager) SaveMempool(path string) (savedCount int, err error) {
+	entries := mm.mempool.TransactionEntries()
+	err = mempoolpersistence.Save(path, entries)
+	if err != nil {
+		return 0, err
+	}
+	return len(entries), nil
+}
+
+// LoadMempool inserts the transactions saved by SaveMempool at the given path to the mempool,
+// re-validating each of them against the current virtual. Transactions that are no longer valid,
+// or that expired while the node was down, are discarded. The rest keep the time and DAA score
+// they were originally added at, so restarts don't postpone their expiry. A missing file is not an error
+func (mm *miningManager) LoadMempool(path string) (loadedCount int, discardedCount int, err error) {
+	entries, err := mempoolpersistence.Load(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, 0, nil
+		}
+		return 0, 0, err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsHighPriority && time.Since(entry.AddedAt) > mm.transactionExpireInterval {
+			discardedCount++
+			continue
+		}
+
+		// Entries are saved with parents before their children, so a transaction
+		// that turns out to be an orphan lost its parent and is discarded
+		_, err := mm.mempool.ValidateAndInsertTransactionEntry(entry)
+		if err != nil {
+			if !errors.As(err, &mempool.RuleError{}) {
+				return loadedCount, discardedCount, err
+			}
+			discardedCount++
+			continue
+		}
+		loadedCount++
+	}
+
+	return loadedCount, discardedCount, nil
+}
@@ -6,9 +6,11 @@ import (
 	"github.com/wombatlabs/coinsecd/domain/miningmanager/model"
 	"github.com/wombatlabs/coinsecd/util"
 	"github.com/wombatlabs/coinsecd/version"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/wombatlabs/coinsecd/domain/miningmanager/mempool"
 
@@ -23,6 +25,7 @@ import (
 	"github.com/wombatlabs/coinsecd/domain/consensus/utils/txscript"
 	"github.com/wombatlabs/coinsecd/domain/consensus/utils/utxo"
 	"github.com/wombatlabs/coinsecd/domain/miningmanager"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/mempoolpersistence"
 	"github.com/pkg/errors"
 )
 
@@ -233,6 +236,748 @@ func TestHandleNewBlockTransactions(t *testing.T) {
 	})
 }
 
+// TestEvictLowestFeeRateTransactions verifies that when the mempool reaches its maximum mass, the transactions
+// with the lowest fee rate are evicted in favor of higher fee rate ones, and that the minimum fee rate is
+// raised above the fee rate of the evicted transactions.
+func TestEvictLowestFeeRateTransactions(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestEvictLowestFeeRateTransactions")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		// All the transactions created below have the same mass
+		transactionMass := createTransactionWithUTXOEntryAndFee(t, 0, 0)
+		tc.PopulateMass(transactionMass)
+		mass := transactionMass.Mass
+
+		// createTransactionWithFeeRate creates a transaction paying feeRateNumerator/feeRateDenominator sompi/gram
+		createTransactionWithFeeRate := func(i int, feeRateNumerator, feeRateDenominator uint64) *externalapi.DomainTransaction {
+			return createTransactionWithUTXOEntryAndFee(t, i, mass*feeRateNumerator/feeRateDenominator)
+		}
+
+		miningFactory := miningmanager.NewFactory()
+		mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
+		mempoolConfig.MaximumMempoolMass = 3 * mass
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig)
+
+		transactionsToInsert := []*externalapi.DomainTransaction{
+			createTransactionWithFeeRate(0, 5, 1),
+			createTransactionWithFeeRate(1, 6, 1),
+			createTransactionWithFeeRate(2, 7, 1),
+		}
+		for _, transaction := range transactionsToInsert {
+			_, err = miningManager.ValidateAndInsertTransaction(transaction, false, true)
+			if err != nil {
+				t.Fatalf("ValidateAndInsertTransaction: %v", err)
+			}
+		}
+
+		expectInsufficientFee := func(transaction *externalapi.DomainTransaction) {
+			_, err := miningManager.ValidateAndInsertTransaction(transaction, false, true)
+			if err == nil {
+				t.Fatalf("Expected transaction %s to be rejected", consensushashing.TransactionID(transaction))
+			}
+			txRuleError := &mempool.TxRuleError{}
+			if !errors.As(err, txRuleError) || txRuleError.RejectCode != mempool.RejectInsufficientFee {
+				t.Fatalf("Expected RejectInsufficientFee, but got %+v", err)
+			}
+		}
+
+		// A transaction with a lower fee rate than everything in the full mempool is rejected
+		expectInsufficientFee(createTransactionWithFeeRate(3, 4, 1))
+		mempoolTransactions, _ := miningManager.AllTransactions(true, false)
+		if len(mempoolTransactions) != len(transactionsToInsert) {
+			t.Fatalf("Expected %d transactions in the mempool, but got %d", len(transactionsToInsert), len(mempoolTransactions))
+		}
+
+		// A transaction with a higher fee rate evicts the transaction with the lowest fee rate
+		highFeeRateTransaction := createTransactionWithFeeRate(4, 8, 1)
+		_, err = miningManager.ValidateAndInsertTransaction(highFeeRateTransaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		mempoolTransactions, _ = miningManager.AllTransactions(true, false)
+		if contains(transactionsToInsert[0], mempoolTransactions) {
+			t.Fatalf("Transaction %s should have been evicted", consensushashing.TransactionID(transactionsToInsert[0]))
+		}
+		for _, transaction := range append(transactionsToInsert[1:], highFeeRateTransaction) {
+			if !contains(transaction, mempoolTransactions) {
+				t.Fatalf("Missing transaction %s in the mempool", consensushashing.TransactionID(transaction))
+			}
+		}
+
+		// Make room in the mempool by mining a transaction
+		_, err = miningManager.HandleNewBlockTransactions([]*externalapi.DomainTransaction{nil, transactionsToInsert[2]})
+		if err != nil {
+			t.Fatalf("HandleNewBlockTransactions: %v", err)
+		}
+
+		// Even though the mempool isn't full, the minimum fee rate was raised above the evicted transaction's
+		expectInsufficientFee(createTransactionWithFeeRate(5, 11, 2))
+		_, err = miningManager.ValidateAndInsertTransaction(createTransactionWithFeeRate(6, 13, 2), false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+	})
+}
+
+// TestReplaceByFee verifies that a transaction can replace the mempool transactions it double spends, along with
+// their descendants, only if it pays more than all of them.
+func TestReplaceByFee(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestReplaceByFee")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig)
+
+		// All the transactions spending the same kind of UTXO have the same mass
+		transactionMass := createTransactionWithUTXOEntryAndFee(t, 0, 0)
+		tc.PopulateMass(transactionMass)
+		mass := transactionMass.Mass
+
+		// Insert a parent with a fee rate of 10 sompi/gram and a child with a fee rate of 2 sompi/gram
+		parentTransaction := createTransactionWithUTXOEntryAndFee(t, 0, 10*mass)
+		_, err = miningManager.ValidateAndInsertTransaction(parentTransaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		childTransaction, err := testutils.CreateTransaction(parentTransaction, 0)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+		tc.PopulateMass(childTransaction)
+		childTransaction.Outputs[0].Value -= 2 * childTransaction.Mass
+		_, err = miningManager.ValidateAndInsertTransaction(childTransaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+
+		expectRejection := func(transaction *externalapi.DomainTransaction, rbfPolicy model.RBFPolicy,
+			expectedRejectCode mempool.RejectCode) {
+
+			_, _, err := miningManager.ValidateAndInsertTransactionWithReplacement(transaction, false, true, rbfPolicy)
+			if err == nil {
+				t.Fatalf("Expected transaction %s to be rejected", consensushashing.TransactionID(transaction))
+			}
+			txRuleError := &mempool.TxRuleError{}
+			if !errors.As(err, txRuleError) || txRuleError.RejectCode != expectedRejectCode {
+				t.Fatalf("Expected %s, but got %+v", expectedRejectCode, err)
+			}
+		}
+
+		// Replacement is forbidden by default
+		expectRejection(createTransactionWithUTXOEntryAndFee(t, 0, 20*mass), model.RBFPolicyForbidden,
+			mempool.RejectDuplicate)
+
+		// A mandatory replacement must double spend something
+		expectRejection(createTransactionWithUTXOEntryAndFee(t, 1, 20*mass), model.RBFPolicyMandatory,
+			mempool.RejectNonstandard)
+
+		// The fee rate must be higher than the replaced parent's
+		expectRejection(createTransactionWithUTXOEntryAndFee(t, 0, 9*mass), model.RBFPolicyMandatory,
+			mempool.RejectInsufficientFee)
+
+		// The total fee must be higher than the parent and child's combined
+		expectRejection(createTransactionWithUTXOEntryAndFee(t, 0, 11*mass), model.RBFPolicyMandatory,
+			mempool.RejectInsufficientFee)
+
+		// The number of replaced transactions, including descendants, is limited
+		mempoolConfig.MaximumReplacedTransactionCount = 1
+		expectRejection(createTransactionWithUTXOEntryAndFee(t, 0, 20*mass), model.RBFPolicyMandatory,
+			mempool.RejectNonstandard)
+		mempoolConfig.MaximumReplacedTransactionCount = 2
+
+		replacementTransaction := createTransactionWithUTXOEntryAndFee(t, 0, 20*mass)
+		acceptedTransactions, replacedTransactions, err := miningManager.ValidateAndInsertTransactionWithReplacement(
+			replacementTransaction, false, true, model.RBFPolicyMandatory)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransactionWithReplacement: %v", err)
+		}
+		if len(acceptedTransactions) != 1 || !acceptedTransactions[0].Equal(replacementTransaction) {
+			t.Fatalf("Expected only the replacement transaction to be accepted, but got %v",
+				consensushashing.TransactionIDs(acceptedTransactions))
+		}
+		if len(replacedTransactions) != 2 || !contains(parentTransaction, replacedTransactions) ||
+			!contains(childTransaction, replacedTransactions) {
+			t.Fatalf("Expected the parent and child transactions to be replaced, but got %v",
+				consensushashing.TransactionIDs(replacedTransactions))
+		}
+
+		mempoolTransactions, _ := miningManager.AllTransactions(true, false)
+		if len(mempoolTransactions) != 1 || !contains(replacementTransaction, mempoolTransactions) {
+			t.Fatalf("Expected only the replacement transaction to be in the mempool, but got %v",
+				consensushashing.TransactionIDs(mempoolTransactions))
+		}
+	})
+}
+
+func TestSaveAndLoadMempool(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestSaveAndLoadMempool")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
+		// Use an expiry interval of an hour regardless of the block rate of the network
+		mempoolConfig.TransactionExpireIntervalDAAScore = uint64(time.Hour / consensusConfig.Params.TargetTimePerBlock)
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig)
+
+		// The saved transactions don't carry their UTXO entries, so they must spend real UTXOs
+		parentTransaction, childTransaction, err := createParentAndChildrenTransactions(tc)
+		if err != nil {
+			t.Fatalf("Error in createParentAndChildrenTransactions: %v", err)
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(parentTransaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(childTransaction, true, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+
+		path := filepath.Join(t.TempDir(), "mempool.dat")
+		savedCount, err := miningManager.SaveMempool(path)
+		if err != nil {
+			t.Fatalf("SaveMempool: %+v", err)
+		}
+		if savedCount != 2 {
+			t.Fatalf("Expected 2 transactions to be saved, but got %d", savedCount)
+		}
+
+		// Loading into a fresh mempool restores both transactions
+		restoredMiningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig)
+		loadedCount, discardedCount, err := restoredMiningManager.LoadMempool(path)
+		if err != nil {
+			t.Fatalf("LoadMempool: %+v", err)
+		}
+		if loadedCount != 2 || discardedCount != 0 {
+			t.Fatalf("Expected 2 loaded and 0 discarded transactions, but got %d and %d", loadedCount, discardedCount)
+		}
+		for _, transaction := range []*externalapi.DomainTransaction{parentTransaction, childTransaction} {
+			_, _, found := restoredMiningManager.GetTransaction(consensushashing.TransactionID(transaction), true, false)
+			if !found {
+				t.Fatalf("Transaction %s was not restored", consensushashing.TransactionID(transaction))
+			}
+		}
+
+		// Loading again discards the transactions that are already in the mempool
+		loadedCount, discardedCount, err = restoredMiningManager.LoadMempool(path)
+		if err != nil {
+			t.Fatalf("LoadMempool: %+v", err)
+		}
+		if loadedCount != 0 || discardedCount != 2 {
+			t.Fatalf("Expected 0 loaded and 2 discarded transactions, but got %d and %d", loadedCount, discardedCount)
+		}
+
+		// Low-priority transactions that expired while the node was down are discarded
+		transactionExpireInterval := time.Duration(mempoolConfig.TransactionExpireIntervalDAAScore) *
+			consensusConfig.Params.TargetTimePerBlock
+		expiredAt := time.Now().Add(-2 * transactionExpireInterval)
+		lowPriorityTransaction, _, err := createParentAndChildrenTransactions(tc)
+		if err != nil {
+			t.Fatalf("Error in createParentAndChildrenTransactions: %v", err)
+		}
+		highPriorityTransaction, _, err := createParentAndChildrenTransactions(tc)
+		if err != nil {
+			t.Fatalf("Error in createParentAndChildrenTransactions: %v", err)
+		}
+		err = mempoolpersistence.Save(path, []*model.MempoolTransactionEntry{
+			{Transaction: lowPriorityTransaction, IsHighPriority: false, AddedAt: expiredAt},
+			{Transaction: highPriorityTransaction, IsHighPriority: true, AddedAt: expiredAt},
+		})
+		if err != nil {
+			t.Fatalf("Save: %+v", err)
+		}
+		expiryMiningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempoolConfig)
+		loadedCount, discardedCount, err = expiryMiningManager.LoadMempool(path)
+		if err != nil {
+			t.Fatalf("LoadMempool: %+v", err)
+		}
+		if loadedCount != 1 || discardedCount != 1 {
+			t.Fatalf("Expected 1 loaded and 1 discarded transactions, but got %d and %d", loadedCount, discardedCount)
+		}
+
+		// A missing file is not an error
+		loadedCount, discardedCount, err = expiryMiningManager.LoadMempool(filepath.Join(t.TempDir(), "missing.dat"))
+		if err != nil {
+			t.Fatalf("LoadMempool: %+v", err)
+		}
+		if loadedCount != 0 || discardedCount != 0 {
+			t.Fatalf("Expected nothing to be loaded from a missing file, but got %d and %d", loadedCount, discardedCount)
+		}
+	})
+}
+
+func TestLoadedMempoolTransactionExpiry(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestLoadedMempoolTransactionExpiry")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
+		const transactionExpireIntervalDAAScore = 10
+		mempoolConfig.TransactionExpireIntervalDAAScore = transactionExpireIntervalDAAScore
+		mempoolConfig.TransactionExpireScanIntervalDAAScore = 0
+		mempoolConfig.TransactionExpireScanIntervalSeconds = 0
+		// Transactions are also discarded on load once their expiry interval passes in time, estimated
+		// from the time per block. Make sure the test runs well within that interval on any network
+		miningParams := consensusConfig.Params
+		miningParams.TargetTimePerBlock = time.Minute
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &miningParams, mempoolConfig)
+
+		// addBlocks adds the given number of blocks and returns the transactions of the last of them
+		addBlocks := func(count int) []*externalapi.DomainTransaction {
+			var blockHash *externalapi.DomainHash
+			for i := 0; i < count; i++ {
+				tips, err := tc.Tips()
+				if err != nil {
+					t.Fatalf("Tips: %+v", err)
+				}
+				blockHash, _, err = tc.AddBlock(tips, nil, nil)
+				if err != nil {
+					t.Fatalf("AddBlock: %+v", err)
+				}
+			}
+			block, _, err := tc.GetBlock(blockHash)
+			if err != nil {
+				t.Fatalf("GetBlock: %+v", err)
+			}
+			return block.Transactions
+		}
+
+		transaction, _, err := createParentAndChildrenTransactions(tc)
+		if err != nil {
+			t.Fatalf("Error in createParentAndChildrenTransactions: %v", err)
+		}
+		transactionID := consensushashing.TransactionID(transaction)
+		_, err = miningManager.ValidateAndInsertTransaction(transaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		path := filepath.Join(t.TempDir(), "mempool.dat")
+		_, err = miningManager.SaveMempool(path)
+		if err != nil {
+			t.Fatalf("SaveMempool: %+v", err)
+		}
+
+		// The node is restarted after the transaction spent most of its expiry interval in the mempool
+		addBlocks(transactionExpireIntervalDAAScore - 2)
+		restoredMiningManager := miningFactory.NewMiningManager(consensusReference, &miningParams, mempoolConfig)
+		loadedCount, _, err := restoredMiningManager.LoadMempool(path)
+		if err != nil {
+			t.Fatalf("LoadMempool: %+v", err)
+		}
+		if loadedCount != 1 {
+			t.Fatalf("Expected 1 loaded transaction, but got %d", loadedCount)
+		}
+
+		// The transaction expires once its original expiry interval passes,
+		// although less than that passed since it was loaded
+		blockTransactions := addBlocks(4)
+		_, err = restoredMiningManager.HandleNewBlockTransactions(blockTransactions)
+		if err != nil {
+			t.Fatalf("HandleNewBlockTransactions: %+v", err)
+		}
+		_, _, found := restoredMiningManager.GetTransaction(transactionID, true, false)
+		if found {
+			t.Fatalf("Expected the loaded transaction to expire on its original schedule")
+		}
+	})
+}
+
+func TestTransactionPackage(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestTransactionPackage")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		mempoolInstance := mempool.New(mempool.DefaultConfig(&consensusConfig.Params), consensusReference)
+
+		expectRejection := func(transactions []*externalapi.DomainTransaction, expectedRejectCode mempool.RejectCode) {
+			_, err := mempoolInstance.ValidateAndInsertTransactionPackage(transactions, false)
+			if err == nil {
+				t.Fatalf("Expected the package to be rejected")
+			}
+			txRuleError := &mempool.TxRuleError{}
+			if !errors.As(err, txRuleError) || txRuleError.RejectCode != expectedRejectCode {
+				t.Fatalf("Expected %s, but got %+v", expectedRejectCode, err)
+			}
+			if transactionCount := mempoolInstance.TransactionCount(true, true); transactionCount != 0 {
+				t.Fatalf("Expected a rejected package to leave the mempool empty, but it has %d transactions", transactionCount)
+			}
+		}
+
+		// createChild creates a transaction spending the given parent and paying the given fee
+		createChild := func(parent *externalapi.DomainTransaction, fee uint64) *externalapi.DomainTransaction {
+			child, err := testutils.CreateTransaction(parent, 0)
+			if err != nil {
+				t.Fatalf("CreateTransaction: %v", err)
+			}
+			tc.PopulateMass(child)
+			child.Outputs[0].Value -= fee
+			return child
+		}
+
+		// A parent that pays no fee isn't accepted on its own
+		parentTransaction := createTransactionWithUTXOEntryAndFee(t, 0, 0)
+		_, err = mempoolInstance.ValidateAndInsertTransaction(parentTransaction, false, true)
+		txRuleError := &mempool.TxRuleError{}
+		if !errors.As(err, txRuleError) || txRuleError.RejectCode != mempool.RejectInsufficientFee {
+			t.Fatalf("Expected RejectInsufficientFee, but got %+v", err)
+		}
+		parentMass := parentTransaction.Mass
+
+		// A child that pays the minimum relay fee only for itself can't pay for its parent
+		childMass := createChild(parentTransaction, 0).Mass
+		expectRejection([]*externalapi.DomainTransaction{parentTransaction, createChild(parentTransaction, childMass)},
+			mempool.RejectInsufficientFee)
+
+		// Parents must come before their children, and every transaction must be an ancestor of the last one
+		childTransaction := createChild(parentTransaction, 10*(parentMass+childMass))
+		expectRejection([]*externalapi.DomainTransaction{childTransaction, parentTransaction}, mempool.RejectInvalid)
+		expectRejection([]*externalapi.DomainTransaction{createTransactionWithUTXOEntryAndFee(t, 1, 0), parentTransaction,
+			childTransaction}, mempool.RejectNonstandard)
+
+		// A child that pays enough for both gets the package accepted
+		acceptedTransactions, err := mempoolInstance.ValidateAndInsertTransactionPackage(
+			[]*externalapi.DomainTransaction{parentTransaction, childTransaction}, false)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransactionPackage: %+v", err)
+		}
+		if len(acceptedTransactions) != 2 {
+			t.Fatalf("Expected 2 accepted transactions, but got %d", len(acceptedTransactions))
+		}
+
+		// Only the parent can be mined next, and it's valued by the fee rate of the whole package
+		blockCandidateTransactions := mempoolInstance.BlockCandidateTransactions()
+		if len(blockCandidateTransactions) != 1 {
+			t.Fatalf("Expected 1 block candidate transaction, but got %d", len(blockCandidateTransactions))
+		}
+		blockCandidateTransaction := blockCandidateTransactions[0]
+		if !consensushashing.TransactionID(blockCandidateTransaction.Transaction).Equal(consensushashing.TransactionID(parentTransaction)) {
+			t.Fatalf("Expected the block candidate to be the parent transaction")
+		}
+		expectedPackageFeeRate := float64(childTransaction.Fee) / float64(parentMass+childMass)
+		if blockCandidateTransaction.PackageFeeRate != expectedPackageFeeRate {
+			t.Fatalf("Expected a package fee rate of %f, but got %f",
+				expectedPackageFeeRate, blockCandidateTransaction.PackageFeeRate)
+		}
+
+		// Submitting the package again doesn't change anything
+		acceptedTransactions, err = mempoolInstance.ValidateAndInsertTransactionPackage(
+			[]*externalapi.DomainTransaction{parentTransaction, childTransaction}, false)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransactionPackage: %+v", err)
+		}
+		if len(acceptedTransactions) != 0 {
+			t.Fatalf("Expected no accepted transactions, but got %d", len(acceptedTransactions))
+		}
+	})
+}
+
+func TestEvictByPackageFeeRate(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestEvictByPackageFeeRate")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
+		mempoolConfig.MaximumTransactionCount = 3
+		mempoolInstance := mempool.New(mempoolConfig, consensusReference)
+
+		// withFeeRate sets the fee of the given transaction to feeRate sompi/gram
+		withFeeRate := func(transaction *externalapi.DomainTransaction, feeRate uint64) *externalapi.DomainTransaction {
+			tc.PopulateMass(transaction)
+			transaction.Outputs[0].Value -= feeRate * transaction.Mass
+			return transaction
+		}
+
+		// A parent that pays no fee, with a child that pays for both
+		parentTransaction := createTransactionWithUTXOEntryAndFee(t, 0, 0)
+		childTransaction, err := testutils.CreateTransaction(parentTransaction, 0)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+		childTransaction = withFeeRate(childTransaction, 20)
+		_, err = mempoolInstance.ValidateAndInsertTransactionPackage(
+			[]*externalapi.DomainTransaction{parentTransaction, childTransaction}, false)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransactionPackage: %+v", err)
+		}
+
+		// A transaction with a fee rate higher than the parent's own, but lower than the package's
+		lowFeeRateTransaction := withFeeRate(createTransactionWithUTXOEntryAndFee(t, 1, 0), 2)
+		_, err = mempoolInstance.ValidateAndInsertTransaction(lowFeeRateTransaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %+v", err)
+		}
+
+		// A high priority transaction is inserted although the mempool is full, and the
+		// transaction with the lowest package fee rate is evicted to make room for it
+		highPriorityTransaction := withFeeRate(createTransactionWithUTXOEntryAndFee(t, 2, 0), 1)
+		_, err = mempoolInstance.ValidateAndInsertTransaction(highPriorityTransaction, true, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %+v", err)
+		}
+
+		for _, transaction := range []*externalapi.DomainTransaction{parentTransaction, childTransaction, highPriorityTransaction} {
+			if _, _, ok := mempoolInstance.GetTransaction(consensushashing.TransactionID(transaction), true, false); !ok {
+				t.Fatalf("Expected transaction %s to stay in the mempool", consensushashing.TransactionID(transaction))
+			}
+		}
+		if _, _, ok := mempoolInstance.GetTransaction(consensushashing.TransactionID(lowFeeRateTransaction), true, false); ok {
+			t.Fatalf("Expected the transaction with the lowest package fee rate to be evicted")
+		}
+	})
+}
+
+func TestChainLimitsAndMempoolInfo(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestChainLimitsAndMempoolInfo")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+
+		// createChild creates a transaction spending the given parent and paying a generous fee
+		createChild := func(parent *externalapi.DomainTransaction) *externalapi.DomainTransaction {
+			child, err := testutils.CreateTransaction(parent, 0)
+			if err != nil {
+				t.Fatalf("CreateTransaction: %v", err)
+			}
+			tc.PopulateMass(child)
+			child.Outputs[0].Value -= 10 * child.Mass
+			return child
+		}
+
+		// insertChain inserts a chain of the given length into a new mempool with the given
+		// limits, and returns the error of the last insertion
+		insertChain := func(chainLength int, maximumAncestorCount uint64, maximumDescendantCount uint64) (
+			model.Mempool, []*externalapi.DomainTransaction, error) {
+
+			mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
+			mempoolConfig.MaximumAncestorCount = maximumAncestorCount
+			mempoolConfig.MaximumDescendantCount = maximumDescendantCount
+			mempoolInstance := mempool.New(mempoolConfig, consensusReference)
+
+			transaction := createTransactionWithUTXOEntryAndFee(t, 0, 100_000)
+			chain := []*externalapi.DomainTransaction{transaction}
+			for i := 1; i < chainLength; i++ {
+				transaction = createChild(transaction)
+				chain = append(chain, transaction)
+			}
+			for i, transaction := range chain {
+				_, err := mempoolInstance.ValidateAndInsertTransaction(transaction, false, false)
+				if err != nil {
+					if i != len(chain)-1 {
+						t.Fatalf("ValidateAndInsertTransaction: %+v", err)
+					}
+					return mempoolInstance, chain, err
+				}
+			}
+			return mempoolInstance, chain, nil
+		}
+
+		expectNonstandard := func(err error) {
+			txRuleError := &mempool.TxRuleError{}
+			if !errors.As(err, txRuleError) || txRuleError.RejectCode != mempool.RejectNonstandard {
+				t.Fatalf("Expected RejectNonstandard, but got %+v", err)
+			}
+		}
+
+		// A chain of 3 has 3 ancestors for its last transaction and 3 descendants for its first
+		_, _, err = insertChain(3, 2, 3)
+		expectNonstandard(err)
+		_, _, err = insertChain(3, 3, 2)
+		expectNonstandard(err)
+		mempoolInstance, chain, err := insertChain(3, 3, 3)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %+v", err)
+		}
+
+		mempoolInfo := mempoolInstance.MempoolInfo()
+		if mempoolInfo.TransactionCount != uint64(len(chain)) {
+			t.Fatalf("Expected %d transactions, but got %d", len(chain), mempoolInfo.TransactionCount)
+		}
+		if mempoolInfo.OrphanCount != 0 {
+			t.Fatalf("Expected no orphans, but got %d", mempoolInfo.OrphanCount)
+		}
+		expectedTotalMass := uint64(0)
+		for _, transaction := range chain {
+			expectedTotalMass += transaction.Mass
+		}
+		if mempoolInfo.TotalMass != expectedTotalMass {
+			t.Fatalf("Expected a total mass of %d, but got %d", expectedTotalMass, mempoolInfo.TotalMass)
+		}
+		histogramTransactionCount := uint64(0)
+		histogramTotalMass := uint64(0)
+		for _, bucket := range mempoolInfo.FeeRateHistogram {
+			histogramTransactionCount += bucket.TransactionCount
+			histogramTotalMass += bucket.TotalMass
+		}
+		if histogramTransactionCount != mempoolInfo.TransactionCount || histogramTotalMass != mempoolInfo.TotalMass {
+			t.Fatalf("Expected the fee rate histogram to hold %d transactions with a total mass of %d, "+
+				"but it holds %d with a total mass of %d", mempoolInfo.TransactionCount, mempoolInfo.TotalMass,
+				histogramTransactionCount, histogramTotalMass)
+		}
+	})
+}
+
+func TestMempoolChanges(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestMempoolChanges")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		mempoolInstance := mempool.New(mempool.DefaultConfig(&consensusConfig.Params), consensusReference)
+
+		var changes []*model.MempoolChange
+		mempoolInstance.SetOnChangedHandler(func(newChanges []*model.MempoolChange) error {
+			changes = append(changes, newChanges...)
+			return nil
+		})
+
+		expectChanges := func(expectedTransactions []*externalapi.DomainTransaction, expectedReasons []model.MempoolChangeReason) {
+			if len(changes) != len(expectedTransactions) {
+				t.Fatalf("Expected %d changes, but got %d", len(expectedTransactions), len(changes))
+			}
+			for i, change := range changes {
+				expectedTransactionID := consensushashing.TransactionID(expectedTransactions[i])
+				if !change.TransactionID.Equal(expectedTransactionID) || change.Reason != expectedReasons[i] {
+					t.Fatalf("Expected change %d to be %s of %s, but got %s of %s",
+						i, expectedReasons[i], expectedTransactionID, change.Reason, change.TransactionID)
+				}
+			}
+			changes = nil
+		}
+
+		transactionMass := createTransactionWithUTXOEntryAndFee(t, 0, 0)
+		tc.PopulateMass(transactionMass)
+		mass := transactionMass.Mass
+
+		parentTransaction := createTransactionWithUTXOEntryAndFee(t, 0, 10*mass)
+		_, err = mempoolInstance.ValidateAndInsertTransaction(parentTransaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		childTransaction, err := testutils.CreateTransaction(parentTransaction, 0)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+		tc.PopulateMass(childTransaction)
+		childTransaction.Outputs[0].Value -= 2 * childTransaction.Mass
+		_, err = mempoolInstance.ValidateAndInsertTransaction(childTransaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		expectChanges([]*externalapi.DomainTransaction{parentTransaction, childTransaction},
+			[]model.MempoolChangeReason{model.MempoolChangeReasonAdded, model.MempoolChangeReasonAdded})
+
+		// A replacement removes the transactions it double spends along with their descendants
+		replacementTransaction := createTransactionWithUTXOEntryAndFee(t, 0, 20*mass)
+		_, _, err = mempoolInstance.ValidateAndInsertTransactionWithReplacement(
+			replacementTransaction, false, true, model.RBFPolicyMandatory)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransactionWithReplacement: %v", err)
+		}
+		expectChanges([]*externalapi.DomainTransaction{parentTransaction, childTransaction, replacementTransaction},
+			[]model.MempoolChangeReason{model.MempoolChangeReasonReplaced, model.MempoolChangeReasonReplaced,
+				model.MempoolChangeReasonAdded})
+
+		// The transactions of a rejected package are never reported
+		unpaidTransaction := createTransactionWithUTXOEntryAndFee(t, 1, 0)
+		unpaidChildTransaction, err := testutils.CreateTransaction(unpaidTransaction, 0)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+		_, err = mempoolInstance.ValidateAndInsertTransactionPackage(
+			[]*externalapi.DomainTransaction{unpaidTransaction, unpaidChildTransaction}, false)
+		if err == nil {
+			t.Fatalf("Expected a package that pays no fees to be rejected")
+		}
+		expectChanges(nil, nil)
+
+		// A block removes the transactions it includes, and the transactions it double spends
+		doubleSpentTransaction := createTransactionWithUTXOEntryAndFee(t, 2, 10*mass)
+		_, err = mempoolInstance.ValidateAndInsertTransaction(doubleSpentTransaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		expectChanges([]*externalapi.DomainTransaction{doubleSpentTransaction},
+			[]model.MempoolChangeReason{model.MempoolChangeReasonAdded})
+
+		coinbaseTransaction := &externalapi.DomainTransaction{}
+		_, err = mempoolInstance.HandleNewBlockTransactions([]*externalapi.DomainTransaction{coinbaseTransaction,
+			replacementTransaction, createTransactionWithUTXOEntryAndFee(t, 2, 20*mass)})
+		if err != nil {
+			t.Fatalf("HandleNewBlockTransactions: %v", err)
+		}
+		expectChanges([]*externalapi.DomainTransaction{replacementTransaction, doubleSpentTransaction},
+			[]model.MempoolChangeReason{model.MempoolChangeReasonAccepted, model.MempoolChangeReasonDoubleSpent})
+	})
+}
+
 func domainBlocksToBlockIds(blocks []*externalapi.DomainTransaction) []*externalapi.DomainTransactionID {
 	blockIDs := make([]*externalapi.DomainTransactionID, len(blocks))
 	for i := range blockIDs {
@@ -951,6 +1696,16 @@ func createTransactionWithUTXOEntry(t *testing.T, i int, daaScore uint64) *exter
 	return &tx
 }
 
+// createTransactionWithUTXOEntryAndFee is like createTransactionWithUTXOEntry, but pays the given fee
+// and leaves the mass to be calculated, so that fee rates are meaningful
+func createTransactionWithUTXOEntryAndFee(t *testing.T, i int, fee uint64) *externalapi.DomainTransaction {
+	transaction := createTransactionWithUTXOEntry(t, i, 0)
+	transaction.Outputs[0].Value = transaction.Inputs[0].UTXOEntry.Amount() - fee
+	transaction.Fee = 0
+	transaction.Mass = 0
+	return transaction
+}
+
 func createArraysOfParentAndChildrenTransactions(tc testapi.TestConsensus) ([]*externalapi.DomainTransaction,
 	[]*externalapi.DomainTransaction, error) {
 
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+)
+
+// BlockCandidateTransaction is a mempool transaction that can be included in the next block
+type BlockCandidateTransaction struct {
+	Transaction *externalapi.DomainTransaction
+
+	// PackageFeeRate is the fee rate, in sompi/gram, miners get for including the transaction.
+	// It's higher than the transaction's own fee rate when a transaction that spends it pays
+	// for both, since that transaction can only be mined after this one is
+	PackageFeeRate float64
+}
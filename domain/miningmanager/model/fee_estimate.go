@@ -0,0 +1,27 @@
+package model
+
+// FeeEstimate holds the fee rates a transaction should pay in order to be
+// included in a block within different time frames
+type FeeEstimate struct {
+	// PriorityBucket is the fee rate needed for the transaction to be
+	// included in the next block
+	PriorityBucket FeeRateBucket
+
+	// NormalBucket is a fee rate that should get the transaction included
+	// within about a minute
+	NormalBucket FeeRateBucket
+
+	// LowBucket is a fee rate that should get the transaction included
+	// within about an hour
+	LowBucket FeeRateBucket
+}
+
+// FeeRateBucket is a fee rate along with the expected time it takes a
+// transaction paying it to be included in a block
+type FeeRateBucket struct {
+	// FeeRate is in sompi/gram
+	FeeRate float64
+
+	// EstimatedSeconds is the expected time until inclusion
+	EstimatedSeconds float64
+}
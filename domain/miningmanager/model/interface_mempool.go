@@ -9,9 +9,16 @@ import (
 // are intended to be mined into new blocks
 type Mempool interface {
 	HandleNewBlockTransactions(txs []*externalapi.DomainTransaction) ([]*externalapi.DomainTransaction, error)
-	BlockCandidateTransactions() []*externalapi.DomainTransaction
+	BlockCandidateTransactions() []*BlockCandidateTransaction
 	ValidateAndInsertTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool, allowOrphan bool) (
 		acceptedTransactions []*externalapi.DomainTransaction, err error)
+	ValidateAndInsertTransactionWithReplacement(transaction *externalapi.DomainTransaction, isHighPriority bool,
+		allowOrphan bool, rbfPolicy RBFPolicy) (
+		acceptedTransactions []*externalapi.DomainTransaction, replacedTransactions []*externalapi.DomainTransaction, err error)
+	ValidateAndInsertTransactionPackage(transactions []*externalapi.DomainTransaction, isHighPriority bool) (
+		acceptedTransactions []*externalapi.DomainTransaction, err error)
+	ValidateAndInsertTransactionEntry(entry *MempoolTransactionEntry) (
+		acceptedTransactions []*externalapi.DomainTransaction, err error)
 	RemoveInvalidTransactions(err *ruleerrors.ErrInvalidTransactionsInNewBlock) error
 	GetTransaction(
 		transactionID *externalapi.DomainTransactionID,
@@ -35,9 +42,13 @@ type Mempool interface {
 	) (
 		transactionPoolTransactions []*externalapi.DomainTransaction,
 		orphanPoolTransactions []*externalapi.DomainTransaction)
+	TransactionEntries() []*MempoolTransactionEntry
 	TransactionCount(
 		includeTransactionPool bool,
 		includeOrphanPool bool) int
 	RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error)
 	IsTransactionOutputDust(output *externalapi.DomainTransactionOutput) bool
+	MinimumFeeRate() float64
+	MempoolInfo() *MempoolInfo
+	SetOnChangedHandler(onChangedHandler OnMempoolChangedHandler)
 }
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+)
+
+// MempoolChangeReason describes why a transaction was added to or removed from the mempool
+type MempoolChangeReason uint8
+
+const (
+	// MempoolChangeReasonAdded means the transaction was added to the mempool
+	MempoolChangeReasonAdded MempoolChangeReason = iota
+
+	// MempoolChangeReasonAccepted means the transaction was removed because it was included in a block
+	MempoolChangeReasonAccepted
+
+	// MempoolChangeReasonDoubleSpent means the transaction was removed because a block included a
+	// transaction that double spends it or one of its ancestors
+	MempoolChangeReasonDoubleSpent
+
+	// MempoolChangeReasonReplaced means the transaction was removed because a transaction that double
+	// spends it or one of its ancestors replaced it (replace-by-fee)
+	MempoolChangeReasonReplaced
+
+	// MempoolChangeReasonExpired means the transaction was removed because it stayed in the mempool
+	// for too long
+	MempoolChangeReasonExpired
+
+	// MempoolChangeReasonEvicted means the transaction was removed to make room for transactions
+	// paying a higher fee rate
+	MempoolChangeReasonEvicted
+
+	// MempoolChangeReasonInvalidated means the transaction was removed because it's no longer valid
+	MempoolChangeReasonInvalidated
+)
+
+var mempoolChangeReasonToString = map[MempoolChangeReason]string{
+	MempoolChangeReasonAdded:       "Added",
+	MempoolChangeReasonAccepted:    "Accepted",
+	MempoolChangeReasonDoubleSpent: "DoubleSpent",
+	MempoolChangeReasonReplaced:    "Replaced",
+	MempoolChangeReasonExpired:     "Expired",
+	MempoolChangeReasonEvicted:     "Evicted",
+	MempoolChangeReasonInvalidated: "Invalidated",
+}
+
+func (reason MempoolChangeReason) String() string {
+	return mempoolChangeReasonToString[reason]
+}
+
+// MempoolChange is a transaction that was added to or removed from the mempool
+type MempoolChange struct {
+	TransactionID *externalapi.DomainTransactionID
+	Transaction   *externalapi.DomainTransaction
+	Reason        MempoolChangeReason
+}
+
+// OnMempoolChangedHandler is a handler function that's triggered with the changes
+// every mempool operation made, in the order they were made
+type OnMempoolChangedHandler func(changes []*MempoolChange) error
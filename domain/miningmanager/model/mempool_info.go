@@ -0,0 +1,26 @@
+package model
+
+// MempoolInfo holds aggregate statistics about the mempool
+type MempoolInfo struct {
+	TransactionCount uint64
+	OrphanCount      uint64
+
+	// TotalMass is the total mass of the transactions in the mempool, not including orphans
+	TotalMass uint64
+
+	// MinimumFeeRate is the fee rate, in sompi/gram, a transaction currently has to pay
+	// in order to be accepted to the mempool
+	MinimumFeeRate float64
+
+	// FeeRateHistogram splits the transactions in the mempool by their fee rate, ordered
+	// from the lowest fee rate to the highest
+	FeeRateHistogram []FeeRateHistogramBucket
+}
+
+// FeeRateHistogramBucket holds the transactions whose fee rate is at least MinimumFeeRate,
+// and lower than the MinimumFeeRate of the next bucket
+type FeeRateHistogramBucket struct {
+	MinimumFeeRate   float64
+	TransactionCount uint64
+	TotalMass        uint64
+}
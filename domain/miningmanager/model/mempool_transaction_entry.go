@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+)
+
+// MempoolTransactionEntry is a mempool transaction along with the
+// metadata needed to restore it to a mempool
+type MempoolTransactionEntry struct {
+	Transaction     *externalapi.DomainTransaction
+	IsHighPriority  bool
+	AddedAt         time.Time
+	AddedAtDAAScore uint64
+}
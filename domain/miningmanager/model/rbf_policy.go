@@ -0,0 +1,32 @@
+package model
+
+// RBFPolicy specifies whether a transaction is allowed to replace the
+// mempool transactions it double spends (replace-by-fee)
+type RBFPolicy uint8
+
+const (
+	// RBFPolicyForbidden rejects a transaction that double spends a mempool transaction
+	RBFPolicyForbidden RBFPolicy = iota
+
+	// RBFPolicyAllowed lets a transaction replace the mempool transactions it double
+	// spends, provided it pays more than them. A transaction that doesn't double spend
+	// anything is inserted as usual
+	RBFPolicyAllowed
+
+	// RBFPolicyMandatory is like RBFPolicyAllowed, but rejects a transaction that
+	// doesn't double spend any mempool transaction
+	RBFPolicyMandatory
+)
+
+func (policy RBFPolicy) String() string {
+	switch policy {
+	case RBFPolicyForbidden:
+		return "Forbidden"
+	case RBFPolicyAllowed:
+		return "Allowed"
+	case RBFPolicyMandatory:
+		return "Mandatory"
+	default:
+		return "Unknown"
+	}
+}
@@ -8,6 +8,11 @@ import (
 var activePrefixKey = database.MakeBucket(nil).Key([]byte("active-prefix"))
 var inactivePrefixKey = database.MakeBucket(nil).Key([]byte("inactive-prefix"))
 
+// ActivePrefixKey returns the database key that the active prefix is stored in
+func ActivePrefixKey() *database.Key {
+	return activePrefixKey
+}
+
 // ActivePrefix returns the current active database prefix, and whether it exists
 func ActivePrefix(dataAccessor database.DataAccessor) (*prefix.Prefix, bool, error) {
 	prefixBytes, err := dataAccessor.Get(activePrefixKey)
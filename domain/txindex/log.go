@@ -0,0 +1,7 @@
+package txindex
+
+import (
+	"github.com/wombatlabs/coinsecd/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("TXIN")
@@ -0,0 +1,23 @@
+package txindex
+
+import (
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+)
+
+// TXAcceptingBlockInfo describes the chain block that accepted a transaction
+// and the block whose copy of the transaction was the accepted one
+type TXAcceptingBlockInfo struct {
+	AcceptingBlockHash *externalapi.DomainHash
+	IncludingBlockHash *externalapi.DomainHash
+}
+
+// TXIndexEntry is everything the TX index knows about a single transaction
+type TXIndexEntry struct {
+	// AcceptingBlockInfo is nil if the transaction was not accepted
+	// by any block in the current selected parent chain
+	AcceptingBlockInfo *TXAcceptingBlockInfo
+
+	// IncludingBlockHashes are all the blocks merged by the current
+	// selected parent chain that contain the transaction
+	IncludingBlockHashes []*externalapi.DomainHash
+}
@@ -0,0 +1,28 @@
+package txindex
+
+import (
+	"github.com/wombatlabs/coinsecd/domain/consensus/database/binaryserialization"
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+)
+
+func serializeAcceptingBlockInfo(acceptingBlockInfo *TXAcceptingBlockInfo) []byte {
+	return binaryserialization.SerializeHashes([]*externalapi.DomainHash{
+		acceptingBlockInfo.AcceptingBlockHash,
+		acceptingBlockInfo.IncludingBlockHash,
+	})
+}
+
+func deserializeAcceptingBlockInfo(serializedAcceptingBlockInfo []byte) (*TXAcceptingBlockInfo, error) {
+	hashes, err := binaryserialization.DeserializeHashes(serializedAcceptingBlockInfo)
+	if err != nil {
+		return nil, err
+	}
+	if len(hashes) != 2 {
+		return nil, errors.Errorf("expected 2 hashes in a serialized accepting block info but got %d", len(hashes))
+	}
+	return &TXAcceptingBlockInfo{
+		AcceptingBlockHash: hashes[0],
+		IncludingBlockHash: hashes[1],
+	}, nil
+}
@@ -0,0 +1,37 @@
+package txindex
+
+import (
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+)
+
+func Test_serializeAcceptingBlockInfo(t *testing.T) {
+	acceptingBlockInfo := &TXAcceptingBlockInfo{
+		AcceptingBlockHash: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{1}),
+		IncludingBlockHash: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{2}),
+	}
+	result, err := deserializeAcceptingBlockInfo(serializeAcceptingBlockInfo(acceptingBlockInfo))
+	if err != nil {
+		t.Fatalf("Failed deserializing accepting block info: %v", err)
+	}
+	if !result.AcceptingBlockHash.Equal(acceptingBlockInfo.AcceptingBlockHash) {
+		t.Fatalf("Expected accepting block hash %s but got %s",
+			acceptingBlockInfo.AcceptingBlockHash, result.AcceptingBlockHash)
+	}
+	if !result.IncludingBlockHash.Equal(acceptingBlockInfo.IncludingBlockHash) {
+		t.Fatalf("Expected including block hash %s but got %s",
+			acceptingBlockInfo.IncludingBlockHash, result.IncludingBlockHash)
+	}
+}
+
+func Test_deserializeAcceptingBlockInfoFailure(t *testing.T) {
+	serialized := serializeAcceptingBlockInfo(&TXAcceptingBlockInfo{
+		AcceptingBlockHash: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{1}),
+		IncludingBlockHash: externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{2}),
+	})
+	_, err := deserializeAcceptingBlockInfo(serialized[:externalapi.DomainHashSize])
+	if err == nil {
+		t.Fatalf("Expected an error when deserializing a truncated accepting block info")
+	}
+}
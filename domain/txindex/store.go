@@ -0,0 +1,151 @@
+package txindex
+
+import (
+	"github.com/wombatlabs/coinsecd/domain/consensus/database/binaryserialization"
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+)
+
+var acceptedBucket = database.MakeBucket([]byte("tx-index-accepted"))
+var includedBucket = database.MakeBucket([]byte("tx-index-included"))
+var virtualParentsKey = database.MakeBucket([]byte("")).Key([]byte("tx-index-virtual-parents"))
+
+type txIndexStore struct {
+	database database.Database
+}
+
+func newTXIndexStore(database database.Database) *txIndexStore {
+	return &txIndexStore{
+		database: database,
+	}
+}
+
+func (tis *txIndexStore) addAccepted(dataAccessor database.DataAccessor, transactionID *externalapi.DomainTransactionID,
+	acceptingBlockInfo *TXAcceptingBlockInfo) error {
+
+	log.Tracef("Marking transaction %s as accepted by block %s", transactionID, acceptingBlockInfo.AcceptingBlockHash)
+	return dataAccessor.Put(tis.acceptedKey(transactionID), serializeAcceptingBlockInfo(acceptingBlockInfo))
+}
+
+func (tis *txIndexStore) removeAccepted(dataAccessor database.DataAccessor, transactionID *externalapi.DomainTransactionID) error {
+	log.Tracef("Unmarking transaction %s as accepted", transactionID)
+	return dataAccessor.Delete(tis.acceptedKey(transactionID))
+}
+
+func (tis *txIndexStore) addIncluded(dataAccessor database.DataAccessor, transactionID *externalapi.DomainTransactionID,
+	blockHash *externalapi.DomainHash) error {
+
+	log.Tracef("Adding block %s as including transaction %s", blockHash, transactionID)
+	return dataAccessor.Put(tis.includedKey(transactionID, blockHash), []byte{})
+}
+
+func (tis *txIndexStore) removeIncluded(dataAccessor database.DataAccessor, transactionID *externalapi.DomainTransactionID,
+	blockHash *externalapi.DomainHash) error {
+
+	log.Tracef("Removing block %s as including transaction %s", blockHash, transactionID)
+	return dataAccessor.Delete(tis.includedKey(transactionID, blockHash))
+}
+
+func (tis *txIndexStore) updateVirtualParents(dataAccessor database.DataAccessor, virtualParents []*externalapi.DomainHash) error {
+	return dataAccessor.Put(virtualParentsKey, binaryserialization.SerializeHashes(virtualParents))
+}
+
+func (tis *txIndexStore) getVirtualParents() ([]*externalapi.DomainHash, error) {
+	serializedHashes, err := tis.database.Get(virtualParentsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return binaryserialization.DeserializeHashes(serializedHashes)
+}
+
+func (tis *txIndexStore) getAcceptingBlockInfo(transactionID *externalapi.DomainTransactionID) (*TXAcceptingBlockInfo, bool, error) {
+	serializedAcceptingBlockInfo, err := tis.database.Get(tis.acceptedKey(transactionID))
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	acceptingBlockInfo, err := deserializeAcceptingBlockInfo(serializedAcceptingBlockInfo)
+	if err != nil {
+		return nil, false, err
+	}
+	return acceptingBlockInfo, true, nil
+}
+
+func (tis *txIndexStore) getIncludingBlockHashes(transactionID *externalapi.DomainTransactionID) ([]*externalapi.DomainHash, error) {
+	cursor, err := tis.database.Cursor(tis.includedBucketForTransactionID(transactionID))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	var includingBlockHashes []*externalapi.DomainHash
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return nil, err
+		}
+		blockHash, err := binaryserialization.DeserializeHash(key.Suffix())
+		if err != nil {
+			return nil, err
+		}
+		includingBlockHashes = append(includingBlockHashes, blockHash)
+	}
+	return includingBlockHashes, nil
+}
+
+func (tis *txIndexStore) deleteAll() error {
+	// First we delete the virtual parents, so if anything goes wrong, the TX index will be marked as "not synced"
+	// and will be reset.
+	err := tis.database.Delete(virtualParentsKey)
+	if err != nil {
+		return err
+	}
+
+	for _, bucket := range []*database.Bucket{acceptedBucket, includedBucket} {
+		err := tis.deleteBucket(bucket)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (tis *txIndexStore) deleteBucket(bucket *database.Bucket) error {
+	cursor, err := tis.database.Cursor(bucket)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for cursor.Next() {
+		key, err := cursor.Key()
+		if err != nil {
+			return err
+		}
+
+		err = tis.database.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (tis *txIndexStore) acceptedKey(transactionID *externalapi.DomainTransactionID) *database.Key {
+	return acceptedBucket.Key(transactionID.ByteSlice())
+}
+
+func (tis *txIndexStore) includedBucketForTransactionID(transactionID *externalapi.DomainTransactionID) *database.Bucket {
+	return includedBucket.Bucket(transactionID.ByteSlice())
+}
+
+func (tis *txIndexStore) includedKey(transactionID *externalapi.DomainTransactionID,
+	blockHash *externalapi.DomainHash) *database.Key {
+
+	return tis.includedBucketForTransactionID(transactionID).Key(blockHash.ByteSlice())
+}
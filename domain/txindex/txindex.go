@@ -0,0 +1,257 @@
+package txindex
+
+import (
+	"sync"
+
+	"github.com/wombatlabs/coinsecd/domain"
+	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/wombatlabs/coinsecd/infrastructure/logger"
+)
+
+// TXIndex maintains an index between transaction IDs
+// and the blocks that include and accept them
+type TXIndex struct {
+	domain domain.Domain
+	store  *txIndexStore
+
+	mutex sync.Mutex
+}
+
+// New creates a new TX index.
+//
+// NOTE: While this is called no new blocks can be added to the consensus.
+func New(domain domain.Domain, database database.Database) (*TXIndex, error) {
+	txIndex := &TXIndex{
+		domain: domain,
+		store:  newTXIndexStore(database),
+	}
+	isSynced, err := txIndex.isSynced()
+	if err != nil {
+		return nil, err
+	}
+
+	if !isSynced {
+		err := txIndex.Reset()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return txIndex, nil
+}
+
+// Reset deletes the whole TX index and resyncs it from consensus.
+func (ti *TXIndex) Reset() error {
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	log.Infof("Starting TX index reset")
+
+	err := ti.store.deleteAll()
+	if err != nil {
+		return err
+	}
+
+	virtualInfo, err := ti.domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return err
+	}
+
+	pruningPoint, err := ti.domain.Consensus().PruningPoint()
+	if err != nil {
+		return err
+	}
+
+	// The pruning point's acceptance data might be missing if it was
+	// imported during IBD, in which case there's nothing to add for it
+	pruningPointAcceptanceData, err := ti.domain.Consensus().GetBlockAcceptanceData(pruningPoint)
+	if err != nil && !database.IsNotFoundError(err) {
+		return err
+	}
+	if err == nil {
+		err = ti.addAcceptanceData(ti.store.database, pruningPoint, pruningPointAcceptanceData)
+		if err != nil {
+			return err
+		}
+	}
+
+	selectedParentChain, err := ti.domain.Consensus().GetVirtualSelectedParentChainFromBlock(pruningPoint)
+	if err != nil {
+		return err
+	}
+
+	const step = 1000
+	for start := 0; start < len(selectedParentChain.Added); start += step {
+		end := start + step
+		if end > len(selectedParentChain.Added) {
+			end = len(selectedParentChain.Added)
+		}
+
+		// We use chunks in order to avoid blocking consensus for too long
+		chainBlocksChunk := selectedParentChain.Added[start:end]
+		chainBlocksAcceptanceData, err := ti.domain.Consensus().GetBlocksAcceptanceData(chainBlocksChunk)
+		if err != nil {
+			return err
+		}
+
+		for i, chainBlockHash := range chainBlocksChunk {
+			err := ti.addAcceptanceData(ti.store.database, chainBlockHash, chainBlocksAcceptanceData[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// This has to be done last to mark that the reset went smoothly and no reset has to be called next time.
+	err = ti.store.updateVirtualParents(ti.store.database, virtualInfo.ParentHashes)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Finished TX index reset")
+	return nil
+}
+
+func (ti *TXIndex) isSynced() (bool, error) {
+	txIndexVirtualParents, err := ti.store.getVirtualParents()
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	virtualInfo, err := ti.domain.Consensus().GetVirtualInfo()
+	if err != nil {
+		return false, err
+	}
+
+	return externalapi.HashesEqual(virtualInfo.ParentHashes, txIndexVirtualParents), nil
+}
+
+// Update updates the TX index with the given DAG selected parent chain changes
+func (ti *TXIndex) Update(virtualChangeSet *externalapi.VirtualChangeSet) error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "TXIndex.Update")
+	defer onEnd()
+
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	dbTransaction, err := ti.store.database.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTransaction.RollbackUnlessClosed()
+
+	selectedParentChainChanges := virtualChangeSet.VirtualSelectedParentChainChanges
+	if selectedParentChainChanges != nil {
+		// Removed chain blocks have to be handled first, since blocks that were merged
+		// by them are merged again by the added chain blocks
+		removedAcceptanceData, err := ti.domain.Consensus().GetBlocksAcceptanceData(selectedParentChainChanges.Removed)
+		if err != nil {
+			return err
+		}
+		for i, removedChainBlockHash := range selectedParentChainChanges.Removed {
+			log.Tracef("Removing acceptance data of chain block %s from TX index", removedChainBlockHash)
+			err := ti.removeAcceptanceData(dbTransaction, removedAcceptanceData[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		addedAcceptanceData, err := ti.domain.Consensus().GetBlocksAcceptanceData(selectedParentChainChanges.Added)
+		if err != nil {
+			return err
+		}
+		for i, addedChainBlockHash := range selectedParentChainChanges.Added {
+			log.Tracef("Adding acceptance data of chain block %s to TX index", addedChainBlockHash)
+			err := ti.addAcceptanceData(dbTransaction, addedChainBlockHash, addedAcceptanceData[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = ti.store.updateVirtualParents(dbTransaction, virtualChangeSet.VirtualParents)
+	if err != nil {
+		return err
+	}
+
+	return dbTransaction.Commit()
+}
+
+func (ti *TXIndex) addAcceptanceData(dataAccessor database.DataAccessor,
+	chainBlockHash *externalapi.DomainHash, acceptanceData externalapi.AcceptanceData) error {
+
+	for _, blockAcceptanceData := range acceptanceData {
+		for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+			transactionID := consensushashing.TransactionID(transactionAcceptanceData.Transaction)
+			err := ti.store.addIncluded(dataAccessor, transactionID, blockAcceptanceData.BlockHash)
+			if err != nil {
+				return err
+			}
+
+			if transactionAcceptanceData.IsAccepted {
+				err := ti.store.addAccepted(dataAccessor, transactionID, &TXAcceptingBlockInfo{
+					AcceptingBlockHash: chainBlockHash,
+					IncludingBlockHash: blockAcceptanceData.BlockHash,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (ti *TXIndex) removeAcceptanceData(dataAccessor database.DataAccessor, acceptanceData externalapi.AcceptanceData) error {
+	for _, blockAcceptanceData := range acceptanceData {
+		for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+			transactionID := consensushashing.TransactionID(transactionAcceptanceData.Transaction)
+			err := ti.store.removeIncluded(dataAccessor, transactionID, blockAcceptanceData.BlockHash)
+			if err != nil {
+				return err
+			}
+
+			if transactionAcceptanceData.IsAccepted {
+				err := ti.store.removeAccepted(dataAccessor, transactionID)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// TXEntry returns everything the TX index knows about the given transaction.
+// Returns false if the transaction is not included in any block merged by
+// the current selected parent chain
+func (ti *TXIndex) TXEntry(transactionID *externalapi.DomainTransactionID) (*TXIndexEntry, bool, error) {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "TXIndex.TXEntry")
+	defer onEnd()
+
+	ti.mutex.Lock()
+	defer ti.mutex.Unlock()
+
+	includingBlockHashes, err := ti.store.getIncludingBlockHashes(transactionID)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(includingBlockHashes) == 0 {
+		return nil, false, nil
+	}
+
+	acceptingBlockInfo, _, err := ti.store.getAcceptingBlockInfo(transactionID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &TXIndexEntry{
+		AcceptingBlockInfo:   acceptingBlockInfo,
+		IncludingBlockHashes: includingBlockHashes,
+	}, true, nil
+}
@@ -99,6 +99,14 @@ func (ui *UTXOIndex) Reset() error {
 	return nil
 }
 
+// IsSynced returns whether the UTXO index is synced with the virtual UTXO set of consensus
+func (ui *UTXOIndex) IsSynced() (bool, error) {
+	ui.mutex.Lock()
+	defer ui.mutex.Unlock()
+
+	return ui.isSynced()
+}
+
 func (ui *UTXOIndex) isSynced() (bool, error) {
 	utxoIndexVirtualParents, err := ui.store.getVirtualParents()
 	if err != nil {
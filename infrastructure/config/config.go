@@ -18,6 +18,7 @@ import (
 
 	"github.com/btcsuite/go-socks/socks"
 	"github.com/jessevdk/go-flags"
+	"github.com/wombatlabs/coinsecd/app/appmessage"
 	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
 	"github.com/wombatlabs/coinsecd/domain/dagconfig"
 	"github.com/wombatlabs/coinsecd/infrastructure/logger"
@@ -48,12 +49,29 @@ const (
 	blockMaxMassMax              = 10_000_000
 	defaultMinRelayTxFee         = 1e-6 // 1 sompi per byte
 	defaultMaxOrphanTransactions = 100
+	defaultMaxMempoolMass        = 2_000_000_000
+	defaultMaxMempoolAncestors   = 25
+	defaultMaxMempoolDescendants = 25
+	defaultMaxMempoolChainMass   = 1_000_000
 	//DefaultMaxOrphanTxSize is the default maximum size for an orphan transaction
 	DefaultMaxOrphanTxSize  = 100_000
 	defaultSigCacheMaxSize  = 100_000
 	sampleConfigFilename    = "sample-coinsecd.conf"
 	defaultMaxUTXOCacheSize = 5_000_000_000
 	defaultProtocolVersion  = 5
+
+	defaultRPCNotificationReplaySize = 300
+	defaultRPCRateBurst              = 100
+	defaultHealthMinPeers            = 1
+
+	// DBTypeLevelDB is the database type that stores the node's data in LevelDB on disk
+	DBTypeLevelDB = "leveldb"
+	// DBTypeMemory is the database type that keeps the node's data in memory only
+	DBTypeMemory = "memory"
+
+	// ExportUTXOSnapshotCommand is the command that exports a UTXO snapshot of the
+	// node's database instead of starting the node
+	ExportUTXOSnapshotCommand = "export-utxo-snapshot"
 )
 
 var (
@@ -89,16 +107,28 @@ type Flags struct {
 	MaxInboundPeers                 int           `long:"maxinpeers" description:"Max number of inbound peers"`
 	EnableBanning                   bool          `long:"enablebanning" description:"Enable banning of misbehaving peers"`
 	BanDuration                     time.Duration `long:"banduration" description:"How long to ban misbehaving peers. Valid time units are {s, m, h}. Minimum 1 second"`
-	BanThreshold                    uint32        `long:"banthreshold" description:"Maximum allowed ban score before disconnecting and banning misbehaving peers."`
+	BanThreshold                    uint32        `long:"banthreshold" description:"Misbehavior score at which misbehaving peers are banned. Scores decay by half every 10 minutes"`
 	Whitelists                      []string      `long:"whitelist" description:"Add an IP network or IP that will not be banned. (eg. 192.168.1.0/24 or ::1)"`
 	RPCListeners                    []string      `long:"rpclisten" description:"Add an interface/port to listen for RPC connections (default port: 16110, testnet: 16210)"`
+	RPCWebsocketListeners           []string      `long:"rpcwslisten" description:"Add an interface/port to listen for RPC connections over WebSocket, with messages encoded in JSON (disabled by default)"`
+	RPCWebsocketAllowedOrigins      []string      `long:"rpcwsallowedorigin" description:"Allow RPC WebSocket connections from web pages of the specified origin (eg. https://explorer.example.com). Connections from web pages of any other origin than the node's own are rejected"`
+	JSONRPCListeners                []string      `long:"jsonrpclisten" description:"Add an interface/port to listen for JSON-RPC 2.0 requests over HTTP (disabled by default)"`
+	JSONRPCAllowedOrigins           []string      `long:"jsonrpcallowedorigin" description:"Allow JSON-RPC requests from web pages of the specified origin (eg. https://explorer.example.com). Requests from any other web page are rejected"`
 	RPCCert                         string        `long:"rpccert" description:"File containing the certificate file"`
 	RPCKey                          string        `long:"rpckey" description:"File containing the certificate key"`
+	RPCTLS                          bool          `long:"rpctls" description:"Serve RPC over TLS using --rpccert and --rpckey (a self-signed certificate pair is generated if neither file exists)"`
+	RPCClientCA                     string        `long:"rpcclientca" description:"File containing the CA certificates that RPC client certificates must be signed by (enables mutual TLS, requires --rpctls)"`
 	RPCMaxClients                   int           `long:"rpcmaxclients" description:"Max number of RPC clients for standard connections"`
 	RPCMaxWebsockets                int           `long:"rpcmaxwebsockets" description:"Max number of RPC websocket connections"`
 	RPCMaxConcurrentReqs            int           `long:"rpcmaxconcurrentreqs" description:"Max number of concurrent RPC requests that may be processed concurrently"`
+	RPCNotificationReplaySize       int           `long:"rpcnotificationreplaysize" description:"Number of the latest UtxosChanged and VirtualSelectedParentChainChanged notifications to keep for RPC clients that reconnect and resume (0 to disable)"`
+	RPCRateLimit                    float64       `long:"rpcratelimit" description:"Request weight per second that every RPC client, identified by its token or else by its IP, regains (0 to disable rate limiting)"`
+	RPCRateBurst                    float64       `long:"rpcrateburst" description:"Max request weight that an RPC client may accumulate and use in a burst"`
+	RPCRateLimitWeights             []string      `long:"rpcratelimitweight" description:"Override the weight of an RPC method in the form <method>:<weight> (eg. GetBlocks:50)"`
 	DisableRPC                      bool          `long:"norpc" description:"Disable built-in RPC server"`
 	SafeRPC                         bool          `long:"saferpc" description:"Disable RPC commands which affect the state of the node"`
+	RPCAuthTokens                   string        `long:"rpcauthtokens" description:"JSON file of the tokens that RPC clients may authenticate with, and of their roles (enables RPC authentication)"`
+	RPCAuthHMACKey                  string        `long:"rpcauthhmackey" description:"File containing the key that HMAC-signed RPC tokens are verified with (enables RPC authentication)"`
 	DisableDNSSeed                  bool          `long:"nodnsseed" description:"Disable DNS seeding for peers"`
 	DNSSeed                         string        `long:"dnsseed" description:"Override DNS seeds with specified hostname (Only 1 hostname allowed)"`
 	GRPCSeed                        string        `long:"grpcseed" description:"Hostname of gRPC server for seeding peers"`
@@ -106,12 +136,21 @@ type Flags struct {
 	Proxy                           string        `long:"proxy" description:"Connect via SOCKS5 proxy (eg. 127.0.0.1:9050)"`
 	ProxyUser                       string        `long:"proxyuser" description:"Username for proxy server"`
 	ProxyPass                       string        `long:"proxypass" default-mask:"-" description:"Password for proxy server"`
-	DbType                          string        `long:"dbtype" description:"Database backend to use for the Block DAG"`
+	DBType                          string        `long:"dbtype" description:"Database backend to use {leveldb, memory} -- memory keeps all data in RAM and loses it on shutdown"`
 	Profile                         string        `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
+	MetricsListeners                []string      `long:"metricslisten" description:"Add an interface/port to serve Prometheus metrics on at /metrics (disabled by default)"`
+	HealthListeners                 []string      `long:"healthlisten" description:"Add an interface/port to serve the /healthz and /readyz health checks on (disabled by default)"`
+	HealthMinPeers                  int           `long:"healthminpeers" description:"Minimum number of connected peers for /readyz to report the node as ready"`
 	LogLevel                        string        `short:"d" long:"loglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
 	Upnp                            bool          `long:"upnp" description:"Use UPnP to map our listening port outside of NAT"`
 	MinRelayTxFee                   float64       `long:"minrelaytxfee" description:"The minimum transaction fee in SEC/kB to be considered a non-zero fee."`
 	MaxOrphanTxs                    uint64        `long:"maxorphantx" description:"Max number of orphan transactions to keep in memory"`
+	MaxMempoolMass                  uint64        `long:"maxmempoolmass" description:"Max total mass of the transactions to keep in the mempool. When it's reached, the transactions with the lowest fee rate are evicted"`
+	PersistMempool                  bool          `long:"persistmempool" description:"Save the mempool to disk on shutdown and restore it on startup"`
+	MaxMempoolAncestors             uint64        `long:"maxmempoolancestors" description:"Max number of ancestors in the mempool, including itself, a mempool transaction may have"`
+	MaxMempoolAncestorMass          uint64        `long:"maxmempoolancestormass" description:"Max total mass of a mempool transaction and its ancestors in the mempool"`
+	MaxMempoolDescendants           uint64        `long:"maxmempooldescendants" description:"Max number of descendants in the mempool, including itself, a mempool transaction may have"`
+	MaxMempoolDescendantMass        uint64        `long:"maxmempooldescendantmass" description:"Max total mass of a mempool transaction and its descendants in the mempool"`
 	BlockMaxMass                    uint64        `long:"blockmaxmass" description:"Maximum transaction mass to be used when creating a block"`
 	UserAgentComments               []string      `long:"uacomment" description:"Comment to add to the user agent -- See BIP 14 for more information."`
 	NoPeerBloomFilters              bool          `long:"nopeerbloomfilters" description:"Disable bloom filtering support"`
@@ -120,8 +159,13 @@ type Flags struct {
 	RelayNonStd                     bool          `long:"relaynonstd" description:"Relay non-standard transactions regardless of the default settings for the active network."`
 	RejectNonStd                    bool          `long:"rejectnonstd" description:"Reject non-standard transactions regardless of the default settings for the active network."`
 	ResetDatabase                   bool          `long:"reset-db" description:"Reset database before starting node. It's needed when switching between subnetworks."`
+	DryRunMigration                 bool          `long:"dry-run-migration" description:"Run the pending database migrations without writing their changes, and exit"`
+	RestoreDatabase                 string        `long:"restore-db" description:"Restore the database from a backup written by the BackupDatabase RPC command before starting the node. The node's database must not exist, unless --reset-db is given"`
+	ImportSnapshot                  string        `long:"import-snapshot" description:"Bootstrap the node from a UTXO snapshot written by the export-utxo-snapshot command, instead of downloading the pruning point state from peers"`
 	MaxUTXOCacheSize                uint64        `long:"maxutxocachesize" description:"Max size of loaded UTXO into ram from the disk in bytes"`
 	UTXOIndex                       bool          `long:"utxoindex" description:"Enable the UTXO index"`
+	TXIndex                         bool          `long:"txindex" description:"Enable the TX index, which allows looking up accepted transactions by their ID"`
+	AddressHistoryIndex             bool          `long:"addresshistoryindex" description:"Enable the address history index, which allows looking up the accepted transactions of an address"`
 	IsArchivalNode                  bool          `long:"archival" description:"Run as an archival node: don't delete old block data when moving the pruning point (Warning: heavy disk usage)'"`
 	AllowSubmitBlockWhenNotSynced   bool          `long:"allow-submit-block-when-not-synced" hidden:"true" description:"Allow the node to accept blocks from RPC while not synced (this flag is mainly used for testing)"`
 	EnableSanityCheckPruningUTXOSet bool          `long:"enable-sanity-check-pruning-utxo" hidden:"true" description:"When moving the pruning point - check that the utxo set matches the utxo commitment"`
@@ -141,6 +185,13 @@ type Config struct {
 	MinRelayTxFee util.Amount
 	Whitelists    []*net.IPNet
 	SubnetworkID  *externalapi.DomainSubnetworkID // nil in full nodes
+
+	// RPCRateLimitWeightOverrides are the parsed RPCRateLimitWeights
+	RPCRateLimitWeightOverrides map[appmessage.MessageCommand]float64
+
+	// ExportUTXOSnapshotPath is the file given to the export-utxo-snapshot command.
+	// If it's set, the node exports a UTXO snapshot to it and exits instead of starting
+	ExportUTXOSnapshotPath string
 }
 
 // ServiceOptions defines the configuration options for the daemon as a service on
@@ -166,6 +217,7 @@ func cleanAndExpandPath(path string) string {
 // newConfigParser returns a new command line flags parser.
 func newConfigParser(cfgFlags *Flags, options flags.Options) *flags.Parser {
 	parser := flags.NewParser(cfgFlags, options)
+	parser.Usage = "[OPTIONS] [" + ExportUTXOSnapshotCommand + " <file>]"
 	if runtime.GOOS == "windows" {
 		parser.AddGroup("Service Options", "Service Options", cfgFlags.ServiceOptions)
 	}
@@ -188,11 +240,22 @@ func defaultFlags() *Flags {
 		RPCCert:              defaultRPCCertFile,
 		BlockMaxMass:         defaultBlockMaxMass,
 		MaxOrphanTxs:         defaultMaxOrphanTransactions,
+		MaxMempoolMass:       defaultMaxMempoolMass,
 		SigCacheMaxSize:      defaultSigCacheMaxSize,
 		MinRelayTxFee:        defaultMinRelayTxFee,
 		MaxUTXOCacheSize:     defaultMaxUTXOCacheSize,
 		ServiceOptions:       &ServiceOptions{},
 		ProtocolVersion:      defaultProtocolVersion,
+
+		MaxMempoolAncestors:      defaultMaxMempoolAncestors,
+		MaxMempoolAncestorMass:   defaultMaxMempoolChainMass,
+		MaxMempoolDescendants:    defaultMaxMempoolDescendants,
+		MaxMempoolDescendantMass: defaultMaxMempoolChainMass,
+
+		RPCNotificationReplaySize: defaultRPCNotificationReplaySize,
+		RPCRateBurst:              defaultRPCRateBurst,
+		HealthMinPeers:            defaultHealthMinPeers,
+		DBType:                    DBTypeLevelDB,
 	}
 }
 
@@ -266,7 +329,7 @@ func LoadConfig() (*Config, error) {
 	}
 
 	// Parse command line options again to ensure they take precedence.
-	_, err = parser.Parse()
+	commandArgs, err := parser.Parse()
 	if err != nil {
 		var flagsErr *flags.Error
 		if ok := errors.As(err, &flagsErr); !ok || flagsErr.Type != flags.ErrHelp {
@@ -373,6 +436,15 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	// A zero ban threshold would ban peers on any misbehavior, however slight.
+	if cfg.BanThreshold == 0 {
+		str := "%s: The banthreshold option may not be 0"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
 	// Validate any given whitelisted IP addresses and networks.
 	if len(cfg.Whitelists) > 0 {
 		var ip net.IP
@@ -458,6 +530,158 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	if cfg.RPCNotificationReplaySize < 0 {
+		str := "%s: The rpcnotificationreplaysize option may " +
+			"not be less than 0 -- parsed [%d]"
+		err := errors.Errorf(str, funcName, cfg.RPCNotificationReplaySize)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	if cfg.RPCRateLimit < 0 || cfg.RPCRateBurst <= 0 {
+		str := "%s: The rpcratelimit option may not be less than 0, and the rpcrateburst " +
+			"option must be greater than 0 -- parsed [%f] and [%f]"
+		err := errors.Errorf(str, funcName, cfg.RPCRateLimit, cfg.RPCRateBurst)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+	cfg.RPCRateLimitWeightOverrides, err = parseRPCRateLimitWeights(cfg.RPCRateLimitWeights)
+	if err != nil {
+		err := errors.Errorf("%s: %s", funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// The JSON-RPC gateway and the WebSocket listeners serve the same handlers as
+	// the RPC server, and have no default port of their own
+	additionalRPCListeners := map[string][]string{
+		"jsonrpclisten": cfg.JSONRPCListeners,
+		"rpcwslisten":   cfg.RPCWebsocketListeners,
+	}
+	for option, listeners := range additionalRPCListeners {
+		if cfg.DisableRPC && len(listeners) > 0 {
+			str := "%s: --%s can not be used together with --norpc"
+			err := errors.Errorf(str, funcName, option)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+		for _, listener := range listeners {
+			_, _, err := net.SplitHostPort(listener)
+			if err != nil {
+				str := "%s: The %s option requires a port -- parsed [%s]"
+				err := errors.Errorf(str, funcName, option, listener)
+				fmt.Fprintln(os.Stderr, err)
+				fmt.Fprintln(os.Stderr, usageMessage)
+				return nil, err
+			}
+		}
+	}
+
+	monitoringListeners := map[string][]string{
+		"metricslisten": cfg.MetricsListeners,
+		"healthlisten":  cfg.HealthListeners,
+	}
+	for option, listeners := range monitoringListeners {
+		for _, listener := range listeners {
+			_, _, err := net.SplitHostPort(listener)
+			if err != nil {
+				str := "%s: The %s option requires a port -- parsed [%s]"
+				err := errors.Errorf(str, funcName, option, listener)
+				fmt.Fprintln(os.Stderr, err)
+				fmt.Fprintln(os.Stderr, usageMessage)
+				return nil, err
+			}
+		}
+	}
+
+	if cfg.HealthMinPeers < 0 {
+		str := "%s: The healthminpeers option may not be negative -- parsed [%d]"
+		err := errors.Errorf(str, funcName, cfg.HealthMinPeers)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// A dry run of the migration needs the database it would migrate
+	if cfg.DryRunMigration && cfg.ResetDatabase {
+		str := "%s: --dry-run-migration can not be used together with --reset-db"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	if cfg.DBType != DBTypeLevelDB && cfg.DBType != DBTypeMemory {
+		str := "%s: The dbtype option must be one of %s or %s -- parsed [%s]"
+		err := errors.Errorf(str, funcName, DBTypeLevelDB, DBTypeMemory, cfg.DBType)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// An in-memory database starts empty, so there's nothing to migrate
+	if cfg.DryRunMigration && cfg.DBType == DBTypeMemory {
+		str := "%s: --dry-run-migration can not be used together with --dbtype=%s"
+		err := errors.Errorf(str, funcName, DBTypeMemory)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	if cfg.RestoreDatabase != "" {
+		cfg.RestoreDatabase = cleanAndExpandPath(cfg.RestoreDatabase)
+		if cfg.DryRunMigration || cfg.DBType == DBTypeMemory {
+			str := "%s: --restore-db can not be used together with --dry-run-migration or --dbtype=%s"
+			err := errors.Errorf(str, funcName, DBTypeMemory)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+	}
+
+	if cfg.ImportSnapshot != "" {
+		cfg.ImportSnapshot = cleanAndExpandPath(cfg.ImportSnapshot)
+		if cfg.DryRunMigration || cfg.RestoreDatabase != "" {
+			str := "%s: --import-snapshot can not be used together with --dry-run-migration or --restore-db"
+			err := errors.Errorf(str, funcName)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+	}
+
+	err = parseCommand(cfg, commandArgs)
+	if err != nil {
+		err := errors.Errorf("%s: %s", funcName, err.Error())
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// Mutual TLS is meaningless without TLS
+	if cfg.RPCClientCA != "" && !cfg.RPCTLS {
+		str := "%s: the rpcclientca option requires rpctls"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+	cfg.RPCCert = cleanAndExpandPath(cfg.RPCCert)
+	cfg.RPCKey = cleanAndExpandPath(cfg.RPCKey)
+	if cfg.RPCClientCA != "" {
+		cfg.RPCClientCA = cleanAndExpandPath(cfg.RPCClientCA)
+	}
+	if cfg.RPCAuthTokens != "" {
+		cfg.RPCAuthTokens = cleanAndExpandPath(cfg.RPCAuthTokens)
+	}
+	if cfg.RPCAuthHMACKey != "" {
+		cfg.RPCAuthHMACKey = cleanAndExpandPath(cfg.RPCAuthHMACKey)
+	}
+
 	// Validate the the minrelaytxfee.
 	cfg.MinRelayTxFee, err = util.NewAmount(cfg.Flags.MinRelayTxFee)
 	if err != nil {
@@ -477,6 +701,27 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	// Disallow a mempool that can't hold any transaction.
+	if cfg.MaxMempoolMass == 0 {
+		str := "%s: The maxmempoolmass option must be greater than 0"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// Disallow chain limits that don't allow any transaction into the mempool.
+	if cfg.MaxMempoolAncestors == 0 || cfg.MaxMempoolAncestorMass == 0 ||
+		cfg.MaxMempoolDescendants == 0 || cfg.MaxMempoolDescendantMass == 0 {
+
+		str := "%s: The maxmempoolancestors, maxmempoolancestormass, maxmempooldescendants and " +
+			"maxmempooldescendantmass options must be greater than 0"
+		err := errors.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
 	// Limit the max block mass to a sane value.
 	if cfg.BlockMaxMass < blockMaxMassMin || cfg.BlockMaxMass >
 		blockMaxMassMax {
@@ -575,6 +820,32 @@ func LoadConfig() (*Config, error) {
 	return cfg, nil
 }
 
+// parseCommand parses the arguments that are left on the command line after its options.
+// These are either nothing, which starts the node, or the export-utxo-snapshot command
+// with the file to export to
+func parseCommand(cfg *Config, args []string) error {
+	if len(args) == 0 {
+		return nil
+	}
+	if args[0] != ExportUTXOSnapshotCommand {
+		return errors.Errorf("unknown command %s -- the only supported command is %s",
+			args[0], ExportUTXOSnapshotCommand)
+	}
+	if len(args) != 2 {
+		return errors.Errorf("the %s command expects exactly one argument, which is the file to export to",
+			ExportUTXOSnapshotCommand)
+	}
+
+	// The snapshot is of the node's database, so there must be one
+	if cfg.DBType == DBTypeMemory || cfg.ResetDatabase || cfg.DryRunMigration || cfg.ImportSnapshot != "" {
+		return errors.Errorf("the %s command can not be used together with --dbtype=%s, --reset-db, "+
+			"--dry-run-migration or --import-snapshot", ExportUTXOSnapshotCommand, DBTypeMemory)
+	}
+
+	cfg.ExportUTXOSnapshotPath = cleanAndExpandPath(args[1])
+	return nil
+}
+
 // createDefaultConfig copies the file sample-coinsecd.conf to the given destination path,
 // and populates it with some randomly generated RPC username and password.
 func createDefaultConfigFile(destinationPath string) error {
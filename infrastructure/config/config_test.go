@@ -7,6 +7,7 @@ import (
 	"runtime"
 	"testing"
 
+	"github.com/jessevdk/go-flags"
 	"github.com/wombatlabs/coinsecd/domain/consensus/utils/subnetworks"
 
 	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
@@ -70,3 +71,49 @@ func TestConstants(t *testing.T) {
 		t.Errorf("subnetworks.SubnetworkIDRegistry value was changed from 2, therefore you probably need to update the help text for SubnetworkID")
 	}
 }
+
+// TestParseFlags makes sure that the flags are parsable, which they aren't if, for
+// example, two of them share the same name
+func TestParseFlags(t *testing.T) {
+	cfgFlags := defaultFlags()
+	_, err := newConfigParser(cfgFlags, flags.Default).ParseArgs([]string{"--dbtype=memory"})
+	if err != nil {
+		t.Fatalf("Failed parsing the flags: %v", err)
+	}
+	if cfgFlags.DBType != DBTypeMemory {
+		t.Fatalf("Expected dbtype to be parsed as %s, but got %s", DBTypeMemory, cfgFlags.DBType)
+	}
+}
+
+func TestParseCommand(t *testing.T) {
+	cfg := &Config{Flags: defaultFlags()}
+	args, err := newConfigParser(cfg.Flags, flags.Default).ParseArgs(
+		[]string{ExportUTXOSnapshotCommand, "--dbtype=leveldb", "snapshot.dat"})
+	if err != nil {
+		t.Fatalf("Failed parsing the flags: %v", err)
+	}
+	err = parseCommand(cfg, args)
+	if err != nil {
+		t.Fatalf("parseCommand: %v", err)
+	}
+	if cfg.ExportUTXOSnapshotPath != "snapshot.dat" {
+		t.Fatalf("Expected the snapshot path to be snapshot.dat, but got %s", cfg.ExportUTXOSnapshotPath)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		cfg  *Config
+	}{
+		{name: "unknown command", args: []string{"export-everything", "snapshot.dat"}, cfg: &Config{Flags: defaultFlags()}},
+		{name: "missing file", args: []string{ExportUTXOSnapshotCommand}, cfg: &Config{Flags: defaultFlags()}},
+		{name: "in-memory database", args: []string{ExportUTXOSnapshotCommand, "snapshot.dat"},
+			cfg: &Config{Flags: &Flags{DBType: DBTypeMemory}}},
+	}
+	for _, test := range tests {
+		err := parseCommand(test.cfg, test.args)
+		if err == nil {
+			t.Errorf("%s: Expected an error", test.name)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package config
+
+import (
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// RPCClientAuthFlags holds the configuration of programs that authenticate to coinsecd's RPC server with a token
+type RPCClientAuthFlags struct {
+	RPCToken     string `long:"rpctoken" description:"Token to authenticate to the RPC server with, for servers that require RPC authentication"`
+	RPCTokenFile string `long:"rpctokenfile" description:"File containing the token to authenticate to the RPC server with. Unlike --rpctoken, it keeps the token out of the process list"`
+}
+
+// RPCAuthToken returns the token described by the flags.
+// It returns an empty string if no token was given
+func (authFlags *RPCClientAuthFlags) RPCAuthToken() (string, error) {
+	if authFlags.RPCTokenFile == "" {
+		return authFlags.RPCToken, nil
+	}
+	if authFlags.RPCToken != "" {
+		return "", errors.New("--rpctoken and --rpctokenfile can not be used together")
+	}
+
+	token, err := os.ReadFile(cleanAndExpandPath(authFlags.RPCTokenFile))
+	if err != nil {
+		return "", errors.Wrapf(err, "error reading --rpctokenfile")
+	}
+	return strings.TrimSpace(string(token)), nil
+}
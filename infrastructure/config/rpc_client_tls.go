@@ -0,0 +1,60 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// RPCClientTLSFlags holds the configuration of programs that connect to coinsecd's RPC server over TLS
+type RPCClientTLSFlags struct {
+	RPCTLS           bool   `long:"rpctls" description:"Connect to the RPC server over TLS"`
+	RPCCACert        string `long:"rpccacert" description:"File containing the certificate that the RPC server's certificate is verified against (e.g. coinsecd's self-signed rpc.cert). Defaults to the system's certificate pool"`
+	RPCClientCert    string `long:"rpcclientcert" description:"File containing a client certificate to present to RPC servers that require mutual TLS"`
+	RPCClientKey     string `long:"rpcclientkey" description:"File containing the key of --rpcclientcert"`
+	RPCTLSSkipVerify bool   `long:"rpctlsskipverify" description:"Do not verify the RPC server's certificate. This is insecure and should only be used for testing"`
+}
+
+// RPCTLSConfig builds the TLS configuration of the RPC client described by the flags.
+// It returns nil if TLS is disabled
+func (tlsFlags *RPCClientTLSFlags) RPCTLSConfig() (*tls.Config, error) {
+	if !tlsFlags.RPCTLS {
+		if tlsFlags.RPCCACert != "" || tlsFlags.RPCClientCert != "" || tlsFlags.RPCClientKey != "" || tlsFlags.RPCTLSSkipVerify {
+			return nil, errors.New("RPC TLS options require --rpctls")
+		}
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: tlsFlags.RPCTLSSkipVerify,
+	}
+
+	if tlsFlags.RPCCACert != "" {
+		pemCerts, err := os.ReadFile(cleanAndExpandPath(tlsFlags.RPCCACert))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading --rpccacert")
+		}
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(pemCerts) {
+			return nil, errors.Errorf("no valid PEM certificates found in %s", tlsFlags.RPCCACert)
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if (tlsFlags.RPCClientCert == "") != (tlsFlags.RPCClientKey == "") {
+		return nil, errors.New("--rpcclientcert and --rpcclientkey must be provided together")
+	}
+	if tlsFlags.RPCClientCert != "" {
+		clientCert, err := tls.LoadX509KeyPair(cleanAndExpandPath(tlsFlags.RPCClientCert),
+			cleanAndExpandPath(tlsFlags.RPCClientKey))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error loading the RPC client certificate pair")
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return tlsConfig, nil
+}
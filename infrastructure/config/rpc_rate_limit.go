@@ -0,0 +1,42 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+// parseRPCRateLimitWeights parses weights in the form <method>:<weight>, where method is
+// the name of an RPC request without its "Request" suffix, matched case-insensitively
+func parseRPCRateLimitWeights(weights []string) (map[appmessage.MessageCommand]float64, error) {
+	overrides := make(map[appmessage.MessageCommand]float64, len(weights))
+	for _, weight := range weights {
+		parts := strings.Split(weight, ":")
+		if len(parts) != 2 {
+			return nil, errors.Errorf("the rpcratelimitweight option must be in the form "+
+				"<method>:<weight> -- parsed [%s]", weight)
+		}
+		command, ok := rpcRequestCommand(parts[0])
+		if !ok {
+			return nil, errors.Errorf("the rpcratelimitweight option has an unknown method -- parsed [%s]", weight)
+		}
+		value, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil || value < 0 {
+			return nil, errors.Errorf("the rpcratelimitweight option must have a non-negative weight "+
+				"-- parsed [%s]", weight)
+		}
+		overrides[command] = value
+	}
+	return overrides, nil
+}
+
+func rpcRequestCommand(method string) (appmessage.MessageCommand, bool) {
+	for command, name := range appmessage.RPCMessageCommandToString {
+		if strings.HasSuffix(name, "Request") && strings.EqualFold(strings.TrimSuffix(name, "Request"), method) {
+			return command, true
+		}
+	}
+	return 0, false
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
 	"github.com/wombatlabs/coinsecd/infrastructure/db/database/ldb"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database/memdb"
 )
 
 type databasePrepareFunc func(t *testing.T, testName string) (db database.Database, name string, teardownFunc func())
@@ -16,6 +17,7 @@ type databasePrepareFunc func(t *testing.T, testName string) (db database.Databa
 // See testForAllDatabaseTypes for further details.
 var databasePrepareFuncs = []databasePrepareFunc{
 	prepareLDBForTest,
+	prepareMemoryDBForTest,
 }
 
 func prepareLDBForTest(t *testing.T, testName string) (db database.Database, name string, teardownFunc func()) {
@@ -40,6 +42,18 @@ func prepareLDBForTest(t *testing.T, testName string) (db database.Database, nam
 	return db, "ldb", teardownFunc
 }
 
+func prepareMemoryDBForTest(t *testing.T, testName string) (db database.Database, name string, teardownFunc func()) {
+	db = memdb.NewMemoryDB()
+	teardownFunc = func() {
+		err := db.Close()
+		if err != nil {
+			t.Fatalf("%s: Close unexpectedly "+
+				"failed: %s", testName, err)
+		}
+	}
+	return db, "memdb", teardownFunc
+}
+
 // testForAllDatabaseTypes runs the given testFunc for every database
 // type defined in databasePrepareFuncs. This is to make sure that
 // all supported database types adhere to the assumptions defined in
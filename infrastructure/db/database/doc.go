@@ -7,7 +7,8 @@ This package provides a database layer to store and retrieve data in a simple
 and efficient manner.
 
 The current backend is ffldb, which makes use of leveldb, flat files, and strict
-checksums in key areas to ensure data integrity.
+checksums in key areas to ensure data integrity. The memdb backend keeps all data
+in memory, and is selected with --dbtype=memory.
 
 Implementors of additional backends are required to implement the following interfaces:
 
@@ -48,12 +48,40 @@ func NewLevelDB(path string, cacheSizeMiB int) (*LevelDB, error) {
 	return db, nil
 }
 
+// NewReadOnlyLevelDB opens an existing leveldb instance defined by the given
+// path for reading only. Writing to it returns an error.
+func NewReadOnlyLevelDB(path string) (*LevelDB, error) {
+	options := Options()
+	options.ReadOnly = true
+	options.ErrorIfMissing = true
+	ldb, err := leveldb.OpenFile(path, &options)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	db := &LevelDB{
+		ldb: ldb,
+	}
+	return db, nil
+}
+
 // Compact compacts the leveldb instance.
 func (db *LevelDB) Compact() error {
 	err := db.ldb.CompactRange(util.Range{Start: nil, Limit: nil})
 	return errors.WithStack(err)
 }
 
+// Stats returns the statistics of the leveldb instance, such as its IO,
+// compactions and the size of each of its levels.
+func (db *LevelDB) Stats() (*leveldb.DBStats, error) {
+	stats := &leveldb.DBStats{}
+	err := db.ldb.Stats(stats)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return stats, nil
+}
+
 // Close closes the leveldb instance.
 func (db *LevelDB) Close() error {
 	err := db.ldb.Close()
@@ -151,3 +151,67 @@ func TestLevelDBTransactionSanity(t *testing.T) {
 			"returned unexpected error: %s", err)
 	}
 }
+
+func TestLevelDBSnapshot(t *testing.T) {
+	ldb, teardownFunc := prepareDatabaseForTest(t, "TestLevelDBSnapshot")
+	defer teardownFunc()
+
+	bucket := database.MakeBucket([]byte("bucket"))
+	for _, keySuffix := range []string{"a", "b"} {
+		err := ldb.Put(bucket.Key([]byte(keySuffix)), []byte(keySuffix))
+		if err != nil {
+			t.Fatalf("TestLevelDBSnapshot: Put unexpectedly failed: %s", err)
+		}
+	}
+	// This key starts with the same bytes as the bucket, but is outside of it
+	err := ldb.Put(database.MakeBucket(nil).Key([]byte("bucket-state")), []byte("state"))
+	if err != nil {
+		t.Fatalf("TestLevelDBSnapshot: Put unexpectedly failed: %s", err)
+	}
+
+	snapshot, err := ldb.Snapshot()
+	if err != nil {
+		t.Fatalf("TestLevelDBSnapshot: Snapshot unexpectedly failed: %s", err)
+	}
+	defer snapshot.Release()
+
+	// Writes made after the snapshot was taken are not seen by it
+	err = ldb.Put(bucket.Key([]byte("c")), []byte("c"))
+	if err != nil {
+		t.Fatalf("TestLevelDBSnapshot: Put unexpectedly failed: %s", err)
+	}
+	err = ldb.Delete(bucket.Key([]byte("a")))
+	if err != nil {
+		t.Fatalf("TestLevelDBSnapshot: Delete unexpectedly failed: %s", err)
+	}
+
+	value, err := snapshot.Get(bucket.Key([]byte("a")))
+	if err != nil {
+		t.Fatalf("TestLevelDBSnapshot: Get unexpectedly failed: %s", err)
+	}
+	if string(value) != "a" {
+		t.Fatalf("TestLevelDBSnapshot: Get returned %s instead of a", value)
+	}
+	_, err = snapshot.Get(bucket.Key([]byte("c")))
+	if !database.IsNotFoundError(err) {
+		t.Fatalf("TestLevelDBSnapshot: Get of a key written after the snapshot "+
+			"returned an unexpected error: %v", err)
+	}
+
+	testForEach := func(prefix string, expectedKeys []string) {
+		var keys []string
+		err := snapshot.ForEach([]byte(prefix), func(key []byte, _ []byte) error {
+			keys = append(keys, string(key))
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("TestLevelDBSnapshot: ForEach unexpectedly failed: %s", err)
+		}
+		if !reflect.DeepEqual(keys, expectedKeys) {
+			t.Fatalf("TestLevelDBSnapshot: ForEach over %s returned keys %v instead of %v",
+				prefix, keys, expectedKeys)
+		}
+	}
+	testForEach("bucket/", []string{"bucket/a", "bucket/b"})
+	testForEach("bucket", []string{"bucket-state", "bucket/a", "bucket/b"})
+}
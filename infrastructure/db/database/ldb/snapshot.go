@@ -0,0 +1,60 @@
+package ldb
+
+import (
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// Snapshot is a read-only view of a leveldb instance as it was when the
+// snapshot was taken. Writes made to the database after that are not seen by it.
+type Snapshot struct {
+	ldbSnapshot *leveldb.Snapshot
+}
+
+// Snapshot takes a snapshot of the current state of the database. The snapshot
+// must be released once it's no longer needed.
+func (db *LevelDB) Snapshot() (*Snapshot, error) {
+	ldbSnapshot, err := db.ldb.GetSnapshot()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &Snapshot{ldbSnapshot: ldbSnapshot}, nil
+}
+
+// Get gets the value for the given key in the snapshot. It returns
+// ErrNotFound if the given key does not exist.
+func (s *Snapshot) Get(key *database.Key) ([]byte, error) {
+	data, err := s.ldbSnapshot.Get(key.Bytes(), nil)
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return nil, errors.Wrapf(database.ErrNotFound,
+				"key %s not found", key)
+		}
+		return nil, errors.WithStack(err)
+	}
+	return data, nil
+}
+
+// ForEach calls f with every key/value pair in the snapshot whose key starts
+// with the given prefix, ordered by key. Unlike a bucket cursor, the prefix
+// doesn't have to end with a bucket separator. The key and value passed to f
+// are only valid until f returns.
+func (s *Snapshot) ForEach(prefix []byte, f func(key []byte, value []byte) error) error {
+	ldbIterator := s.ldbSnapshot.NewIterator(util.BytesPrefix(prefix), nil)
+	defer ldbIterator.Release()
+
+	for ldbIterator.Next() {
+		err := f(ldbIterator.Key(), ldbIterator.Value())
+		if err != nil {
+			return err
+		}
+	}
+	return errors.WithStack(ldbIterator.Error())
+}
+
+// Release releases the snapshot. The snapshot may not be used after it's released.
+func (s *Snapshot) Release() {
+	s.ldbSnapshot.Release()
+}
@@ -0,0 +1,133 @@
+package memdb
+
+import (
+	"bytes"
+
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// MemoryDBCursor iterates over a snapshot of a bucket of a MemoryDB. Like
+// leveldb iterators, it doesn't see writes made after it was opened.
+type MemoryDBCursor struct {
+	iterator *treapIterator
+	bucket   *database.Bucket
+
+	// current is the node the cursor is positioned at. It's nil before the
+	// cursor is positioned and once it's exhausted
+	current      *treapNode
+	isPositioned bool
+
+	isClosed bool
+}
+
+// Cursor begins a new cursor over the given bucket.
+func (db *MemoryDB) Cursor(bucket *database.Bucket) (database.Cursor, error) {
+	root, err := db.snapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &MemoryDBCursor{
+		iterator: &treapIterator{root: root},
+		bucket:   bucket,
+	}, nil
+}
+
+// Next moves the iterator to the next key/value pair. It returns whether the
+// iterator is exhausted. Panics if the cursor is closed.
+func (c *MemoryDBCursor) Next() bool {
+	if c.isClosed {
+		panic("cannot call next on a closed cursor")
+	}
+	if !c.isPositioned {
+		return c.First()
+	}
+	if c.current == nil {
+		return false
+	}
+	c.current = c.inBucket(c.iterator.next())
+	return c.current != nil
+}
+
+// First moves the iterator to the first key/value pair. It returns false if
+// such a pair does not exist. Panics if the cursor is closed.
+func (c *MemoryDBCursor) First() bool {
+	if c.isClosed {
+		panic("cannot call first on a closed cursor")
+	}
+	c.isPositioned = true
+	c.current = c.inBucket(c.iterator.seek(c.bucket.Path()))
+	return c.current != nil
+}
+
+// Seek moves the iterator to the first key/value pair whose key is greater
+// than or equal to the given key. It returns ErrNotFound if such pair does not
+// exist.
+func (c *MemoryDBCursor) Seek(key *database.Key) error {
+	if c.isClosed {
+		return errors.New("cannot seek a closed cursor")
+	}
+
+	keyBytes := key.Bytes()
+	seekKey := keyBytes
+	if bytes.Compare(seekKey, c.bucket.Path()) < 0 {
+		seekKey = c.bucket.Path()
+	}
+	c.isPositioned = true
+	c.current = c.inBucket(c.iterator.seek(seekKey))
+	if c.current == nil || !bytes.Equal(c.current.key, keyBytes) {
+		return errors.Wrapf(database.ErrNotFound, "key %s not found", key)
+	}
+	return nil
+}
+
+// Key returns the key of the current key/value pair, or ErrNotFound if done.
+// Note that the key is trimmed to not include the prefix the cursor was opened
+// with. The caller should not modify the contents of the returned slice.
+func (c *MemoryDBCursor) Key() (*database.Key, error) {
+	if c.isClosed {
+		return nil, errors.New("cannot get the key of a closed cursor")
+	}
+	if c.current == nil {
+		return nil, errors.Wrapf(database.ErrNotFound, "cannot get the "+
+			"key of an exhausted cursor")
+	}
+	suffix := bytes.TrimPrefix(c.current.key, c.bucket.Path())
+	return c.bucket.Key(suffix), nil
+}
+
+// Value returns the value of the current key/value pair, or ErrNotFound if done.
+// The caller should not modify the contents of the returned slice.
+func (c *MemoryDBCursor) Value() ([]byte, error) {
+	if c.isClosed {
+		return nil, errors.New("cannot get the value of a closed cursor")
+	}
+	if c.current == nil {
+		return nil, errors.Wrapf(database.ErrNotFound, "cannot get the "+
+			"value of an exhausted cursor")
+	}
+	return c.current.value, nil
+}
+
+// Close releases associated resources.
+func (c *MemoryDBCursor) Close() error {
+	if c.isClosed {
+		return errors.New("cannot close an already closed cursor")
+	}
+	c.isClosed = true
+	c.iterator = nil
+	c.current = nil
+	c.bucket = nil
+	return nil
+}
+
+// inBucket returns the given node if it's in the cursor's bucket. Since nodes are
+// ordered by key, once a node is out of the bucket all the ones after it are too,
+// so the iterator is exhausted
+func (c *MemoryDBCursor) inBucket(node *treapNode) *treapNode {
+	if node == nil || !bytes.HasPrefix(node.key, c.bucket.Path()) {
+		c.iterator.stack = c.iterator.stack[:0]
+		return nil
+	}
+	return node
+}
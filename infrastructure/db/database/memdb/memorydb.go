@@ -0,0 +1,125 @@
+package memdb
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// errClosed is returned when the database is used after it was closed
+var errClosed = errors.New("the memory database is closed")
+
+// MemoryDB is a database.Database that keeps all its data in memory, ordered by key.
+// Its data is lost once it's closed.
+//
+// Every write replaces the root of an immutable treap, so reads and cursors work
+// over a consistent snapshot without holding the lock.
+type MemoryDB struct {
+	root     *treapNode
+	random   *rand.Rand
+	isClosed bool
+	lock     sync.RWMutex
+}
+
+// NewMemoryDB creates a new empty MemoryDB
+func NewMemoryDB() *MemoryDB {
+	return &MemoryDB{
+		random: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// snapshot returns the current root of the database
+func (db *MemoryDB) snapshot() (*treapNode, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.isClosed {
+		return nil, errClosed
+	}
+	return db.root, nil
+}
+
+// newNode creates a node that owns the given key and value, which must not be
+// modified afterwards. Must be called while holding the write lock, since it uses db.random
+func (db *MemoryDB) newNode(key []byte, value []byte) *treapNode {
+	return &treapNode{
+		key:      key,
+		value:    value,
+		priority: db.random.Uint32(),
+	}
+}
+
+// Compact does nothing, since there's nothing to compact in memory.
+func (db *MemoryDB) Compact() error {
+	return nil
+}
+
+// Close closes the database and releases its data.
+func (db *MemoryDB) Close() error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.isClosed {
+		return errors.New("cannot close an already closed memory database")
+	}
+	db.isClosed = true
+	db.root = nil
+	return nil
+}
+
+// Put sets the value for the given key. It overwrites
+// any previous value for that key.
+func (db *MemoryDB) Put(key *database.Key, value []byte) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.isClosed {
+		return errClosed
+	}
+	valueCopy := make([]byte, len(value))
+	copy(valueCopy, value)
+	db.root = treapPut(db.root, db.newNode(key.Bytes(), valueCopy))
+	return nil
+}
+
+// Get gets the value for the given key. It returns
+// ErrNotFound if the given key does not exist.
+func (db *MemoryDB) Get(key *database.Key) ([]byte, error) {
+	root, err := db.snapshot()
+	if err != nil {
+		return nil, err
+	}
+	node := treapGet(root, key.Bytes())
+	if node == nil {
+		return nil, errors.Wrapf(database.ErrNotFound, "key %s not found", key)
+	}
+	value := make([]byte, len(node.value))
+	copy(value, node.value)
+	return value, nil
+}
+
+// Has returns true if the database does contains the
+// given key.
+func (db *MemoryDB) Has(key *database.Key) (bool, error) {
+	root, err := db.snapshot()
+	if err != nil {
+		return false, err
+	}
+	return treapGet(root, key.Bytes()) != nil, nil
+}
+
+// Delete deletes the value for the given key. Will not
+// return an error if the key doesn't exist.
+func (db *MemoryDB) Delete(key *database.Key) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.isClosed {
+		return errClosed
+	}
+	db.root = treapDelete(db.root, key.Bytes())
+	return nil
+}
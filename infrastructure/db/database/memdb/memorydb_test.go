@@ -0,0 +1,242 @@
+package memdb
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database/ldb"
+)
+
+// testBuckets overlap on purpose: a bucket's cursor also covers its sub-buckets,
+// but not buckets whose path merely starts with the same letters
+var testBuckets = []*database.Bucket{
+	database.MakeBucket(nil),
+	database.MakeBucket([]byte("a")),
+	database.MakeBucket([]byte("a")).Bucket([]byte("b")),
+	database.MakeBucket([]byte("ab")),
+	database.MakeBucket([]byte("c")),
+}
+
+func randomKey(random *rand.Rand) *database.Key {
+	bucket := testBuckets[random.Intn(len(testBuckets))]
+	return bucket.Key([]byte(fmt.Sprintf("%d", random.Intn(50))))
+}
+
+type cursorEntry struct {
+	key   []byte
+	value []byte
+}
+
+// cursorEntries positions the cursor with position and returns all the entries from there on
+func cursorEntries(t *testing.T, cursor database.Cursor, position func() (bool, error)) []cursorEntry {
+	hasEntry, err := position()
+	if err != nil && !database.IsNotFoundError(err) {
+		t.Fatalf("Positioning the cursor unexpectedly failed: %s", err)
+	}
+	var entries []cursorEntry
+	for hasEntry {
+		key, err := cursor.Key()
+		if database.IsNotFoundError(err) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Key unexpectedly failed: %s", err)
+		}
+		value, err := cursor.Value()
+		if err != nil {
+			t.Fatalf("Value unexpectedly failed: %s", err)
+		}
+		entries = append(entries, cursorEntry{key: key.Bytes(), value: append([]byte{}, value...)})
+		hasEntry = cursor.Next()
+	}
+	return entries
+}
+
+// TestMemoryDBMatchesLevelDB applies the same random writes to a MemoryDB and a LevelDB,
+// and makes sure that all the reads and cursors over them return the same results
+func TestMemoryDBMatchesLevelDB(t *testing.T) {
+	path, err := ioutil.TempDir("", "TestMemoryDBMatchesLevelDB")
+	if err != nil {
+		t.Fatalf("TempDir unexpectedly failed: %s", err)
+	}
+	defer os.RemoveAll(path)
+	levelDB, err := ldb.NewLevelDB(path, 8)
+	if err != nil {
+		t.Fatalf("NewLevelDB unexpectedly failed: %s", err)
+	}
+	defer levelDB.Close()
+	memoryDB := NewMemoryDB()
+	defer memoryDB.Close()
+	databases := []database.Database{levelDB, memoryDB}
+
+	random := rand.New(rand.NewSource(0))
+	for i := 0; i < 2000; i++ {
+		key := randomKey(random)
+		value := []byte(fmt.Sprintf("value%d", i))
+		switch random.Intn(4) {
+		case 0:
+			for _, db := range databases {
+				err := db.Delete(key)
+				if err != nil {
+					t.Fatalf("Delete unexpectedly failed: %s", err)
+				}
+			}
+		case 1:
+			// A transaction with a few writes, some of them to the same key
+			otherKey := randomKey(random)
+			for _, db := range databases {
+				dbTx, err := db.Begin()
+				if err != nil {
+					t.Fatalf("Begin unexpectedly failed: %s", err)
+				}
+				err = dbTx.Put(key, value)
+				if err != nil {
+					t.Fatalf("Put unexpectedly failed: %s", err)
+				}
+				err = dbTx.Delete(otherKey)
+				if err != nil {
+					t.Fatalf("Delete unexpectedly failed: %s", err)
+				}
+				err = dbTx.Put(otherKey, value)
+				if err != nil {
+					t.Fatalf("Put unexpectedly failed: %s", err)
+				}
+				err = dbTx.Commit()
+				if err != nil {
+					t.Fatalf("Commit unexpectedly failed: %s", err)
+				}
+			}
+		default:
+			for _, db := range databases {
+				err := db.Put(key, value)
+				if err != nil {
+					t.Fatalf("Put unexpectedly failed: %s", err)
+				}
+			}
+		}
+	}
+
+	for i := 0; i < 200; i++ {
+		key := randomKey(random)
+		var values [][]byte
+		for _, db := range databases {
+			value, err := db.Get(key)
+			if err != nil && !database.IsNotFoundError(err) {
+				t.Fatalf("Get unexpectedly failed: %s", err)
+			}
+			values = append(values, value)
+		}
+		if !bytes.Equal(values[0], values[1]) {
+			t.Fatalf("Get %s returned %s from LevelDB but %s from MemoryDB", key, values[0], values[1])
+		}
+	}
+
+	for _, bucket := range testBuckets {
+		seekKey := randomKey(random)
+		positions := map[string]func(cursor database.Cursor) (bool, error){
+			"Next":  func(cursor database.Cursor) (bool, error) { return cursor.Next(), nil },
+			"First": func(cursor database.Cursor) (bool, error) { return cursor.First(), nil },
+			"Seek": func(cursor database.Cursor) (bool, error) {
+				err := cursor.Seek(seekKey)
+				return true, err
+			},
+		}
+		for positionName, position := range positions {
+			var entries [][]cursorEntry
+			for _, db := range databases {
+				cursor, err := db.Cursor(bucket)
+				if err != nil {
+					t.Fatalf("Cursor unexpectedly failed: %s", err)
+				}
+				entries = append(entries, cursorEntries(t, cursor, func() (bool, error) { return position(cursor) }))
+				err = cursor.Close()
+				if err != nil {
+					t.Fatalf("Close unexpectedly failed: %s", err)
+				}
+			}
+			if len(entries[0]) != len(entries[1]) {
+				t.Fatalf("%s over bucket %q returned %d entries from LevelDB but %d from MemoryDB",
+					positionName, bucket.Path(), len(entries[0]), len(entries[1]))
+			}
+			for j := range entries[0] {
+				if !bytes.Equal(entries[0][j].key, entries[1][j].key) ||
+					!bytes.Equal(entries[0][j].value, entries[1][j].value) {
+					t.Fatalf("%s over bucket %q returned %s=%s from LevelDB but %s=%s from MemoryDB",
+						positionName, bucket.Path(), entries[0][j].key, entries[0][j].value,
+						entries[1][j].key, entries[1][j].value)
+				}
+			}
+		}
+	}
+}
+
+func TestMemoryDBCursorSnapshot(t *testing.T) {
+	db := NewMemoryDB()
+	defer db.Close()
+
+	bucket := database.MakeBucket([]byte("bucket"))
+	for i := 0; i < 3; i++ {
+		err := db.Put(bucket.Key([]byte(fmt.Sprintf("key%d", i))), []byte("value"))
+		if err != nil {
+			t.Fatalf("Put unexpectedly failed: %s", err)
+		}
+	}
+
+	cursor, err := db.Cursor(bucket)
+	if err != nil {
+		t.Fatalf("Cursor unexpectedly failed: %s", err)
+	}
+	defer cursor.Close()
+
+	// Writes made after the cursor was opened are not seen by it
+	err = db.Delete(bucket.Key([]byte("key1")))
+	if err != nil {
+		t.Fatalf("Delete unexpectedly failed: %s", err)
+	}
+	err = db.Put(bucket.Key([]byte("key3")), []byte("value"))
+	if err != nil {
+		t.Fatalf("Put unexpectedly failed: %s", err)
+	}
+
+	count := 0
+	for cursor.Next() {
+		count++
+	}
+	if count != 3 {
+		t.Fatalf("Expected the cursor to see the 3 original keys, but it saw %d", count)
+	}
+	if cursor.Next() {
+		t.Fatalf("Next unexpectedly succeeded on an exhausted cursor")
+	}
+}
+
+func TestMemoryDBClose(t *testing.T) {
+	db := NewMemoryDB()
+	key := database.MakeBucket(nil).Key([]byte("key"))
+	err := db.Put(key, []byte("value"))
+	if err != nil {
+		t.Fatalf("Put unexpectedly failed: %s", err)
+	}
+	err = db.Close()
+	if err != nil {
+		t.Fatalf("Close unexpectedly failed: %s", err)
+	}
+
+	_, err = db.Get(key)
+	if err == nil || database.IsNotFoundError(err) {
+		t.Fatalf("Expected Get to fail on a closed database, got: %v", err)
+	}
+	err = db.Put(key, []byte("value"))
+	if err == nil {
+		t.Fatalf("Put unexpectedly succeeded on a closed database")
+	}
+	err = db.Close()
+	if err == nil {
+		t.Fatalf("Close unexpectedly succeeded on a closed database")
+	}
+}
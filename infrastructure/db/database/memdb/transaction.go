@@ -0,0 +1,132 @@
+package memdb
+
+import (
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// MemoryDBTransaction is a batch of writes that's applied to a MemoryDB
+// atomically when it's committed.
+//
+// Like leveldb transactions, reads are done from the database directly, so
+// writes that were put into the transaction are not available to get within it.
+type MemoryDBTransaction struct {
+	db       *MemoryDB
+	writes   []*transactionWrite
+	isClosed bool
+}
+
+// transactionWrite is a put, or a delete if isDelete is set
+type transactionWrite struct {
+	key      []byte
+	value    []byte
+	isDelete bool
+}
+
+// Begin begins a new transaction.
+func (db *MemoryDB) Begin() (database.Transaction, error) {
+	_, err := db.snapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &MemoryDBTransaction{db: db}, nil
+}
+
+// Commit commits whatever changes were made to the database
+// within this transaction.
+func (tx *MemoryDBTransaction) Commit() error {
+	if tx.isClosed {
+		return errors.New("cannot commit a closed transaction")
+	}
+	tx.isClosed = true
+
+	tx.db.lock.Lock()
+	defer tx.db.lock.Unlock()
+
+	if tx.db.isClosed {
+		return errClosed
+	}
+	root := tx.db.root
+	for _, write := range tx.writes {
+		if write.isDelete {
+			root = treapDelete(root, write.key)
+			continue
+		}
+		root = treapPut(root, tx.db.newNode(write.key, write.value))
+	}
+	tx.db.root = root
+	return nil
+}
+
+// Rollback rolls back whatever changes were made to the
+// database within this transaction.
+func (tx *MemoryDBTransaction) Rollback() error {
+	if tx.isClosed {
+		return errors.New("cannot rollback a closed transaction")
+	}
+
+	tx.isClosed = true
+	tx.writes = nil
+	return nil
+}
+
+// RollbackUnlessClosed rolls back changes that were made to
+// the database within the transaction, unless the transaction
+// had already been closed using either Rollback or Commit.
+func (tx *MemoryDBTransaction) RollbackUnlessClosed() error {
+	if tx.isClosed {
+		return nil
+	}
+	return tx.Rollback()
+}
+
+// Put sets the value for the given key. It overwrites
+// any previous value for that key.
+func (tx *MemoryDBTransaction) Put(key *database.Key, value []byte) error {
+	if tx.isClosed {
+		return errors.New("cannot put into a closed transaction")
+	}
+
+	valueCopy := make([]byte, len(value))
+	copy(valueCopy, value)
+	tx.writes = append(tx.writes, &transactionWrite{key: key.Bytes(), value: valueCopy})
+	return nil
+}
+
+// Get gets the value for the given key. It returns
+// ErrNotFound if the given key does not exist.
+func (tx *MemoryDBTransaction) Get(key *database.Key) ([]byte, error) {
+	if tx.isClosed {
+		return nil, errors.New("cannot get from a closed transaction")
+	}
+	return tx.db.Get(key)
+}
+
+// Has returns true if the database does contains the
+// given key.
+func (tx *MemoryDBTransaction) Has(key *database.Key) (bool, error) {
+	if tx.isClosed {
+		return false, errors.New("cannot has from a closed transaction")
+	}
+	return tx.db.Has(key)
+}
+
+// Delete deletes the value for the given key. Will not
+// return an error if the key doesn't exist.
+func (tx *MemoryDBTransaction) Delete(key *database.Key) error {
+	if tx.isClosed {
+		return errors.New("cannot delete from a closed transaction")
+	}
+
+	tx.writes = append(tx.writes, &transactionWrite{key: key.Bytes(), isDelete: true})
+	return nil
+}
+
+// Cursor begins a new cursor over the given bucket.
+func (tx *MemoryDBTransaction) Cursor(bucket *database.Bucket) (database.Cursor, error) {
+	if tx.isClosed {
+		return nil, errors.New("cannot open a cursor from a closed transaction")
+	}
+
+	return tx.db.Cursor(bucket)
+}
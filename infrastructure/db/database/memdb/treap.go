@@ -0,0 +1,134 @@
+package memdb
+
+import (
+	"bytes"
+)
+
+// treapNode is a node of an immutable treap, a binary search tree ordered by key
+// that's kept balanced by keeping every node's priority higher than its children's.
+// Nodes are never modified once they're in a tree. Every change copies the nodes on
+// its path instead, so that any root is a consistent snapshot of the database
+type treapNode struct {
+	key      []byte
+	value    []byte
+	priority uint32
+	left     *treapNode
+	right    *treapNode
+}
+
+// treapGet returns the node of the given key, or nil if it doesn't exist
+func treapGet(root *treapNode, key []byte) *treapNode {
+	node := root
+	for node != nil {
+		comparison := bytes.Compare(key, node.key)
+		switch {
+		case comparison < 0:
+			node = node.left
+		case comparison > 0:
+			node = node.right
+		default:
+			return node
+		}
+	}
+	return nil
+}
+
+// treapPut returns the root of a treap with the key set to the value of newNode
+func treapPut(root *treapNode, newNode *treapNode) *treapNode {
+	lower, notLower := treapSplit(root, newNode.key)
+	_, higher := treapSplit(notLower, successorKey(newNode.key))
+	return treapMerge(treapMerge(lower, newNode), higher)
+}
+
+// treapDelete returns the root of a treap without the given key
+func treapDelete(root *treapNode, key []byte) *treapNode {
+	if treapGet(root, key) == nil {
+		return root
+	}
+	lower, notLower := treapSplit(root, key)
+	_, higher := treapSplit(notLower, successorKey(key))
+	return treapMerge(lower, higher)
+}
+
+// treapSplit splits a treap into the nodes whose keys are lower than the given
+// key and the nodes whose keys are greater or equal to it
+func treapSplit(node *treapNode, key []byte) (lower *treapNode, notLower *treapNode) {
+	if node == nil {
+		return nil, nil
+	}
+	nodeCopy := *node
+	if bytes.Compare(node.key, key) < 0 {
+		nodeCopy.right, notLower = treapSplit(node.right, key)
+		return &nodeCopy, notLower
+	}
+	lower, nodeCopy.left = treapSplit(node.left, key)
+	return lower, &nodeCopy
+}
+
+// treapMerge merges two treaps, where all the keys of lower are lower than all the keys of higher
+func treapMerge(lower *treapNode, higher *treapNode) *treapNode {
+	if lower == nil {
+		return higher
+	}
+	if higher == nil {
+		return lower
+	}
+	if lower.priority > higher.priority {
+		lowerCopy := *lower
+		lowerCopy.right = treapMerge(lower.right, higher)
+		return &lowerCopy
+	}
+	higherCopy := *higher
+	higherCopy.left = treapMerge(lower, higher.left)
+	return &higherCopy
+}
+
+// successorKey returns the lowest key that's greater than the given key
+func successorKey(key []byte) []byte {
+	successor := make([]byte, len(key)+1)
+	copy(successor, key)
+	return successor
+}
+
+// treapIterator iterates over the nodes of a treap in key order. It keeps the path
+// of nodes whose left subtrees were already visited
+type treapIterator struct {
+	root  *treapNode
+	stack []*treapNode
+}
+
+// seek positions the iterator at the first node whose key is greater than or equal to
+// the given key, and returns it, or nil if there's no such node
+func (it *treapIterator) seek(key []byte) *treapNode {
+	it.stack = it.stack[:0]
+	node := it.root
+	for node != nil {
+		if bytes.Compare(node.key, key) >= 0 {
+			it.stack = append(it.stack, node)
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	return it.current()
+}
+
+// next advances the iterator and returns the node it's positioned at, or nil if it's exhausted
+func (it *treapIterator) next() *treapNode {
+	if len(it.stack) == 0 {
+		return nil
+	}
+	node := it.stack[len(it.stack)-1]
+	it.stack = it.stack[:len(it.stack)-1]
+	for node = node.right; node != nil; node = node.left {
+		it.stack = append(it.stack, node)
+	}
+	return it.current()
+}
+
+func (it *treapIterator) current() *treapNode {
+	if len(it.stack) == 0 {
+		return nil
+	}
+	return it.stack[len(it.stack)-1]
+}
@@ -0,0 +1,114 @@
+package migration
+
+import (
+	"time"
+
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+)
+
+// progressLogInterval is the minimal time between two progress logs of a migration
+const progressLogInterval = 10 * time.Second
+
+// Context is what an UpgradeFunc migrates the database through
+type Context struct {
+	db         database.Database
+	migration  *Migration
+	checkpoint []byte
+	dryRun     bool
+
+	lastProgressLogTime time.Time
+
+	commitCount, putCount, deleteCount int
+}
+
+func newContext(db database.Database, migration *Migration, checkpoint []byte, dryRun bool) *Context {
+	return &Context{
+		db:                  db,
+		migration:           migration,
+		checkpoint:          checkpoint,
+		dryRun:              dryRun,
+		lastProgressLogTime: time.Now(),
+	}
+}
+
+// Database returns the database for reading. All writes must go through Commit,
+// so that they're checkpointed, and discarded in dry runs
+func (c *Context) Database() database.DataAccessor {
+	return c.db
+}
+
+// Checkpoint returns the checkpoint of the last Commit, or nil if the migration starts from scratch
+func (c *Context) Checkpoint() []byte {
+	return c.checkpoint
+}
+
+// IsDryRun returns whether the writes of the migration are discarded
+func (c *Context) IsDryRun() bool {
+	return c.dryRun
+}
+
+// Commit calls writeFunc with a transaction, and commits its writes together with the given
+// checkpoint. If the process crashes, the migration is called again with the checkpoint of
+// the last Commit that went through. In dry runs the writes are counted and rolled back,
+// and the checkpoint is only kept in memory
+func (c *Context) Commit(checkpoint []byte, writeFunc func(dbTx database.Transaction) error) error {
+	dbTx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTx.RollbackUnlessClosed()
+
+	countingTx := &countingTransaction{Transaction: dbTx}
+	err = writeFunc(countingTx)
+	if err != nil {
+		return err
+	}
+	c.commitCount++
+	c.putCount += countingTx.putCount
+	c.deleteCount += countingTx.deleteCount
+
+	checkpointCopy := make([]byte, len(checkpoint))
+	copy(checkpointCopy, checkpoint)
+	c.checkpoint = checkpointCopy
+
+	if c.dryRun {
+		return dbTx.Rollback()
+	}
+	err = dbTx.Put(checkpointKey, serializeCheckpoint(c.migration.FromVersion, checkpoint))
+	if err != nil {
+		return err
+	}
+	return dbTx.Commit()
+}
+
+// LogProgress logs how many of the migration's objects were processed, at most once per
+// progressLogInterval. total may be 0 if it isn't known
+func (c *Context) LogProgress(processed uint64, total uint64, objectName string) {
+	if time.Since(c.lastProgressLogTime) < progressLogInterval {
+		return
+	}
+	c.lastProgressLogTime = time.Now()
+
+	if total == 0 {
+		log.Infof("Migration to version %d: processed %d %s", c.migration.FromVersion+1, processed, objectName)
+		return
+	}
+	log.Infof("Migration to version %d: processed %d/%d %s (%d%%)",
+		c.migration.FromVersion+1, processed, total, objectName, processed*100/total)
+}
+
+// countingTransaction counts the writes that go through a transaction
+type countingTransaction struct {
+	database.Transaction
+	putCount, deleteCount int
+}
+
+func (ct *countingTransaction) Put(key *database.Key, value []byte) error {
+	ct.putCount++
+	return ct.Transaction.Put(key, value)
+}
+
+func (ct *countingTransaction) Delete(key *database.Key) error {
+	ct.deleteCount++
+	return ct.Transaction.Delete(key)
+}
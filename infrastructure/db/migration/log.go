@@ -0,0 +1,7 @@
+package migration
+
+import (
+	"github.com/wombatlabs/coinsecd/infrastructure/logger"
+)
+
+var log = logger.RegisterSubSystem("MIGR")
@@ -0,0 +1,135 @@
+// Package migration upgrades the database from one version of its format to the next.
+//
+// Every version bump registers an UpgradeFunc that migrates the database from the
+// previous version. Upgrade functions write through Context.Commit, which stores a
+// checkpoint in the same transaction as the writes, so that a migration that was
+// interrupted resumes from its last commit instead of starting over.
+package migration
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// UpgradeFunc migrates the database from the version it's registered for to the next one.
+// It must be safe to call again with the context's checkpoint if the process crashed midway
+type UpgradeFunc func(context *Context) error
+
+// Migration is an upgrade of the database from FromVersion to FromVersion+1
+type Migration struct {
+	FromVersion int
+	Description string
+	Upgrade     UpgradeFunc
+}
+
+// Registry holds the migrations between all the database versions
+type Registry struct {
+	migrations map[int]*Migration
+}
+
+// NewRegistry creates a new empty Registry
+func NewRegistry() *Registry {
+	return &Registry{migrations: make(map[int]*Migration)}
+}
+
+// Register registers the upgrade from fromVersion to fromVersion+1. It panics if such an
+// upgrade is already registered
+func (r *Registry) Register(fromVersion int, description string, upgrade UpgradeFunc) {
+	if _, ok := r.migrations[fromVersion]; ok {
+		panic(fmt.Sprintf("a migration from database version %d is already registered", fromVersion))
+	}
+	r.migrations[fromVersion] = &Migration{
+		FromVersion: fromVersion,
+		Description: description,
+		Upgrade:     upgrade,
+	}
+}
+
+// Migrate upgrades db from fromVersion to toVersion, one version at a time, and returns the
+// version db is in when it's done. fromVersion is the version recorded outside the database,
+// which may lag behind the version recorded by a migration that completed right before a crash.
+//
+// In a dry run nothing is written, and only the first pending migration runs, since the
+// ones after it expect its writes. The returned version is then the unchanged version of db
+func (r *Registry) Migrate(db database.Database, fromVersion int, toVersion int, dryRun bool) (int, error) {
+	version, err := effectiveVersion(db, fromVersion)
+	if err != nil {
+		return 0, err
+	}
+	if version > toVersion {
+		return 0, errors.Errorf("the database version %d is newer than the latest supported version %d. "+
+			"It was probably created by a newer version of coinsecd", version, toVersion)
+	}
+	if version == toVersion {
+		return version, nil
+	}
+
+	for pendingVersion := version; pendingVersion < toVersion; pendingVersion++ {
+		if _, ok := r.migrations[pendingVersion]; !ok {
+			return 0, errors.Errorf("there's no migration of the database from version %d to version %d",
+				pendingVersion, pendingVersion+1)
+		}
+	}
+
+	log.Infof("Migrating the database from version %d to version %d", version, toVersion)
+	for version < toVersion {
+		migration := r.migrations[version]
+		err := runMigration(db, migration, dryRun)
+		if err != nil {
+			return 0, err
+		}
+		if dryRun {
+			if version+1 < toVersion {
+				log.Infof("Dry run: skipping the migrations from version %d on, since they depend on "+
+					"the migration to version %d", version+1, version+1)
+			}
+			return version, nil
+		}
+		version++
+	}
+	return version, nil
+}
+
+func runMigration(db database.Database, migration *Migration, dryRun bool) error {
+	checkpoint, err := readCheckpoint(db, migration.FromVersion)
+	if err != nil {
+		return err
+	}
+
+	dryRunPrefix := ""
+	if dryRun {
+		dryRunPrefix = "Dry run: "
+	}
+	if checkpoint != nil {
+		log.Infof("%sResuming the migration of the database from version %d to version %d from its "+
+			"last checkpoint: %s", dryRunPrefix, migration.FromVersion, migration.FromVersion+1, migration.Description)
+	} else {
+		log.Infof("%sMigrating the database from version %d to version %d: %s",
+			dryRunPrefix, migration.FromVersion, migration.FromVersion+1, migration.Description)
+	}
+
+	context := newContext(db, migration, checkpoint, dryRun)
+	start := time.Now()
+	err = migration.Upgrade(context)
+	if err != nil {
+		return errors.Wrapf(err, "error migrating the database from version %d to version %d",
+			migration.FromVersion, migration.FromVersion+1)
+	}
+
+	if dryRun {
+		log.Infof("Dry run: the migration to version %d would have written %d keys and deleted %d keys in %d "+
+			"commits. It took %s", migration.FromVersion+1, context.putCount, context.deleteCount,
+			context.commitCount, time.Since(start))
+		return nil
+	}
+
+	err = completeMigration(db, migration.FromVersion+1)
+	if err != nil {
+		return err
+	}
+	log.Infof("Migrated the database to version %d in %s", migration.FromVersion+1, time.Since(start))
+	return nil
+}
@@ -0,0 +1,280 @@
+package migration
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database/ldb"
+	"github.com/pkg/errors"
+)
+
+// In version 1 of the test database, balances are stored as decimal strings.
+// In version 2 they're stored as 8-byte little endian integers
+var balancesBucket = database.MakeBucket([]byte("balances"))
+
+var v1Balances = map[string]uint64{
+	"alice": 10,
+	"bob":   20,
+	"carol": 30,
+	"dave":  40,
+	"erin":  50,
+}
+
+func prepareV1DatabaseForTest(t *testing.T) (db database.Database, teardownFunc func()) {
+	path, err := ioutil.TempDir("", "TestMigration")
+	if err != nil {
+		t.Fatalf("TempDir: %s", err)
+	}
+	db, err = ldb.NewLevelDB(path, 8)
+	if err != nil {
+		t.Fatalf("NewLevelDB: %s", err)
+	}
+	for account, balance := range v1Balances {
+		err := db.Put(balancesBucket.Key([]byte(account)), []byte(strconv.FormatUint(balance, 10)))
+		if err != nil {
+			t.Fatalf("Put: %s", err)
+		}
+	}
+	return db, func() {
+		err := db.Close()
+		if err != nil {
+			t.Fatalf("Close: %s", err)
+		}
+		err = os.RemoveAll(path)
+		if err != nil {
+			t.Fatalf("RemoveAll: %s", err)
+		}
+	}
+}
+
+// newTestRegistry registers a migration from version 1 that converts the balances two
+// at a time. failAfterCommits makes it fail once it made that many commits, unless it's 0
+func newTestRegistry(failAfterCommits int, upgradeCount *int) *Registry {
+	registry := NewRegistry()
+	registry.Register(1, "store balances as integers", func(context *Context) error {
+		*upgradeCount++
+
+		cursor, err := context.Database().Cursor(balancesBucket)
+		if err != nil {
+			return err
+		}
+		defer cursor.Close()
+
+		// The checkpoint is the last account that was converted
+		checkpoint := context.Checkpoint()
+		if checkpoint == nil {
+			cursor.First()
+		} else {
+			err := cursor.Seek(balancesBucket.Key(checkpoint))
+			if err != nil {
+				return err
+			}
+			cursor.Next()
+		}
+
+		commitCount := 0
+		for {
+			var accounts [][]byte
+			var balances []uint64
+			for len(accounts) < 2 {
+				key, err := cursor.Key()
+				if database.IsNotFoundError(err) {
+					break
+				}
+				if err != nil {
+					return err
+				}
+				value, err := cursor.Value()
+				if err != nil {
+					return err
+				}
+				balance, err := strconv.ParseUint(string(value), 10, 64)
+				if err != nil {
+					return err
+				}
+				accounts = append(accounts, append([]byte{}, key.Suffix()...))
+				balances = append(balances, balance)
+				cursor.Next()
+			}
+			if len(accounts) == 0 {
+				return nil
+			}
+
+			err := context.Commit(accounts[len(accounts)-1], func(dbTx database.Transaction) error {
+				for i, account := range accounts {
+					balanceBytes := make([]byte, 8)
+					binary.LittleEndian.PutUint64(balanceBytes, balances[i])
+					err := dbTx.Put(balancesBucket.Key(account), balanceBytes)
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			commitCount++
+			if failAfterCommits != 0 && commitCount == failAfterCommits {
+				return errors.New("simulated crash")
+			}
+		}
+	})
+	return registry
+}
+
+func balanceVersions(t *testing.T, db database.Database) (v1Count int, v2Count int) {
+	for account, expectedBalance := range v1Balances {
+		value, err := db.Get(balancesBucket.Key([]byte(account)))
+		if err != nil {
+			t.Fatalf("Get: %s", err)
+		}
+		if string(value) == strconv.FormatUint(expectedBalance, 10) {
+			v1Count++
+			continue
+		}
+		if len(value) != 8 || binary.LittleEndian.Uint64(value) != expectedBalance {
+			t.Fatalf("Unexpected value %x for account %s", value, account)
+		}
+		v2Count++
+	}
+	return v1Count, v2Count
+}
+
+func TestMigrate(t *testing.T) {
+	db, teardown := prepareV1DatabaseForTest(t)
+	defer teardown()
+
+	upgradeCount := 0
+	registry := newTestRegistry(0, &upgradeCount)
+	version, err := registry.Migrate(db, 1, 2, false)
+	if err != nil {
+		t.Fatalf("Migrate: %+v", err)
+	}
+	if version != 2 || upgradeCount != 1 {
+		t.Fatalf("Unexpected version %d after %d upgrades", version, upgradeCount)
+	}
+	v1Count, v2Count := balanceVersions(t, db)
+	if v1Count != 0 || v2Count != len(v1Balances) {
+		t.Fatalf("Expected all balances to be migrated, but %d weren't", v1Count)
+	}
+
+	storedVersion, found, err := StoredVersion(db)
+	if err != nil {
+		t.Fatalf("StoredVersion: %s", err)
+	}
+	if !found || storedVersion != 2 {
+		t.Fatalf("Unexpected stored version %d (found: %t)", storedVersion, found)
+	}
+	hasCheckpoint, err := db.Has(checkpointKey)
+	if err != nil {
+		t.Fatalf("Has: %s", err)
+	}
+	if hasCheckpoint {
+		t.Fatalf("The checkpoint wasn't removed when the migration completed")
+	}
+
+	// The stored version is used if the recorded one wasn't updated
+	version, err = registry.Migrate(db, 1, 2, false)
+	if err != nil {
+		t.Fatalf("Migrate: %+v", err)
+	}
+	if version != 2 || upgradeCount != 1 {
+		t.Fatalf("Unexpected version %d after %d upgrades", version, upgradeCount)
+	}
+}
+
+func TestMigrateResumesFromCheckpoint(t *testing.T) {
+	db, teardown := prepareV1DatabaseForTest(t)
+	defer teardown()
+
+	upgradeCount := 0
+	_, err := newTestRegistry(2, &upgradeCount).Migrate(db, 1, 2, false)
+	if err == nil || !strings.Contains(err.Error(), "simulated crash") {
+		t.Fatalf("Expected the migration to crash, got: %v", err)
+	}
+	v1Count, v2Count := balanceVersions(t, db)
+	if v1Count != 1 || v2Count != 4 {
+		t.Fatalf("Expected the first two commits to be written, got %d migrated balances", v2Count)
+	}
+	_, found, err := StoredVersion(db)
+	if err != nil {
+		t.Fatalf("StoredVersion: %s", err)
+	}
+	if found {
+		t.Fatalf("The version was stored even though the migration crashed")
+	}
+
+	// Converting an already converted balance fails, so this only passes if
+	// the migration resumes after the last commit
+	version, err := newTestRegistry(0, &upgradeCount).Migrate(db, 1, 2, false)
+	if err != nil {
+		t.Fatalf("Migrate: %+v", err)
+	}
+	if version != 2 {
+		t.Fatalf("Unexpected version %d", version)
+	}
+	v1Count, _ = balanceVersions(t, db)
+	if v1Count != 0 {
+		t.Fatalf("Expected all balances to be migrated, but %d weren't", v1Count)
+	}
+}
+
+func TestMigrateDryRun(t *testing.T) {
+	db, teardown := prepareV1DatabaseForTest(t)
+	defer teardown()
+
+	upgradeCount := 0
+	registry := newTestRegistry(0, &upgradeCount)
+	registry.Register(2, "never runs in a dry run", func(context *Context) error {
+		t.Fatalf("The migration from version 2 ran in a dry run")
+		return nil
+	})
+	version, err := registry.Migrate(db, 1, 3, true)
+	if err != nil {
+		t.Fatalf("Migrate: %+v", err)
+	}
+	if version != 1 || upgradeCount != 1 {
+		t.Fatalf("Unexpected version %d after %d upgrades", version, upgradeCount)
+	}
+	v1Count, _ := balanceVersions(t, db)
+	if v1Count != len(v1Balances) {
+		t.Fatalf("A dry run migrated %d balances", len(v1Balances)-v1Count)
+	}
+	hasMigrationState, err := db.Has(checkpointKey)
+	if err != nil {
+		t.Fatalf("Has: %s", err)
+	}
+	hasVersion, err := db.Has(versionKey)
+	if err != nil {
+		t.Fatalf("Has: %s", err)
+	}
+	if hasMigrationState || hasVersion {
+		t.Fatalf("A dry run wrote the migration state")
+	}
+}
+
+func TestMigrateErrors(t *testing.T) {
+	db, teardown := prepareV1DatabaseForTest(t)
+	defer teardown()
+
+	upgradeCount := 0
+	registry := newTestRegistry(0, &upgradeCount)
+
+	_, err := registry.Migrate(db, 1, 3, false)
+	if err == nil || !strings.Contains(err.Error(), "from version 2 to version 3") {
+		t.Fatalf("Expected a missing migration error, got: %v", err)
+	}
+	_, err = registry.Migrate(db, 3, 2, false)
+	if err == nil || !strings.Contains(err.Error(), "newer than the latest supported version") {
+		t.Fatalf("Expected a newer version error, got: %v", err)
+	}
+	if upgradeCount != 0 {
+		t.Fatalf("A migration ran even though the versions were invalid")
+	}
+}
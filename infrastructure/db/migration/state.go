@@ -0,0 +1,95 @@
+package migration
+
+import (
+	"encoding/binary"
+
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+var migrationBucket = database.MakeBucket([]byte("migration"))
+
+// versionKey holds the version of the last migration that completed. It's what
+// the database is in even if the version recorded outside of it wasn't updated
+var versionKey = migrationBucket.Key([]byte("version"))
+
+// checkpointKey holds the version that the migration in progress upgrades from,
+// followed by the checkpoint it last committed
+var checkpointKey = migrationBucket.Key([]byte("checkpoint"))
+
+// StoredVersion returns the version of the last migration that completed on db,
+// and false if no migration ever completed on it
+func StoredVersion(db database.DataAccessor) (int, bool, error) {
+	versionBytes, err := db.Get(versionKey)
+	if database.IsNotFoundError(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	if len(versionBytes) != 4 {
+		return 0, false, errors.Errorf("malformed stored database version of length %d", len(versionBytes))
+	}
+	return int(binary.LittleEndian.Uint32(versionBytes)), true, nil
+}
+
+func effectiveVersion(db database.DataAccessor, recordedVersion int) (int, error) {
+	storedVersion, found, err := StoredVersion(db)
+	if err != nil {
+		return 0, err
+	}
+	if found && storedVersion > recordedVersion {
+		log.Warnf("The database was migrated to version %d, but its version was recorded as %d. "+
+			"The migration was probably interrupted right before it finished", storedVersion, recordedVersion)
+		return storedVersion, nil
+	}
+	return recordedVersion, nil
+}
+
+func readCheckpoint(db database.DataAccessor, fromVersion int) ([]byte, error) {
+	checkpointBytes, err := db.Get(checkpointKey)
+	if database.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(checkpointBytes) < 4 {
+		return nil, errors.Errorf("malformed migration checkpoint of length %d", len(checkpointBytes))
+	}
+	checkpointVersion := int(binary.LittleEndian.Uint32(checkpointBytes[:4]))
+	if checkpointVersion != fromVersion {
+		return nil, errors.Errorf("found a checkpoint of the migration from version %d while migrating "+
+			"from version %d", checkpointVersion, fromVersion)
+	}
+	return checkpointBytes[4:], nil
+}
+
+func serializeCheckpoint(fromVersion int, checkpoint []byte) []byte {
+	checkpointBytes := make([]byte, 4+len(checkpoint))
+	binary.LittleEndian.PutUint32(checkpointBytes[:4], uint32(fromVersion))
+	copy(checkpointBytes[4:], checkpoint)
+	return checkpointBytes
+}
+
+// completeMigration records that db is in the given version and removes the checkpoint of
+// the migration to it, in a single transaction
+func completeMigration(db database.Database, version int) error {
+	dbTx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer dbTx.RollbackUnlessClosed()
+
+	versionBytes := make([]byte, 4)
+	binary.LittleEndian.PutUint32(versionBytes, uint32(version))
+	err = dbTx.Put(versionKey, versionBytes)
+	if err != nil {
+		return err
+	}
+	err = dbTx.Delete(checkpointKey)
+	if err != nil {
+		return err
+	}
+	return dbTx.Commit()
+}
@@ -0,0 +1,41 @@
+// Package health serves the liveness and readiness of coinsecd over HTTP, for
+// orchestrators and load balancers to probe
+package health
+
+// CheckFunc checks a single aspect of the node. It returns whether the aspect is
+// healthy, and a human readable detail either way
+type CheckFunc func() (healthy bool, detail string)
+
+// Check is a named CheckFunc
+type Check struct {
+	Name string
+	Func CheckFunc
+}
+
+// CheckResult is the outcome of a single Check
+type CheckResult struct {
+	Healthy bool   `json:"healthy"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+// Report is the outcome of a set of checks, as it's served in JSON
+type Report struct {
+	Healthy bool                    `json:"healthy"`
+	Checks  map[string]*CheckResult `json:"checks"`
+}
+
+// RunChecks runs all the given checks. The report is healthy only if all of them are
+func RunChecks(checks []*Check) *Report {
+	report := &Report{
+		Healthy: true,
+		Checks:  make(map[string]*CheckResult, len(checks)),
+	}
+	for _, check := range checks {
+		healthy, detail := check.Func()
+		report.Checks[check.Name] = &CheckResult{Healthy: healthy, Detail: detail}
+		if !healthy {
+			report.Healthy = false
+		}
+	}
+	return report
+}
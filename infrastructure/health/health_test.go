@@ -0,0 +1,57 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestServer(t *testing.T) {
+	isReady := false
+	livenessChecks := []*Check{
+		{Name: "process", Func: func() (bool, string) { return true, "" }},
+	}
+	readinessChecks := []*Check{
+		{Name: "process", Func: func() (bool, string) { return true, "" }},
+		{Name: "synced", Func: func() (bool, string) { return isReady, "the node is syncing" }},
+	}
+	server := NewServer(nil, livenessChecks, readinessChecks)
+
+	get := func(path string) (int, *Report) {
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		report := &Report{}
+		err := json.Unmarshal(recorder.Body.Bytes(), report)
+		if err != nil {
+			t.Fatalf("Error parsing the report of %s: %s", path, err)
+		}
+		return recorder.Code, report
+	}
+
+	statusCode, report := get(LivenessPath)
+	if statusCode != http.StatusOK || !report.Healthy || len(report.Checks) != 1 {
+		t.Fatalf("Unexpected liveness response %d: %+v", statusCode, report)
+	}
+
+	statusCode, report = get(ReadinessPath)
+	if statusCode != http.StatusServiceUnavailable || report.Healthy {
+		t.Fatalf("Unexpected readiness response %d: %+v", statusCode, report)
+	}
+	if !report.Checks["process"].Healthy || report.Checks["synced"].Healthy ||
+		report.Checks["synced"].Detail != "the node is syncing" {
+		t.Fatalf("Unexpected readiness checks: %+v", report.Checks)
+	}
+
+	isReady = true
+	statusCode, report = get(ReadinessPath)
+	if statusCode != http.StatusOK || !report.Healthy {
+		t.Fatalf("Unexpected readiness response %d: %+v", statusCode, report)
+	}
+
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, ReadinessPath, nil))
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("Unexpected status code. Want: %d, got: %d", http.StatusMethodNotAllowed, recorder.Code)
+	}
+}
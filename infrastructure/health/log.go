@@ -0,0 +1,9 @@
+package health
+
+import (
+	"github.com/wombatlabs/coinsecd/infrastructure/logger"
+	"github.com/wombatlabs/coinsecd/util/panics"
+)
+
+var log = logger.RegisterSubSystem("HLTH")
+var spawn = panics.GoroutineWrapperFunc(log)
@@ -0,0 +1,106 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/util/panics"
+	"github.com/pkg/errors"
+)
+
+const (
+	// LivenessPath is the HTTP path that the liveness checks are served on
+	LivenessPath = "/healthz"
+
+	// ReadinessPath is the HTTP path that the readiness checks are served on
+	ReadinessPath = "/readyz"
+)
+
+// Server serves the liveness and readiness checks of the node over HTTP. A check
+// set responds with 200 if all its checks pass, and with 503 otherwise
+type Server struct {
+	listeningAddresses []string
+	serveMux           *http.ServeMux
+	server             *http.Server
+}
+
+// NewServer creates a new Server that serves the given liveness checks on LivenessPath
+// and the given readiness checks on ReadinessPath
+func NewServer(listeningAddresses []string, livenessChecks []*Check, readinessChecks []*Check) *Server {
+	server := &Server{
+		listeningAddresses: listeningAddresses,
+		serveMux:           http.NewServeMux(),
+	}
+	server.serveMux.HandleFunc(LivenessPath, server.checksHandler(livenessChecks))
+	server.serveMux.HandleFunc(ReadinessPath, server.checksHandler(readinessChecks))
+	server.server = &http.Server{Handler: server}
+	return server
+}
+
+// Start starts listening on all the server's addresses
+func (s *Server) Start() error {
+	for _, listenAddress := range s.listeningAddresses {
+		listener, err := net.Listen("tcp", listenAddress)
+		if err != nil {
+			return errors.Wrapf(err, "health server error listening on %s", listenAddress)
+		}
+
+		listenAddress := listenAddress
+		spawn("health.Server.Start-Serve", func() {
+			err := s.server.Serve(listener)
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				panics.Exit(log, fmt.Sprintf("error serving health checks on %s: %+v", listenAddress, err))
+			}
+		})
+
+		log.Infof("Health server listening on %s", listener.Addr())
+	}
+	return nil
+}
+
+// Stop stops the server
+func (s *Server) Stop() error {
+	const stopTimeout = 2 * time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+	defer cancel()
+
+	err := s.server.Shutdown(ctx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		log.Warnf("Could not gracefully stop the health server: timed out after %s", stopTimeout)
+		return s.server.Close()
+	}
+	return err
+}
+
+// ServeHTTP serves the liveness and readiness checks
+func (s *Server) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	s.serveMux.ServeHTTP(writer, request)
+}
+
+func (s *Server) checksHandler(checks []*Check) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet && request.Method != http.MethodHead {
+			writer.Header().Set("Allow", http.MethodGet)
+			http.Error(writer, "health checks must be requested with GET", http.StatusMethodNotAllowed)
+			return
+		}
+
+		report := RunChecks(checks)
+		statusCode := http.StatusOK
+		if !report.Healthy {
+			statusCode = http.StatusServiceUnavailable
+		}
+
+		writer.Header().Set("Content-Type", "application/json")
+		writer.WriteHeader(statusCode)
+		err := json.NewEncoder(writer).Encode(report)
+		if err != nil {
+			log.Warnf("Error writing the health report to %s: %s", request.RemoteAddr, err)
+		}
+	}
+}
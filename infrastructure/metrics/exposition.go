@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ExpositionContentType is the content type of the Prometheus text exposition format
+const ExpositionContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+var helpEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+// WriteText writes the given families in the Prometheus text exposition format.
+// Families without samples are skipped
+func WriteText(writer io.Writer, families []*Family) error {
+	bufferedWriter := bufio.NewWriter(writer)
+	for _, family := range families {
+		if len(family.Samples) == 0 {
+			continue
+		}
+		if family.Help != "" {
+			bufferedWriter.WriteString("# HELP " + family.Name + " " + helpEscaper.Replace(family.Help) + "\n")
+		}
+		bufferedWriter.WriteString("# TYPE " + family.Name + " " + string(family.Type) + "\n")
+
+		for _, sample := range family.Samples {
+			bufferedWriter.WriteString(sample.Name)
+			if len(sample.Labels) > 0 {
+				bufferedWriter.WriteByte('{')
+				for i, label := range sample.Labels {
+					if i > 0 {
+						bufferedWriter.WriteByte(',')
+					}
+					bufferedWriter.WriteString(label.Name + `="` + labelValueEscaper.Replace(label.Value) + `"`)
+				}
+				bufferedWriter.WriteByte('}')
+			}
+			bufferedWriter.WriteString(" " + formatValue(sample.Value) + "\n")
+		}
+	}
+	return errors.WithStack(bufferedWriter.Flush())
+}
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
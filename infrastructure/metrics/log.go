@@ -0,0 +1,9 @@
+package metrics
+
+import (
+	"github.com/wombatlabs/coinsecd/infrastructure/logger"
+	"github.com/wombatlabs/coinsecd/util/panics"
+)
+
+var log = logger.RegisterSubSystem("METR")
+var spawn = panics.GoroutineWrapperFunc(log)
@@ -0,0 +1,71 @@
+// Package metrics keeps the metrics of coinsecd, and serves them in the text
+// exposition format of Prometheus
+package metrics
+
+// Type is the type of a metric family, as it's written in its TYPE line
+type Type string
+
+// The metric types that coinsecd exposes
+const (
+	TypeCounter   Type = "counter"
+	TypeGauge     Type = "gauge"
+	TypeHistogram Type = "histogram"
+)
+
+// Label is a single name/value pair that distinguishes samples of the same metric
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sample is a single value of a metric. Name is usually the name of its family,
+// but histograms have samples with suffixed names, such as name_bucket and name_sum
+type Sample struct {
+	Name   string
+	Labels []Label
+	Value  float64
+}
+
+// Family is a metric along with all its samples
+type Family struct {
+	Name    string
+	Help    string
+	Type    Type
+	Samples []*Sample
+}
+
+// Collector returns the current families of one or more metrics. It's called
+// on every scrape
+type Collector interface {
+	Collect() []*Family
+}
+
+// CollectorFunc is a Collector implemented by a single function
+type CollectorFunc func() []*Family
+
+// Collect calls the function
+func (f CollectorFunc) Collect() []*Family {
+	return f()
+}
+
+// NewGaugeFamily returns a gauge family with a single unlabeled sample
+func NewGaugeFamily(name string, help string, value float64) *Family {
+	return &Family{
+		Name:    name,
+		Help:    help,
+		Type:    TypeGauge,
+		Samples: []*Sample{{Name: name, Value: value}},
+	}
+}
+
+// NewCounterFamily returns a counter family with a single unlabeled sample
+func NewCounterFamily(name string, help string, value float64) *Family {
+	family := NewGaugeFamily(name, help, value)
+	family.Type = TypeCounter
+	return family
+}
+
+// AddSample adds a sample with the family's name and the given labels
+func (f *Family) AddSample(value float64, labels ...Label) {
+	f.Samples = append(f.Samples, &Sample{Name: f.Name, Labels: labels, Value: value})
+}
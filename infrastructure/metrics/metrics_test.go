@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWriteText(t *testing.T) {
+	registry := NewRegistry()
+
+	requests := NewCounterVec("test_requests_total", "Requests per command", "command")
+	requests.Inc("GetInfo")
+	requests.Add(2, "GetBlock")
+	durations := NewHistogramVec("test_duration_seconds", "Request durations", []float64{1, 0.1}, "command")
+	durations.Observe(0.05, "GetInfo")
+	durations.Observe(0.5, "GetInfo")
+	durations.Observe(0.1, "GetInfo")
+	durations.Observe(20, "GetInfo")
+	registry.Register(requests, durations, CollectorFunc(func() []*Family {
+		peers := &Family{Name: "test_peers", Help: "Peers\nper \\ direction", Type: TypeGauge}
+		peers.AddSample(3, Label{Name: "direction", Value: `in"bound`})
+		return []*Family{
+			NewGaugeFamily("test_score", "", math.Inf(1)),
+			peers,
+			{Name: "test_empty", Type: TypeGauge},
+		}
+	}))
+	// Families with the same name are merged
+	registry.Register(CollectorFunc(func() []*Family {
+		peers := &Family{Name: "test_peers", Type: TypeGauge}
+		peers.AddSample(5, Label{Name: "direction", Value: "outbound"})
+		return []*Family{peers}
+	}))
+
+	var builder strings.Builder
+	err := WriteText(&builder, registry.Gather())
+	if err != nil {
+		t.Fatalf("WriteText: %s", err)
+	}
+
+	expected := `# HELP test_duration_seconds Request durations
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{command="GetInfo",le="0.1"} 2
+test_duration_seconds_bucket{command="GetInfo",le="1"} 3
+test_duration_seconds_bucket{command="GetInfo",le="+Inf"} 4
+test_duration_seconds_sum{command="GetInfo"} 20.65
+test_duration_seconds_count{command="GetInfo"} 4
+# HELP test_peers Peers\nper \\ direction
+# TYPE test_peers gauge
+test_peers{direction="in\"bound"} 3
+test_peers{direction="outbound"} 5
+# HELP test_requests_total Requests per command
+# TYPE test_requests_total counter
+test_requests_total{command="GetBlock"} 2
+test_requests_total{command="GetInfo"} 1
+# TYPE test_score gauge
+test_score +Inf
+`
+	if builder.String() != expected {
+		t.Fatalf("Unexpected exposition.\nWant:\n%s\nGot:\n%s", expected, builder.String())
+	}
+}
+
+func TestServer(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(CollectorFunc(func() []*Family {
+		return []*Family{NewCounterFamily("test_blocks_total", "Blocks", 7)}
+	}))
+	server := NewServer(nil, registry)
+
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, MetricsPath, nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Unexpected status code. Want: %d, got: %d", http.StatusOK, recorder.Code)
+	}
+	if recorder.Header().Get("Content-Type") != ExpositionContentType {
+		t.Fatalf("Unexpected content type %s", recorder.Header().Get("Content-Type"))
+	}
+	if !strings.Contains(recorder.Body.String(), "test_blocks_total 7\n") {
+		t.Fatalf("Unexpected body:\n%s", recorder.Body)
+	}
+
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, MetricsPath, nil))
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("Unexpected status code. Want: %d, got: %d", http.StatusMethodNotAllowed, recorder.Code)
+	}
+}
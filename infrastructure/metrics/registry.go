@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"sort"
+	"sync"
+)
+
+// Registry holds the collectors whose metrics are served together
+type Registry struct {
+	collectors []Collector
+	lock       sync.RWMutex
+}
+
+// NewRegistry creates a new empty Registry
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// DefaultRegistry is the registry that the metrics of coinsecd's packages are registered in.
+// It's the one served by --metricslisten
+var DefaultRegistry = NewRegistry()
+
+// Register adds the given collectors to the registry
+func (r *Registry) Register(collectors ...Collector) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.collectors = append(r.collectors, collectors...)
+}
+
+// Register adds the given collectors to DefaultRegistry
+func Register(collectors ...Collector) {
+	DefaultRegistry.Register(collectors...)
+}
+
+// Gather collects the families of all the registered collectors, ordered by name. Families
+// with the same name are merged, so that several collectors may add samples to one family
+func (r *Registry) Gather() []*Family {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	familiesByName := make(map[string]*Family)
+	for _, collector := range r.collectors {
+		for _, family := range collector.Collect() {
+			existingFamily, ok := familiesByName[family.Name]
+			if !ok {
+				familiesByName[family.Name] = family
+				continue
+			}
+			existingFamily.Samples = append(existingFamily.Samples, family.Samples...)
+		}
+	}
+
+	families := make([]*Family, 0, len(familiesByName))
+	for _, family := range familiesByName {
+		families = append(families, family)
+	}
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].Name < families[j].Name
+	})
+	return families
+}
+
+// Collect returns the families of the registry, which allows a registry to be registered
+// in another one
+func (r *Registry) Collect() []*Family {
+	return r.Gather()
+}
@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/util/panics"
+	"github.com/pkg/errors"
+)
+
+// MetricsPath is the HTTP path that the metrics are served on
+const MetricsPath = "/metrics"
+
+// Server serves the metrics of a registry over HTTP for Prometheus to scrape
+type Server struct {
+	registry           *Registry
+	listeningAddresses []string
+	server             *http.Server
+}
+
+// NewServer creates a new Server that serves the metrics of the given registry
+func NewServer(listeningAddresses []string, registry *Registry) *Server {
+	server := &Server{
+		registry:           registry,
+		listeningAddresses: listeningAddresses,
+	}
+	serveMux := http.NewServeMux()
+	serveMux.Handle(MetricsPath, server)
+	server.server = &http.Server{Handler: serveMux}
+	return server
+}
+
+// Start starts listening on all the server's addresses
+func (s *Server) Start() error {
+	for _, listenAddress := range s.listeningAddresses {
+		listener, err := net.Listen("tcp", listenAddress)
+		if err != nil {
+			return errors.Wrapf(err, "metrics server error listening on %s", listenAddress)
+		}
+
+		listenAddress := listenAddress
+		spawn("metrics.Server.Start-Serve", func() {
+			err := s.server.Serve(listener)
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				panics.Exit(log, fmt.Sprintf("error serving metrics on %s: %+v", listenAddress, err))
+			}
+		})
+
+		log.Infof("Metrics server listening on %s", listener.Addr())
+	}
+	return nil
+}
+
+// Stop stops the server
+func (s *Server) Stop() error {
+	const stopTimeout = 2 * time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+	defer cancel()
+
+	err := s.server.Shutdown(ctx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		log.Warnf("Could not gracefully stop the metrics server: timed out after %s", stopTimeout)
+		return s.server.Close()
+	}
+	return err
+}
+
+// ServeHTTP writes the metrics of the server's registry
+func (s *Server) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet && request.Method != http.MethodHead {
+		writer.Header().Set("Allow", http.MethodGet)
+		http.Error(writer, "metrics must be requested with GET", http.StatusMethodNotAllowed)
+		return
+	}
+
+	writer.Header().Set("Content-Type", ExpositionContentType)
+	err := WriteText(writer, s.registry.Gather())
+	if err != nil {
+		log.Warnf("Error writing metrics to %s: %s", request.RemoteAddr, err)
+	}
+}
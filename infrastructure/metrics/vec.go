@@ -0,0 +1,182 @@
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultBuckets are the upper bounds, in seconds, of the buckets of histograms
+// that measure durations
+var DefaultBuckets = []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// labeledValues keeps a value for every combination of label values
+type labeledValues struct {
+	labelNames []string
+
+	mutex  sync.Mutex
+	values map[string]interface{}
+	labels map[string][]Label
+}
+
+func newLabeledValues(labelNames []string) labeledValues {
+	return labeledValues{
+		labelNames: labelNames,
+		values:     make(map[string]interface{}),
+		labels:     make(map[string][]Label),
+	}
+}
+
+// update calls updateFunc with the value of the given label values while holding the lock.
+// newValue creates the value if it doesn't exist yet
+func (lv *labeledValues) update(labelValues []string, newValue func() interface{}, updateFunc func(value interface{})) {
+	if len(labelValues) != len(lv.labelNames) {
+		panic(fmt.Sprintf("expected %d label values but got %d", len(lv.labelNames), len(labelValues)))
+	}
+	key := strings.Join(labelValues, "\xff")
+
+	lv.mutex.Lock()
+	defer lv.mutex.Unlock()
+
+	value, ok := lv.values[key]
+	if !ok {
+		value = newValue()
+		lv.values[key] = value
+		labels := make([]Label, len(labelValues))
+		for i, labelValue := range labelValues {
+			labels[i] = Label{Name: lv.labelNames[i], Value: labelValue}
+		}
+		lv.labels[key] = labels
+	}
+	updateFunc(value)
+}
+
+// forEach calls collectFunc with every value and its labels, ordered by their label
+// values, while holding the lock
+func (lv *labeledValues) forEach(collectFunc func(labels []Label, value interface{})) {
+	lv.mutex.Lock()
+	defer lv.mutex.Unlock()
+
+	keys := make([]string, 0, len(lv.values))
+	for key := range lv.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		collectFunc(lv.labels[key], lv.values[key])
+	}
+}
+
+// CounterVec is a counter that's kept separately for every combination of label values
+type CounterVec struct {
+	name   string
+	help   string
+	values labeledValues
+}
+
+// NewCounterVec creates a new CounterVec with the given label names
+func NewCounterVec(name string, help string, labelNames ...string) *CounterVec {
+	return &CounterVec{
+		name:   name,
+		help:   help,
+		values: newLabeledValues(labelNames),
+	}
+}
+
+// Add adds the given non-negative value to the counter of the given label values
+func (v *CounterVec) Add(value float64, labelValues ...string) {
+	if value < 0 {
+		panic("counters can't decrease")
+	}
+	v.values.update(labelValues, func() interface{} { return new(float64) }, func(counter interface{}) {
+		*counter.(*float64) += value
+	})
+}
+
+// Inc increments the counter of the given label values
+func (v *CounterVec) Inc(labelValues ...string) {
+	v.Add(1, labelValues...)
+}
+
+// Collect returns the family of the counter
+func (v *CounterVec) Collect() []*Family {
+	family := &Family{Name: v.name, Help: v.help, Type: TypeCounter}
+	v.values.forEach(func(labels []Label, counter interface{}) {
+		family.AddSample(*counter.(*float64), labels...)
+	})
+	return []*Family{family}
+}
+
+type histogram struct {
+	// bucketCounts[i] is the number of observations that fell in bucket i alone.
+	// They're only accumulated when collected
+	bucketCounts []uint64
+	sum          float64
+	count        uint64
+}
+
+// HistogramVec is a histogram that's kept separately for every combination of label values
+type HistogramVec struct {
+	name    string
+	help    string
+	buckets []float64
+	values  labeledValues
+}
+
+// NewHistogramVec creates a new HistogramVec with the given bucket upper bounds and label names.
+// A +Inf bucket is always added
+func NewHistogramVec(name string, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	sortedBuckets := make([]float64, 0, len(buckets))
+	for _, bucket := range buckets {
+		if !math.IsInf(bucket, 1) {
+			sortedBuckets = append(sortedBuckets, bucket)
+		}
+	}
+	sort.Float64s(sortedBuckets)
+
+	return &HistogramVec{
+		name:    name,
+		help:    help,
+		buckets: sortedBuckets,
+		values:  newLabeledValues(labelNames),
+	}
+}
+
+// Observe adds a single observation to the histogram of the given label values
+func (v *HistogramVec) Observe(value float64, labelValues ...string) {
+	bucketIndex := sort.SearchFloat64s(v.buckets, value)
+	newHistogram := func() interface{} {
+		return &histogram{bucketCounts: make([]uint64, len(v.buckets)+1)}
+	}
+	v.values.update(labelValues, newHistogram, func(histogramValue interface{}) {
+		histogram := histogramValue.(*histogram)
+		histogram.bucketCounts[bucketIndex]++
+		histogram.sum += value
+		histogram.count++
+	})
+}
+
+// Collect returns the family of the histogram
+func (v *HistogramVec) Collect() []*Family {
+	family := &Family{Name: v.name, Help: v.help, Type: TypeHistogram}
+	v.values.forEach(func(labels []Label, value interface{}) {
+		histogram := value.(*histogram)
+		cumulativeCount := uint64(0)
+		for i, bucketCount := range histogram.bucketCounts {
+			cumulativeCount += bucketCount
+			upperBound := math.Inf(1)
+			if i < len(v.buckets) {
+				upperBound = v.buckets[i]
+			}
+			bucketLabels := append(append([]Label{}, labels...), Label{Name: "le", Value: formatValue(upperBound)})
+			family.Samples = append(family.Samples,
+				&Sample{Name: v.name + "_bucket", Labels: bucketLabels, Value: float64(cumulativeCount)})
+		}
+		family.Samples = append(family.Samples,
+			&Sample{Name: v.name + "_sum", Labels: labels, Value: histogram.sum},
+			&Sample{Name: v.name + "_count", Labels: labels, Value: float64(histogram.count)})
+	})
+	return []*Family{family}
+}
@@ -1,6 +1,7 @@
 package netadapter
 
 import (
+	"crypto/tls"
 	"sync"
 	"sync/atomic"
 
@@ -10,6 +11,7 @@ import (
 	routerpkg "github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server/grpcserver"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcauth"
 	"github.com/pkg/errors"
 )
 
@@ -31,8 +33,17 @@ type NetAdapter struct {
 	rpcRouterInitializer RouterInitializer
 	stop                 uint32
 
+	// rpcWebsocketServer is nil if no RPC WebSocket listeners were configured
+	rpcWebsocketServer server.Server
+
+	// rpcAuthenticator is nil if RPC authentication is disabled
+	rpcAuthenticator *rpcauth.Authenticator
+
 	p2pConnections     map[*NetConnection]struct{}
 	p2pConnectionsLock sync.RWMutex
+
+	// p2pTraffic is nil unless metrics are enabled
+	p2pTraffic *routerpkg.Traffic
 }
 
 // NewNetAdapter creates and starts a new NetAdapter on the
@@ -46,7 +57,21 @@ func NewNetAdapter(cfg *config.Config) (*NetAdapter, error) {
 	if err != nil {
 		return nil, err
 	}
-	rpcServer, err := grpcserver.NewRPCServer(cfg.RPCListeners, cfg.RPCMaxClients)
+	var rpcTLSConfig *tls.Config
+	if cfg.RPCTLS {
+		rpcTLSConfig, err = grpcserver.NewRPCServerTLSConfig(cfg.RPCCert, cfg.RPCKey, cfg.RPCClientCA)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var rpcAuthenticator *rpcauth.Authenticator
+	if cfg.RPCAuthTokens != "" || cfg.RPCAuthHMACKey != "" {
+		rpcAuthenticator, err = rpcauth.NewAuthenticator(cfg.RPCAuthTokens, cfg.RPCAuthHMACKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rpcServer, err := grpcserver.NewRPCServer(cfg.RPCListeners, cfg.RPCMaxClients, rpcTLSConfig, rpcAuthenticator)
 	if err != nil {
 		return nil, err
 	}
@@ -56,12 +81,27 @@ func NewNetAdapter(cfg *config.Config) (*NetAdapter, error) {
 		p2pServer: p2pServer,
 		rpcServer: rpcServer,
 
+		rpcAuthenticator: rpcAuthenticator,
+
 		p2pConnections: make(map[*NetConnection]struct{}),
 	}
 
+	if len(cfg.MetricsListeners) > 0 {
+		adapter.p2pTraffic = routerpkg.NewTraffic()
+	}
+
 	adapter.p2pServer.SetOnConnectedHandler(adapter.onP2PConnectedHandler)
 	adapter.rpcServer.SetOnConnectedHandler(adapter.onRPCConnectedHandler)
 
+	if len(cfg.RPCWebsocketListeners) > 0 {
+		adapter.rpcWebsocketServer, err = grpcserver.NewRPCWebsocketServer(cfg.RPCWebsocketListeners,
+			cfg.RPCMaxWebsockets, rpcTLSConfig, rpcAuthenticator, cfg.RPCWebsocketAllowedOrigins)
+		if err != nil {
+			return nil, err
+		}
+		adapter.rpcWebsocketServer.SetOnConnectedHandler(adapter.onRPCConnectedHandler)
+	}
+
 	return &adapter, nil
 }
 
@@ -82,6 +122,12 @@ func (na *NetAdapter) Start() error {
 	if err != nil {
 		return err
 	}
+	if na.rpcWebsocketServer != nil {
+		err = na.rpcWebsocketServer.Start()
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -95,6 +141,12 @@ func (na *NetAdapter) Stop() error {
 	if err != nil {
 		return err
 	}
+	if na.rpcWebsocketServer != nil {
+		err = na.rpcWebsocketServer.Stop()
+		if err != nil {
+			return err
+		}
+	}
 	return na.rpcServer.Stop()
 }
 
@@ -129,6 +181,7 @@ func (na *NetAdapter) P2PConnectionCount() int {
 
 func (na *NetAdapter) onP2PConnectedHandler(connection server.Connection) error {
 	netConnection := newNetConnection(connection, na.p2pRouterInitializer, "on P2P connected")
+	netConnection.router.SetTraffic(na.p2pTraffic)
 
 	na.p2pConnectionsLock.Lock()
 	defer na.p2pConnectionsLock.Unlock()
@@ -155,12 +208,22 @@ func (na *NetAdapter) onRPCConnectedHandler(connection server.Connection) error
 	return nil
 }
 
+// P2PTraffic returns the traffic of all the P2P connections, or nil if it isn't counted
+func (na *NetAdapter) P2PTraffic() *routerpkg.Traffic {
+	return na.p2pTraffic
+}
+
 // SetP2PRouterInitializer sets the p2pRouterInitializer function
 // for the net adapter
 func (na *NetAdapter) SetP2PRouterInitializer(routerInitializer RouterInitializer) {
 	na.p2pRouterInitializer = routerInitializer
 }
 
+// RPCAuthenticator returns the authenticator of RPC clients, or nil if RPC authentication is disabled
+func (na *NetAdapter) RPCAuthenticator() *rpcauth.Authenticator {
+	return na.rpcAuthenticator
+}
+
 // SetRPCRouterInitializer sets the rpcRouterInitializer function
 // for the net adapter
 func (na *NetAdapter) SetRPCRouterInitializer(routerInitializer RouterInitializer) {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/id"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcauth"
 )
 
 // NetConnection is a wrapper to a server connection for use by services external to NetAdapter
@@ -75,6 +76,12 @@ func (c *NetConnection) IsOutbound() bool {
 	return c.connection.IsOutbound()
 }
 
+// Identity returns the identity that an RPC client authenticated as,
+// or nil if RPC authentication is disabled
+func (c *NetConnection) Identity() *rpcauth.Identity {
+	return c.connection.Identity()
+}
+
 // NetAddress returns the NetAddress associated with this connection
 func (c *NetConnection) NetAddress() *appmessage.NetAddress {
 	return appmessage.NewNetAddress(c.connection.Address())
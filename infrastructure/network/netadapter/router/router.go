@@ -21,6 +21,9 @@ type Router struct {
 	incomingRoutesLock sync.RWMutex
 
 	outgoingRoute *Route
+
+	// traffic is nil if the traffic of this router isn't counted
+	traffic *Traffic
 }
 
 // NewRouter creates a new empty router
@@ -86,6 +89,17 @@ func (r *Router) EnqueueIncomingMessage(message appmessage.Message) error {
 	return route.Enqueue(message)
 }
 
+// SetTraffic sets the Traffic that the messages of this router are counted in
+func (r *Router) SetTraffic(traffic *Traffic) {
+	r.traffic = traffic
+}
+
+// Traffic returns the Traffic that the messages of this router are counted in,
+// or nil if they aren't counted
+func (r *Router) Traffic() *Traffic {
+	return r.traffic
+}
+
 // OutgoingRoute returns the outgoing route
 func (r *Router) OutgoingRoute() *Route {
 	return r.outgoingRoute
@@ -0,0 +1,73 @@
+package router
+
+import (
+	"sync"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+)
+
+// MessageTraffic is the number of messages of a single command, and their total size in bytes
+type MessageTraffic struct {
+	MessageCount uint64
+	ByteCount    uint64
+}
+
+// Traffic counts the messages that go through a set of routers, and their sizes in bytes, per
+// message command. The connections that own the routers report the sizes, since routers only
+// see decoded messages
+type Traffic struct {
+	incoming map[appmessage.MessageCommand]MessageTraffic
+	outgoing map[appmessage.MessageCommand]MessageTraffic
+	lock     sync.Mutex
+}
+
+// NewTraffic creates a new empty Traffic
+func NewTraffic() *Traffic {
+	return &Traffic{
+		incoming: make(map[appmessage.MessageCommand]MessageTraffic),
+		outgoing: make(map[appmessage.MessageCommand]MessageTraffic),
+	}
+}
+
+// AddIncoming counts a received message of the given command and size
+func (t *Traffic) AddIncoming(command appmessage.MessageCommand, byteCount int) {
+	t.add(t.incoming, command, byteCount)
+}
+
+// AddOutgoing counts a sent message of the given command and size
+func (t *Traffic) AddOutgoing(command appmessage.MessageCommand, byteCount int) {
+	t.add(t.outgoing, command, byteCount)
+}
+
+func (t *Traffic) add(traffic map[appmessage.MessageCommand]MessageTraffic,
+	command appmessage.MessageCommand, byteCount int) {
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	messageTraffic := traffic[command]
+	messageTraffic.MessageCount++
+	messageTraffic.ByteCount += uint64(byteCount)
+	traffic[command] = messageTraffic
+}
+
+// Incoming returns a copy of the traffic of the received messages
+func (t *Traffic) Incoming() map[appmessage.MessageCommand]MessageTraffic {
+	return t.copy(t.incoming)
+}
+
+// Outgoing returns a copy of the traffic of the sent messages
+func (t *Traffic) Outgoing() map[appmessage.MessageCommand]MessageTraffic {
+	return t.copy(t.outgoing)
+}
+
+func (t *Traffic) copy(traffic map[appmessage.MessageCommand]MessageTraffic) map[appmessage.MessageCommand]MessageTraffic {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	trafficCopy := make(map[appmessage.MessageCommand]MessageTraffic, len(traffic))
+	for command, messageTraffic := range traffic {
+		trafficCopy[command] = messageTraffic
+	}
+	return trafficCopy
+}
@@ -12,6 +12,7 @@ import (
 
 	routerpkg "github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server/grpcserver/protowire"
 )
@@ -71,6 +72,9 @@ func (c *gRPCConnection) sendLoop() error {
 		if err != nil {
 			return err
 		}
+		if traffic := c.router.Traffic(); traffic != nil {
+			traffic.AddOutgoing(message.Command(), proto.Size(messageProto))
+		}
 	}
 	return nil
 }
@@ -93,6 +97,10 @@ func (c *gRPCConnection) receiveLoop() error {
 			return err
 		}
 
+		if traffic := c.router.Traffic(); traffic != nil {
+			traffic.AddIncoming(message.Command(), proto.Size(protoMessage))
+		}
+
 		messageNumber++
 		message.SetMessageNumber(messageNumber)
 		message.SetReceivedAt(time.Now())
@@ -7,6 +7,7 @@ import (
 
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcauth"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -34,6 +35,9 @@ type gRPCConnection struct {
 	onInvalidMessageHandler server.OnInvalidMessageHandler
 
 	isConnected uint32
+
+	// identity is nil unless this is an RPC connection and RPC authentication is enabled
+	identity *rpcauth.Identity
 }
 
 type grpcStream interface {
@@ -127,6 +131,10 @@ func (c *gRPCConnection) Address() *net.TCPAddr {
 	return c.address
 }
 
+func (c *gRPCConnection) Identity() *rpcauth.Identity {
+	return c.identity
+}
+
 func (c *gRPCConnection) receive() (*protowire.CoinsecdMessage, error) {
 	// We use RLock here and in send() because they can work
 	// in parallel. closeSend(), however, must not have either
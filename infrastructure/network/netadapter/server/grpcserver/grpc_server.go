@@ -2,11 +2,14 @@ package grpcserver
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcauth"
 	"github.com/wombatlabs/coinsecd/util/panics"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
 	"net"
 	"sync"
@@ -24,11 +27,18 @@ type gRPCServer struct {
 	inboundConnectionCountLock *sync.Mutex
 }
 
-// newGRPCServer creates a gRPC server
-func newGRPCServer(listeningAddresses []string, maxMessageSize int, maxInboundConnections int, name string) *gRPCServer {
+// newGRPCServer creates a gRPC server. If tlsConfig is not nil, the server only accepts TLS connections
+func newGRPCServer(listeningAddresses []string, maxMessageSize int, maxInboundConnections int, name string,
+	tlsConfig *tls.Config, additionalServerOptions ...grpc.ServerOption) *gRPCServer {
+
 	log.Debugf("Created new %s GRPC server with maxMessageSize %d and maxInboundConnections %d", name, maxMessageSize, maxInboundConnections)
+	serverOptions := []grpc.ServerOption{grpc.MaxRecvMsgSize(maxMessageSize), grpc.MaxSendMsgSize(maxMessageSize)}
+	serverOptions = append(serverOptions, additionalServerOptions...)
+	if tlsConfig != nil {
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
 	return &gRPCServer{
-		server:                     grpc.NewServer(grpc.MaxRecvMsgSize(maxMessageSize), grpc.MaxSendMsgSize(maxMessageSize)),
+		server:                     grpc.NewServer(serverOptions...),
 		listeningAddresses:         listeningAddresses,
 		name:                       name,
 		maxInboundConnections:      maxInboundConnections,
@@ -110,6 +120,7 @@ func (s *gRPCServer) handleInboundConnection(ctx context.Context, stream grpcStr
 	}
 
 	connection := newConnection(s, tcpAddress, stream, nil)
+	connection.identity = rpcauth.IdentityFromContext(ctx)
 
 	err = s.onConnectedHandler(connection)
 	if err != nil {
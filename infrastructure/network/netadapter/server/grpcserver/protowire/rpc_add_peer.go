@@ -40,7 +40,7 @@ func (x *CoinsecdMessage_AddPeerResponse) toAppMessage() (appmessage.Message, er
 func (x *CoinsecdMessage_AddPeerResponse) fromAppMessage(message *appmessage.AddPeerResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.AddPeerResponse = &AddPeerResponseMessage{
 		Error: err,
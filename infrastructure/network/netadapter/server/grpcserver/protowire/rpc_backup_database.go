@@ -0,0 +1,65 @@
+package protowire
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CoinsecdMessage_BackupDatabaseRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CoinsecdMessage_BackupDatabaseRequest is nil")
+	}
+	return x.BackupDatabaseRequest.toAppMessage()
+}
+
+func (x *BackupDatabaseRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "BackupDatabaseRequestMessage is nil")
+	}
+	return &appmessage.BackupDatabaseRequestMessage{
+		TargetDirectory: x.TargetDirectory,
+	}, nil
+}
+
+func (x *CoinsecdMessage_BackupDatabaseRequest) fromAppMessage(message *appmessage.BackupDatabaseRequestMessage) error {
+	x.BackupDatabaseRequest = &BackupDatabaseRequestMessage{TargetDirectory: message.TargetDirectory}
+	return nil
+}
+
+func (x *CoinsecdMessage_BackupDatabaseResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CoinsecdMessage_BackupDatabaseResponse is nil")
+	}
+	return x.BackupDatabaseResponse.toAppMessage()
+}
+
+func (x *BackupDatabaseResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "BackupDatabaseResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.BackupDatabaseResponseMessage{
+		DatabaseVersion: x.DatabaseVersion,
+		ActivePrefix:    x.ActivePrefix,
+		EntryCount:      x.EntryCount,
+		Error:           rpcErr,
+	}, nil
+}
+
+func (x *CoinsecdMessage_BackupDatabaseResponse) fromAppMessage(message *appmessage.BackupDatabaseResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = rpcErrorFromAppMessage(message.Error)
+	}
+	x.BackupDatabaseResponse = &BackupDatabaseResponseMessage{
+		DatabaseVersion: message.DatabaseVersion,
+		ActivePrefix:    message.ActivePrefix,
+		EntryCount:      message.EntryCount,
+		Error:           err,
+	}
+	return nil
+}
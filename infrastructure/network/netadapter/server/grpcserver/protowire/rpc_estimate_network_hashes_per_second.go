@@ -40,7 +40,7 @@ func (x *CoinsecdMessage_EstimateNetworkHashesPerSecondResponse) toAppMessage()
 func (x *CoinsecdMessage_EstimateNetworkHashesPerSecondResponse) fromAppMessage(message *appmessage.EstimateNetworkHashesPerSecondResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.EstimateNetworkHashesPerSecondResponse = &EstimateNetworkHashesPerSecondResponseMessage{
 		NetworkHashesPerSecond: message.NetworkHashesPerSecond,
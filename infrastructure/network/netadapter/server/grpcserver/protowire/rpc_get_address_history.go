@@ -0,0 +1,111 @@
+package protowire
+
+import (
+	"github.com/pkg/errors"
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+)
+
+func (x *CoinsecdMessage_GetAddressHistoryRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CoinsecdMessage_GetAddressHistoryRequest is nil")
+	}
+	return x.GetAddressHistoryRequest.toAppMessage()
+}
+
+func (x *CoinsecdMessage_GetAddressHistoryRequest) fromAppMessage(message *appmessage.GetAddressHistoryRequestMessage) error {
+	x.GetAddressHistoryRequest = &GetAddressHistoryRequestMessage{
+		Address: message.Address,
+		Cursor:  message.Cursor,
+		Limit:   message.Limit,
+	}
+	return nil
+}
+
+func (x *GetAddressHistoryRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAddressHistoryRequestMessage is nil")
+	}
+	return &appmessage.GetAddressHistoryRequestMessage{
+		Address: x.Address,
+		Cursor:  x.Cursor,
+		Limit:   x.Limit,
+	}, nil
+}
+
+func (x *CoinsecdMessage_GetAddressHistoryResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CoinsecdMessage_GetAddressHistoryResponse is nil")
+	}
+	return x.GetAddressHistoryResponse.toAppMessage()
+}
+
+func (x *CoinsecdMessage_GetAddressHistoryResponse) fromAppMessage(message *appmessage.GetAddressHistoryResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = rpcErrorFromAppMessage(message.Error)
+	}
+	entries := make([]*RpcAddressHistoryEntry, len(message.Entries))
+	for i, entry := range message.Entries {
+		entries[i] = &RpcAddressHistoryEntry{}
+		entries[i].fromAppMessage(entry)
+	}
+	x.GetAddressHistoryResponse = &GetAddressHistoryResponseMessage{
+		Entries:    entries,
+		NextCursor: message.NextCursor,
+		Error:      err,
+	}
+	return nil
+}
+
+func (x *GetAddressHistoryResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetAddressHistoryResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && len(x.Entries) != 0 {
+		return nil, errors.New("GetAddressHistoryResponseMessage contains both an error and a response")
+	}
+
+	entries := make([]*appmessage.AddressHistoryEntry, len(x.Entries))
+	for i, entry := range x.Entries {
+		entryAsAppMessage, err := entry.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = entryAsAppMessage
+	}
+
+	return &appmessage.GetAddressHistoryResponseMessage{
+		Entries:    entries,
+		NextCursor: x.NextCursor,
+		Error:      rpcErr,
+	}, nil
+}
+
+func (x *RpcAddressHistoryEntry) toAppMessage() (*appmessage.AddressHistoryEntry, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcAddressHistoryEntry is nil")
+	}
+	return &appmessage.AddressHistoryEntry{
+		TransactionID:          x.TransactionId,
+		AcceptingBlockHash:     x.AcceptingBlockHash,
+		AcceptingBlockDAAScore: x.AcceptingBlockDaaScore,
+		IsPayingToAddress:      x.IsPayingToAddress,
+		IsSpendingFromAddress:  x.IsSpendingFromAddress,
+	}, nil
+}
+
+func (x *RpcAddressHistoryEntry) fromAppMessage(message *appmessage.AddressHistoryEntry) {
+	*x = RpcAddressHistoryEntry{
+		TransactionId:          message.TransactionID,
+		AcceptingBlockHash:     message.AcceptingBlockHash,
+		AcceptingBlockDaaScore: message.AcceptingBlockDAAScore,
+		IsPayingToAddress:      message.IsPayingToAddress,
+		IsSpendingFromAddress:  message.IsSpendingFromAddress,
+	}
+}
@@ -38,7 +38,7 @@ func (x *CoinsecdMessage_GetBalanceByAddressResponse) toAppMessage() (appmessage
 func (x *CoinsecdMessage_GetBalanceByAddressResponse) fromAppMessage(message *appmessage.GetBalanceByAddressResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.GetBalanceByAddressResponse = &GetBalanceByAddressResponseMessage{
 		Balance: message.Balance,
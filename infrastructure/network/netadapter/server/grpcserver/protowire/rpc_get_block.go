@@ -66,7 +66,7 @@ func (x *GetBlockResponseMessage) toAppMessage() (appmessage.Message, error) {
 func (x *CoinsecdMessage_GetBlockResponse) fromAppMessage(message *appmessage.GetBlockResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	var block *RpcBlock
 	if message.Block != nil {
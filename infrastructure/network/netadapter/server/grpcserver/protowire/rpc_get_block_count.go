@@ -27,7 +27,7 @@ func (x *CoinsecdMessage_GetBlockCountResponse) toAppMessage() (appmessage.Messa
 func (x *CoinsecdMessage_GetBlockCountResponse) fromAppMessage(message *appmessage.GetBlockCountResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.GetBlockCountResponse = &GetBlockCountResponseMessage{
 		BlockCount:  message.BlockCount,
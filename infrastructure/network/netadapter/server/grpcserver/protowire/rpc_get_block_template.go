@@ -40,7 +40,7 @@ func (x *CoinsecdMessage_GetBlockTemplateResponse) toAppMessage() (appmessage.Me
 func (x *CoinsecdMessage_GetBlockTemplateResponse) fromAppMessage(message *appmessage.GetBlockTemplateResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 
 	var block *RpcBlock
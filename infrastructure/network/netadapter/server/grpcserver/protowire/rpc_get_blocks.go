@@ -42,7 +42,7 @@ func (x *CoinsecdMessage_GetBlocksResponse) toAppMessage() (appmessage.Message,
 func (x *CoinsecdMessage_GetBlocksResponse) fromAppMessage(message *appmessage.GetBlocksResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.GetBlocksResponse = &GetBlocksResponseMessage{
 		Error: err,
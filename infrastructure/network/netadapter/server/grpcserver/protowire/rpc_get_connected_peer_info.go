@@ -23,7 +23,7 @@ func (x *CoinsecdMessage_GetConnectedPeerInfoResponse) toAppMessage() (appmessag
 func (x *CoinsecdMessage_GetConnectedPeerInfoResponse) fromAppMessage(message *appmessage.GetConnectedPeerInfoResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	infos := make([]*GetConnectedPeerInfoMessage, len(message.Infos))
 	for i, info := range message.Infos {
@@ -37,6 +37,7 @@ func (x *CoinsecdMessage_GetConnectedPeerInfoResponse) fromAppMessage(message *a
 			AdvertisedProtocolVersion: info.AdvertisedProtocolVersion,
 			TimeConnected:             info.TimeConnected,
 			IsIbdPeer:                 info.IsIBDPeer,
+			MisbehaviorScore:          info.MisbehaviorScore,
 		}
 	}
 	x.GetConnectedPeerInfoResponse = &GetConnectedPeerInfoResponseMessage{
@@ -88,5 +89,6 @@ func (x *GetConnectedPeerInfoMessage) toAppMessage() (*appmessage.GetConnectedPe
 		AdvertisedProtocolVersion: x.AdvertisedProtocolVersion,
 		TimeConnected:             x.TimeOffset,
 		IsIBDPeer:                 x.IsIbdPeer,
+		MisbehaviorScore:          x.MisbehaviorScore,
 	}, nil
 }
@@ -17,13 +17,13 @@ func (x *CoinsecdMessage_GetCurrentNetworkResponse) toAppMessage() (appmessage.M
 	if x == nil {
 		return nil, errors.Wrapf(errorNil, "CoinsecdMessage_GetCurrentNetworkResponse is nil")
 	}
-	return x.toAppMessage()
+	return x.GetCurrentNetworkResponse.toAppMessage()
 }
 
 func (x *CoinsecdMessage_GetCurrentNetworkResponse) fromAppMessage(message *appmessage.GetCurrentNetworkResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.GetCurrentNetworkResponse = &GetCurrentNetworkResponseMessage{
 		CurrentNetwork: message.CurrentNetwork,
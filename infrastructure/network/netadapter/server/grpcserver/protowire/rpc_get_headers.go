@@ -42,7 +42,7 @@ func (x *CoinsecdMessage_GetHeadersResponse) toAppMessage() (appmessage.Message,
 func (x *CoinsecdMessage_GetHeadersResponse) fromAppMessage(message *appmessage.GetHeadersResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.GetHeadersResponse = &GetHeadersResponseMessage{
 		Headers: message.Headers,
@@ -24,7 +24,7 @@ func (x *CoinsecdMessage_GetInfoResponse) toAppMessage() (appmessage.Message, er
 func (x *CoinsecdMessage_GetInfoResponse) fromAppMessage(message *appmessage.GetInfoResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.GetInfoResponse = &GetInfoResponseMessage{
 		P2PId:         message.P2PID,
@@ -40,7 +40,7 @@ func (x *CoinsecdMessage_GetMempoolEntriesResponse) toAppMessage() (appmessage.M
 func (x *CoinsecdMessage_GetMempoolEntriesResponse) fromAppMessage(message *appmessage.GetMempoolEntriesResponseMessage) error {
 	var rpcErr *RPCError
 	if message.Error != nil {
-		rpcErr = &RPCError{Message: message.Error.Message}
+		rpcErr = rpcErrorFromAppMessage(message.Error)
 	}
 	entries := make([]*MempoolEntry, len(message.Entries))
 	for i, entry := range message.Entries {
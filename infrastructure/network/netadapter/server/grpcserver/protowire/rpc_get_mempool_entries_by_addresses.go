@@ -42,7 +42,7 @@ func (x *CoinsecdMessage_GetMempoolEntriesByAddressesResponse) toAppMessage() (a
 func (x *CoinsecdMessage_GetMempoolEntriesByAddressesResponse) fromAppMessage(message *appmessage.GetMempoolEntriesByAddressesResponseMessage) error {
 	var rpcErr *RPCError
 	if message.Error != nil {
-		rpcErr = &RPCError{Message: message.Error.Message}
+		rpcErr = rpcErrorFromAppMessage(message.Error)
 	}
 	entries := make([]*MempoolEntryByAddress, len(message.Entries))
 	for i, entry := range message.Entries {
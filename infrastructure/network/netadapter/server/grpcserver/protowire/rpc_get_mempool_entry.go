@@ -42,7 +42,7 @@ func (x *CoinsecdMessage_GetMempoolEntryResponse) toAppMessage() (appmessage.Mes
 func (x *CoinsecdMessage_GetMempoolEntryResponse) fromAppMessage(message *appmessage.GetMempoolEntryResponseMessage) error {
 	var rpcErr *RPCError
 	if message.Error != nil {
-		rpcErr = &RPCError{Message: message.Error.Message}
+		rpcErr = rpcErrorFromAppMessage(message.Error)
 	}
 	var entry *MempoolEntry
 	if message.Entry != nil {
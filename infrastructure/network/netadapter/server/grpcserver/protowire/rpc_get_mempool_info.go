@@ -0,0 +1,88 @@
+package protowire
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CoinsecdMessage_GetMempoolInfoRequest) toAppMessage() (appmessage.Message, error) {
+	return &appmessage.GetMempoolInfoRequestMessage{}, nil
+}
+
+func (x *CoinsecdMessage_GetMempoolInfoRequest) fromAppMessage(_ *appmessage.GetMempoolInfoRequestMessage) error {
+	x.GetMempoolInfoRequest = &GetMempoolInfoRequestMessage{}
+	return nil
+}
+
+func (x *CoinsecdMessage_GetMempoolInfoResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CoinsecdMessage_GetMempoolInfoResponse is nil")
+	}
+	return x.GetMempoolInfoResponse.toAppMessage()
+}
+
+func (x *CoinsecdMessage_GetMempoolInfoResponse) fromAppMessage(message *appmessage.GetMempoolInfoResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = rpcErrorFromAppMessage(message.Error)
+	}
+	feeRateHistogram := make([]*RpcFeeRateHistogramBucket, len(message.FeeRateHistogram))
+	for i, bucket := range message.FeeRateHistogram {
+		feeRateHistogram[i] = &RpcFeeRateHistogramBucket{}
+		feeRateHistogram[i].fromAppMessage(bucket)
+	}
+	x.GetMempoolInfoResponse = &GetMempoolInfoResponseMessage{
+		TransactionCount: message.TransactionCount,
+		OrphanCount:      message.OrphanCount,
+		TotalMass:        message.TotalMass,
+		MinimumFeeRate:   message.MinimumFeeRate,
+		FeeRateHistogram: feeRateHistogram,
+		Error:            err,
+	}
+	return nil
+}
+
+func (x *GetMempoolInfoResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetMempoolInfoResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	feeRateHistogram := make([]*appmessage.RPCFeeRateHistogramBucket, len(x.FeeRateHistogram))
+	for i, bucket := range x.FeeRateHistogram {
+		feeRateHistogram[i], err = bucket.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appmessage.GetMempoolInfoResponseMessage{
+		TransactionCount: x.TransactionCount,
+		OrphanCount:      x.OrphanCount,
+		TotalMass:        x.TotalMass,
+		MinimumFeeRate:   x.MinimumFeeRate,
+		FeeRateHistogram: feeRateHistogram,
+		Error:            rpcErr,
+	}, nil
+}
+
+func (x *RpcFeeRateHistogramBucket) toAppMessage() (*appmessage.RPCFeeRateHistogramBucket, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcFeeRateHistogramBucket is nil")
+	}
+	return &appmessage.RPCFeeRateHistogramBucket{
+		MinimumFeeRate:   x.MinimumFeeRate,
+		TransactionCount: x.TransactionCount,
+		TotalMass:        x.TotalMass,
+	}, nil
+}
+
+func (x *RpcFeeRateHistogramBucket) fromAppMessage(message *appmessage.RPCFeeRateHistogramBucket) {
+	x.MinimumFeeRate = message.MinimumFeeRate
+	x.TransactionCount = message.TransactionCount
+	x.TotalMass = message.TotalMass
+}
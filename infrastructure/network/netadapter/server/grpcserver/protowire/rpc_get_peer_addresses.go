@@ -26,7 +26,7 @@ func (x *CoinsecdMessage_GetPeerAddressesResponse) toAppMessage() (appmessage.Me
 func (x *CoinsecdMessage_GetPeerAddressesResponse) fromAppMessage(message *appmessage.GetPeerAddressesResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	addresses := make([]*GetPeerAddressesKnownAddressMessage, len(message.Addresses))
 	for i, address := range message.Addresses {
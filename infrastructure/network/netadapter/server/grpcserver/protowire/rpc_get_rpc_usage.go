@@ -0,0 +1,92 @@
+package protowire
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CoinsecdMessage_GetRpcUsageRequest) toAppMessage() (appmessage.Message, error) {
+	return &appmessage.GetRPCUsageRequestMessage{}, nil
+}
+
+func (x *CoinsecdMessage_GetRpcUsageRequest) fromAppMessage(_ *appmessage.GetRPCUsageRequestMessage) error {
+	x.GetRpcUsageRequest = &GetRpcUsageRequestMessage{}
+	return nil
+}
+
+func (x *CoinsecdMessage_GetRpcUsageResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CoinsecdMessage_GetRpcUsageResponse is nil")
+	}
+	return x.GetRpcUsageResponse.toAppMessage()
+}
+
+func (x *CoinsecdMessage_GetRpcUsageResponse) fromAppMessage(message *appmessage.GetRPCUsageResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = rpcErrorFromAppMessage(message.Error)
+	}
+	clients := make([]*RpcClientUsage, len(message.Clients))
+	for i, client := range message.Clients {
+		clients[i] = &RpcClientUsage{}
+		clients[i].fromAppMessage(client)
+	}
+	x.GetRpcUsageResponse = &GetRpcUsageResponseMessage{
+		IsRateLimitEnabled: message.IsRateLimitEnabled,
+		RatePerSecond:      message.RatePerSecond,
+		Burst:              message.Burst,
+		Clients:            clients,
+		Error:              err,
+	}
+	return nil
+}
+
+func (x *GetRpcUsageResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetRpcUsageResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	clients := make([]*appmessage.RPCClientUsage, len(x.Clients))
+	for i, client := range x.Clients {
+		clients[i], err = client.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appmessage.GetRPCUsageResponseMessage{
+		IsRateLimitEnabled: x.IsRateLimitEnabled,
+		RatePerSecond:      x.RatePerSecond,
+		Burst:              x.Burst,
+		Clients:            clients,
+		Error:              rpcErr,
+	}, nil
+}
+
+func (x *RpcClientUsage) toAppMessage() (*appmessage.RPCClientUsage, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcClientUsage is nil")
+	}
+	return &appmessage.RPCClientUsage{
+		Client:               x.Client,
+		AvailableWeight:      x.AvailableWeight,
+		RequestCount:         x.RequestCount,
+		RejectedRequestCount: x.RejectedRequestCount,
+		ConsumedWeight:       x.ConsumedWeight,
+		LastRequestTimestamp: x.LastRequestTimestamp,
+	}, nil
+}
+
+func (x *RpcClientUsage) fromAppMessage(message *appmessage.RPCClientUsage) {
+	x.Client = message.Client
+	x.AvailableWeight = message.AvailableWeight
+	x.RequestCount = message.RequestCount
+	x.RejectedRequestCount = message.RejectedRequestCount
+	x.ConsumedWeight = message.ConsumedWeight
+	x.LastRequestTimestamp = message.LastRequestTimestamp
+}
@@ -23,7 +23,7 @@ func (x *CoinsecdMessage_GetSelectedTipHashResponse) toAppMessage() (appmessage.
 func (x *CoinsecdMessage_GetSelectedTipHashResponse) fromAppMessage(message *appmessage.GetSelectedTipHashResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.GetSelectedTipHashResponse = &GetSelectedTipHashResponseMessage{
 		SelectedTipHash: message.SelectedTipHash,
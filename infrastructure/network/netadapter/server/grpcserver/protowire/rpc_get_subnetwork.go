@@ -38,7 +38,7 @@ func (x *CoinsecdMessage_GetSubnetworkResponse) toAppMessage() (appmessage.Messa
 func (x *CoinsecdMessage_GetSubnetworkResponse) fromAppMessage(message *appmessage.GetSubnetworkResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.GetSubnetworkResponse = &GetSubnetworkResponseMessage{
 		GasLimit: message.GasLimit,
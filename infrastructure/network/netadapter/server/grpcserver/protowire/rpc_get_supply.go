@@ -24,7 +24,7 @@ func (x *CoinsecdMessage_GetCoinSupplyResponse) toAppMessage() (appmessage.Messa
 func (x *CoinsecdMessage_GetCoinSupplyResponse) fromAppMessage(message *appmessage.GetCoinSupplyResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.GetCoinSupplyResponse = &GetCoinSupplyResponseMessage{
 		MaxSompi:         message.MaxSompi,
@@ -0,0 +1,84 @@
+package protowire
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CoinsecdMessage_GetTransactionRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CoinsecdMessage_GetTransactionRequest is nil")
+	}
+	return x.GetTransactionRequest.toAppMessage()
+}
+
+func (x *CoinsecdMessage_GetTransactionRequest) fromAppMessage(message *appmessage.GetTransactionRequestMessage) error {
+	x.GetTransactionRequest = &GetTransactionRequestMessage{
+		TransactionId: message.TransactionID,
+	}
+	return nil
+}
+
+func (x *GetTransactionRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionRequestMessage is nil")
+	}
+	return &appmessage.GetTransactionRequestMessage{
+		TransactionID: x.TransactionId,
+	}, nil
+}
+
+func (x *CoinsecdMessage_GetTransactionResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CoinsecdMessage_GetTransactionResponse is nil")
+	}
+	return x.GetTransactionResponse.toAppMessage()
+}
+
+func (x *CoinsecdMessage_GetTransactionResponse) fromAppMessage(message *appmessage.GetTransactionResponseMessage) error {
+	var rpcErr *RPCError
+	if message.Error != nil {
+		rpcErr = rpcErrorFromAppMessage(message.Error)
+	}
+	var transaction *RpcTransaction
+	if message.Transaction != nil {
+		transaction = new(RpcTransaction)
+		transaction.fromAppMessage(message.Transaction)
+	}
+	x.GetTransactionResponse = &GetTransactionResponseMessage{
+		Transaction:          transaction,
+		IsInMempool:          message.IsInMempool,
+		AcceptingBlockHash:   message.AcceptingBlockHash,
+		IncludingBlockHashes: message.IncludingBlockHashes,
+		Error:                rpcErr,
+	}
+	return nil
+}
+
+func (x *GetTransactionResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	transaction, err := x.Transaction.toAppMessage()
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && transaction != nil {
+		return nil, errors.New("GetTransactionResponseMessage contains both an error and a response")
+	}
+
+	return &appmessage.GetTransactionResponseMessage{
+		Transaction:          transaction,
+		IsInMempool:          x.IsInMempool,
+		AcceptingBlockHash:   x.AcceptingBlockHash,
+		IncludingBlockHashes: x.IncludingBlockHashes,
+		Error:                rpcErr,
+	}, nil
+}
@@ -38,7 +38,7 @@ func (x *CoinsecdMessage_GetUtxosByAddressesResponse) toAppMessage() (appmessage
 func (x *CoinsecdMessage_GetUtxosByAddressesResponse) fromAppMessage(message *appmessage.GetUTXOsByAddressesResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	entries := make([]*UtxosByAddressesEntry, len(message.Entries))
 	for i, entry := range message.Entries {
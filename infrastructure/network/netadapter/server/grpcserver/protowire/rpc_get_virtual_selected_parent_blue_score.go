@@ -27,7 +27,7 @@ func (x *CoinsecdMessage_GetVirtualSelectedParentBlueScoreResponse) toAppMessage
 func (x *CoinsecdMessage_GetVirtualSelectedParentBlueScoreResponse) fromAppMessage(message *appmessage.GetVirtualSelectedParentBlueScoreResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.GetVirtualSelectedParentBlueScoreResponse = &GetVirtualSelectedParentBlueScoreResponseMessage{
 		BlueScore: message.BlueScore,
@@ -40,7 +40,7 @@ func (x *CoinsecdMessage_GetVirtualSelectedParentChainFromBlockResponse) toAppMe
 func (x *CoinsecdMessage_GetVirtualSelectedParentChainFromBlockResponse) fromAppMessage(message *appmessage.GetVirtualSelectedParentChainFromBlockResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.GetVirtualSelectedParentChainFromBlockResponse = &GetVirtualSelectedParentChainFromBlockResponseMessage{
 		RemovedChainBlockHashes: message.RemovedChainBlockHashes,
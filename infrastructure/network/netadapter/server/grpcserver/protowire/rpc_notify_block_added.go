@@ -24,7 +24,7 @@ func (x *CoinsecdMessage_NotifyBlockAddedResponse) toAppMessage() (appmessage.Me
 func (x *CoinsecdMessage_NotifyBlockAddedResponse) fromAppMessage(message *appmessage.NotifyBlockAddedResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.NotifyBlockAddedResponse = &NotifyBlockAddedResponseMessage{
 		Error: err,
@@ -24,7 +24,7 @@ func (x *CoinsecdMessage_NotifyFinalityConflictsResponse) toAppMessage() (appmes
 func (x *CoinsecdMessage_NotifyFinalityConflictsResponse) fromAppMessage(message *appmessage.NotifyFinalityConflictsResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.NotifyFinalityConflictsResponse = &NotifyFinalityConflictsResponseMessage{
 		Error: err,
@@ -0,0 +1,123 @@
+package protowire
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CoinsecdMessage_NotifyMempoolChangedRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CoinsecdMessage_NotifyMempoolChangedRequest is nil")
+	}
+	return x.NotifyMempoolChangedRequest.toAppMessage()
+}
+
+func (x *CoinsecdMessage_NotifyMempoolChangedRequest) fromAppMessage(message *appmessage.NotifyMempoolChangedRequestMessage) error {
+	x.NotifyMempoolChangedRequest = &NotifyMempoolChangedRequestMessage{
+		Addresses: message.Addresses,
+	}
+	return nil
+}
+
+func (x *NotifyMempoolChangedRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NotifyMempoolChangedRequestMessage is nil")
+	}
+	return &appmessage.NotifyMempoolChangedRequestMessage{
+		Addresses: x.Addresses,
+	}, nil
+}
+
+func (x *CoinsecdMessage_NotifyMempoolChangedResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CoinsecdMessage_NotifyMempoolChangedResponse is nil")
+	}
+	return x.NotifyMempoolChangedResponse.toAppMessage()
+}
+
+func (x *CoinsecdMessage_NotifyMempoolChangedResponse) fromAppMessage(message *appmessage.NotifyMempoolChangedResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = rpcErrorFromAppMessage(message.Error)
+	}
+	x.NotifyMempoolChangedResponse = &NotifyMempoolChangedResponseMessage{
+		Error: err,
+	}
+	return nil
+}
+
+func (x *NotifyMempoolChangedResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NotifyMempoolChangedResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.NotifyMempoolChangedResponseMessage{
+		Error: rpcErr,
+	}, nil
+}
+
+func (x *CoinsecdMessage_MempoolChangedNotification) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CoinsecdMessage_MempoolChangedNotification is nil")
+	}
+	return x.MempoolChangedNotification.toAppMessage()
+}
+
+func (x *CoinsecdMessage_MempoolChangedNotification) fromAppMessage(message *appmessage.MempoolChangedNotificationMessage) error {
+	changes := make([]*RpcMempoolChange, len(message.Changes))
+	for i, change := range message.Changes {
+		changes[i] = &RpcMempoolChange{}
+		changes[i].fromAppMessage(change)
+	}
+	x.MempoolChangedNotification = &MempoolChangedNotificationMessage{
+		Changes: changes,
+	}
+	return nil
+}
+
+func (x *MempoolChangedNotificationMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "MempoolChangedNotificationMessage is nil")
+	}
+	changes := make([]*appmessage.MempoolChange, len(x.Changes))
+	for i, change := range x.Changes {
+		changeAsAppMessage, err := change.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		changes[i] = changeAsAppMessage
+	}
+	return &appmessage.MempoolChangedNotificationMessage{
+		Changes: changes,
+	}, nil
+}
+
+func (x *RpcMempoolChange) toAppMessage() (*appmessage.MempoolChange, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcMempoolChange is nil")
+	}
+	transaction, err := x.Transaction.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.MempoolChange{
+		TransactionID: x.TransactionId,
+		Transaction:   transaction,
+		Reason:        appmessage.MempoolChangeReason(x.Reason),
+	}, nil
+}
+
+func (x *RpcMempoolChange) fromAppMessage(message *appmessage.MempoolChange) {
+	var transaction *RpcTransaction
+	if message.Transaction != nil {
+		transaction = &RpcTransaction{}
+		transaction.fromAppMessage(message.Transaction)
+	}
+	x.TransactionId = message.TransactionID
+	x.Transaction = transaction
+	x.Reason = RpcMempoolChange_Reason(message.Reason)
+}
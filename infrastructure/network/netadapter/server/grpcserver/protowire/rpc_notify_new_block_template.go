@@ -24,7 +24,7 @@ func (x *CoinsecdMessage_NotifyNewBlockTemplateResponse) toAppMessage() (appmess
 func (x *CoinsecdMessage_NotifyNewBlockTemplateResponse) fromAppMessage(message *appmessage.NotifyNewBlockTemplateResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.NotifyNewBlockTemplateResponse = &NotifyNewBlockTemplateResponseMessage{
 		Error: err,
@@ -27,7 +27,7 @@ func (x *CoinsecdMessage_NotifyPruningPointUTXOSetOverrideResponse) toAppMessage
 func (x *CoinsecdMessage_NotifyPruningPointUTXOSetOverrideResponse) fromAppMessage(message *appmessage.NotifyPruningPointUTXOSetOverrideResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.NotifyPruningPointUTXOSetOverrideResponse = &NotifyPruningPointUTXOSetOverrideResponseMessage{
 		Error: err,
@@ -85,7 +85,7 @@ func (x *CoinsecdMessage_StopNotifyingPruningPointUTXOSetOverrideResponse) fromA
 
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.StopNotifyingPruningPointUTXOSetOverrideResponse = &StopNotifyingPruningPointUTXOSetOverrideResponseMessage{
 		Error: err,
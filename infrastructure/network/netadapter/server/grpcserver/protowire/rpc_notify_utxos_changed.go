@@ -14,7 +14,9 @@ func (x *CoinsecdMessage_NotifyUtxosChangedRequest) toAppMessage() (appmessage.M
 
 func (x *CoinsecdMessage_NotifyUtxosChangedRequest) fromAppMessage(message *appmessage.NotifyUTXOsChangedRequestMessage) error {
 	x.NotifyUtxosChangedRequest = &NotifyUtxosChangedRequestMessage{
-		Addresses: message.Addresses,
+		Addresses:           message.Addresses,
+		Resume:              message.Resume,
+		ResumeAfterSequence: message.ResumeAfterSequence,
 	}
 	return nil
 }
@@ -24,7 +26,9 @@ func (x *NotifyUtxosChangedRequestMessage) toAppMessage() (appmessage.Message, e
 		return nil, errors.Wrapf(errorNil, "NotifyUtxosChangedRequestMessage is nil")
 	}
 	return &appmessage.NotifyUTXOsChangedRequestMessage{
-		Addresses: x.Addresses,
+		Addresses:           x.Addresses,
+		Resume:              x.Resume,
+		ResumeAfterSequence: x.ResumeAfterSequence,
 	}, nil
 }
 
@@ -38,10 +42,18 @@ func (x *CoinsecdMessage_NotifyUtxosChangedResponse) toAppMessage() (appmessage.
 func (x *CoinsecdMessage_NotifyUtxosChangedResponse) fromAppMessage(message *appmessage.NotifyUTXOsChangedResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
+	}
+	missedNotifications := make([]*UtxosChangedNotificationMessage, len(message.MissedNotifications))
+	for i, notification := range message.MissedNotifications {
+		missedNotifications[i] = &UtxosChangedNotificationMessage{}
+		missedNotifications[i].fromAppMessage(notification)
 	}
 	x.NotifyUtxosChangedResponse = &NotifyUtxosChangedResponseMessage{
-		Error: err,
+		Sequence:            message.Sequence,
+		SequenceGap:         message.SequenceGap,
+		MissedNotifications: missedNotifications,
+		Error:               err,
 	}
 	return nil
 }
@@ -55,8 +67,18 @@ func (x *NotifyUtxosChangedResponseMessage) toAppMessage() (appmessage.Message,
 	if err != nil && !errors.Is(err, errorNil) {
 		return nil, err
 	}
+	missedNotifications := make([]*appmessage.UTXOsChangedNotificationMessage, len(x.MissedNotifications))
+	for i, notification := range x.MissedNotifications {
+		missedNotifications[i], err = notification.toAppNotification()
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &appmessage.NotifyUTXOsChangedResponseMessage{
-		Error: rpcErr,
+		Sequence:            x.Sequence,
+		SequenceGap:         x.SequenceGap,
+		MissedNotifications: missedNotifications,
+		Error:               rpcErr,
 	}, nil
 }
 
@@ -68,6 +90,12 @@ func (x *CoinsecdMessage_UtxosChangedNotification) toAppMessage() (appmessage.Me
 }
 
 func (x *CoinsecdMessage_UtxosChangedNotification) fromAppMessage(message *appmessage.UTXOsChangedNotificationMessage) error {
+	x.UtxosChangedNotification = &UtxosChangedNotificationMessage{}
+	x.UtxosChangedNotification.fromAppMessage(message)
+	return nil
+}
+
+func (x *UtxosChangedNotificationMessage) fromAppMessage(message *appmessage.UTXOsChangedNotificationMessage) {
 	added := make([]*UtxosByAddressesEntry, len(message.Added))
 	for i, entry := range message.Added {
 		added[i] = &UtxosByAddressesEntry{}
@@ -80,14 +108,18 @@ func (x *CoinsecdMessage_UtxosChangedNotification) fromAppMessage(message *appme
 		removed[i].fromAppMessage(entry)
 	}
 
-	x.UtxosChangedNotification = &UtxosChangedNotificationMessage{
-		Added:   added,
-		Removed: removed,
+	*x = UtxosChangedNotificationMessage{
+		Added:    added,
+		Removed:  removed,
+		Sequence: message.Sequence,
 	}
-	return nil
 }
 
 func (x *UtxosChangedNotificationMessage) toAppMessage() (appmessage.Message, error) {
+	return x.toAppNotification()
+}
+
+func (x *UtxosChangedNotificationMessage) toAppNotification() (*appmessage.UTXOsChangedNotificationMessage, error) {
 	if x == nil {
 		return nil, errors.Wrapf(errorNil, "UtxosChangedNotificationMessage is nil")
 	}
@@ -114,8 +146,9 @@ func (x *UtxosChangedNotificationMessage) toAppMessage() (appmessage.Message, er
 	}
 
 	return &appmessage.UTXOsChangedNotificationMessage{
-		Added:   added,
-		Removed: removed,
+		Added:    added,
+		Removed:  removed,
+		Sequence: x.Sequence,
 	}, nil
 }
 
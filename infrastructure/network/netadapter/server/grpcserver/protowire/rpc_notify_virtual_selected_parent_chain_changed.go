@@ -11,12 +11,16 @@ func (x *CoinsecdMessage_NotifyVirtualSelectedParentChainChangedRequest) toAppMe
 	}
 	return &appmessage.NotifyVirtualSelectedParentChainChangedRequestMessage{
 		IncludeAcceptedTransactionIDs: x.NotifyVirtualSelectedParentChainChangedRequest.IncludeAcceptedTransactionIds,
+		Resume:                        x.NotifyVirtualSelectedParentChainChangedRequest.Resume,
+		ResumeAfterSequence:           x.NotifyVirtualSelectedParentChainChangedRequest.ResumeAfterSequence,
 	}, nil
 }
 
 func (x *CoinsecdMessage_NotifyVirtualSelectedParentChainChangedRequest) fromAppMessage(appmessage *appmessage.NotifyVirtualSelectedParentChainChangedRequestMessage) error {
 	x.NotifyVirtualSelectedParentChainChangedRequest = &NotifyVirtualSelectedParentChainChangedRequestMessage{
 		IncludeAcceptedTransactionIds: appmessage.IncludeAcceptedTransactionIDs,
+		Resume:                        appmessage.Resume,
+		ResumeAfterSequence:           appmessage.ResumeAfterSequence,
 	}
 	return nil
 }
@@ -31,10 +35,18 @@ func (x *CoinsecdMessage_NotifyVirtualSelectedParentChainChangedResponse) toAppM
 func (x *CoinsecdMessage_NotifyVirtualSelectedParentChainChangedResponse) fromAppMessage(message *appmessage.NotifyVirtualSelectedParentChainChangedResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
+	}
+	missedNotifications := make([]*VirtualSelectedParentChainChangedNotificationMessage, len(message.MissedNotifications))
+	for i, notification := range message.MissedNotifications {
+		missedNotifications[i] = &VirtualSelectedParentChainChangedNotificationMessage{}
+		missedNotifications[i].fromAppMessage(notification)
 	}
 	x.NotifyVirtualSelectedParentChainChangedResponse = &NotifyVirtualSelectedParentChainChangedResponseMessage{
-		Error: err,
+		Sequence:            message.Sequence,
+		SequenceGap:         message.SequenceGap,
+		MissedNotifications: missedNotifications,
+		Error:               err,
 	}
 	return nil
 }
@@ -48,8 +60,18 @@ func (x *NotifyVirtualSelectedParentChainChangedResponseMessage) toAppMessage()
 	if err != nil && !errors.Is(err, errorNil) {
 		return nil, err
 	}
+	missedNotifications := make([]*appmessage.VirtualSelectedParentChainChangedNotificationMessage, len(x.MissedNotifications))
+	for i, notification := range x.MissedNotifications {
+		missedNotifications[i], err = notification.toAppNotification()
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &appmessage.NotifyVirtualSelectedParentChainChangedResponseMessage{
-		Error: rpcErr,
+		Sequence:            x.Sequence,
+		SequenceGap:         x.SequenceGap,
+		MissedNotifications: missedNotifications,
+		Error:               rpcErr,
 	}, nil
 }
 
@@ -61,20 +83,32 @@ func (x *CoinsecdMessage_VirtualSelectedParentChainChangedNotification) toAppMes
 }
 
 func (x *CoinsecdMessage_VirtualSelectedParentChainChangedNotification) fromAppMessage(message *appmessage.VirtualSelectedParentChainChangedNotificationMessage) error {
-	x.VirtualSelectedParentChainChangedNotification = &VirtualSelectedParentChainChangedNotificationMessage{
+	x.VirtualSelectedParentChainChangedNotification = &VirtualSelectedParentChainChangedNotificationMessage{}
+	x.VirtualSelectedParentChainChangedNotification.fromAppMessage(message)
+	return nil
+}
+
+func (x *VirtualSelectedParentChainChangedNotificationMessage) fromAppMessage(message *appmessage.VirtualSelectedParentChainChangedNotificationMessage) {
+	*x = VirtualSelectedParentChainChangedNotificationMessage{
 		RemovedChainBlockHashes: message.RemovedChainBlockHashes,
 		AddedChainBlockHashes:   message.AddedChainBlockHashes,
 		AcceptedTransactionIds:  make([]*AcceptedTransactionIds, len(message.AcceptedTransactionIDs)),
+		Sequence:                message.Sequence,
 	}
 
 	for i, acceptedTransactionIDs := range message.AcceptedTransactionIDs {
-		x.VirtualSelectedParentChainChangedNotification.AcceptedTransactionIds[i] = &AcceptedTransactionIds{}
-		x.VirtualSelectedParentChainChangedNotification.AcceptedTransactionIds[i].fromAppMessage(acceptedTransactionIDs)
+		x.AcceptedTransactionIds[i] = &AcceptedTransactionIds{}
+		x.AcceptedTransactionIds[i].fromAppMessage(acceptedTransactionIDs)
 	}
-	return nil
 }
 
 func (x *VirtualSelectedParentChainChangedNotificationMessage) toAppMessage() (appmessage.Message, error) {
+	return x.toAppNotification()
+}
+
+func (x *VirtualSelectedParentChainChangedNotificationMessage) toAppNotification() (
+	*appmessage.VirtualSelectedParentChainChangedNotificationMessage, error) {
+
 	if x == nil {
 		return nil, errors.Wrapf(errorNil, "VirtualSelectedParentChainChangedNotificationMessage is nil")
 	}
@@ -82,6 +116,7 @@ func (x *VirtualSelectedParentChainChangedNotificationMessage) toAppMessage() (a
 		RemovedChainBlockHashes: x.RemovedChainBlockHashes,
 		AddedChainBlockHashes:   x.AddedChainBlockHashes,
 		AcceptedTransactionIDs:  make([]*appmessage.AcceptedTransactionIDs, len(x.AcceptedTransactionIds)),
+		Sequence:                x.Sequence,
 	}
 
 	for i, acceptedTransactionIds := range x.AcceptedTransactionIds {
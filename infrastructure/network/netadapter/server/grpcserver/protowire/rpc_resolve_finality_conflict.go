@@ -38,7 +38,7 @@ func (x *CoinsecdMessage_ResolveFinalityConflictResponse) toAppMessage() (appmes
 func (x *CoinsecdMessage_ResolveFinalityConflictResponse) fromAppMessage(message *appmessage.ResolveFinalityConflictResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.ResolveFinalityConflictResponse = &ResolveFinalityConflictResponseMessage{
 		Error: err,
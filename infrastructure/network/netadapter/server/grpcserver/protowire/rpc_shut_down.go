@@ -24,7 +24,7 @@ func (x *CoinsecdMessage_ShutDownResponse) toAppMessage() (appmessage.Message, e
 func (x *CoinsecdMessage_ShutDownResponse) fromAppMessage(message *appmessage.ShutDownResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.ShutDownResponse = &ShutDownResponseMessage{
 		Error: err,
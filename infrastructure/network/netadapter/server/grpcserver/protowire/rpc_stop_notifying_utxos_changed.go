@@ -38,7 +38,7 @@ func (x *CoinsecdMessage_StopNotifyingUtxosChangedResponse) toAppMessage() (appm
 func (x *CoinsecdMessage_StopNotifyingUtxosChangedResponse) fromAppMessage(message *appmessage.StopNotifyingUTXOsChangedResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.StopNotifyingUtxosChangedResponse = &StopNotifyingUtxosChangedResponseMessage{
 		Error: err,
@@ -43,7 +43,7 @@ func (x *CoinsecdMessage_SubmitBlockResponse) toAppMessage() (appmessage.Message
 func (x *CoinsecdMessage_SubmitBlockResponse) fromAppMessage(message *appmessage.SubmitBlockResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.SubmitBlockResponse = &SubmitBlockResponseMessage{
 		RejectReason: SubmitBlockResponseMessage_RejectReason(message.RejectReason),
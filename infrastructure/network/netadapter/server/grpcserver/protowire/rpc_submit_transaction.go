@@ -47,7 +47,7 @@ func (x *CoinsecdMessage_SubmitTransactionResponse) toAppMessage() (appmessage.M
 func (x *CoinsecdMessage_SubmitTransactionResponse) fromAppMessage(message *appmessage.SubmitTransactionResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.SubmitTransactionResponse = &SubmitTransactionResponseMessage{
 		TransactionId: message.TransactionID,
@@ -0,0 +1,78 @@
+package protowire
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CoinsecdMessage_SubmitTransactionPackageRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CoinsecdMessage_SubmitTransactionPackageRequest is nil")
+	}
+	return x.SubmitTransactionPackageRequest.toAppMessage()
+}
+
+func (x *CoinsecdMessage_SubmitTransactionPackageRequest) fromAppMessage(message *appmessage.SubmitTransactionPackageRequestMessage) error {
+	transactions := make([]*RpcTransaction, len(message.Transactions))
+	for i, transaction := range message.Transactions {
+		transactions[i] = &RpcTransaction{}
+		transactions[i].fromAppMessage(transaction)
+	}
+	x.SubmitTransactionPackageRequest = &SubmitTransactionPackageRequestMessage{
+		Transactions: transactions,
+	}
+	return nil
+}
+
+func (x *SubmitTransactionPackageRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "SubmitTransactionPackageRequestMessage is nil")
+	}
+	transactions := make([]*appmessage.RPCTransaction, len(x.Transactions))
+	for i, transaction := range x.Transactions {
+		rpcTransaction, err := transaction.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = rpcTransaction
+	}
+	return &appmessage.SubmitTransactionPackageRequestMessage{
+		Transactions: transactions,
+	}, nil
+}
+
+func (x *CoinsecdMessage_SubmitTransactionPackageResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CoinsecdMessage_SubmitTransactionPackageResponse is nil")
+	}
+	return x.SubmitTransactionPackageResponse.toAppMessage()
+}
+
+func (x *CoinsecdMessage_SubmitTransactionPackageResponse) fromAppMessage(message *appmessage.SubmitTransactionPackageResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = rpcErrorFromAppMessage(message.Error)
+	}
+	x.SubmitTransactionPackageResponse = &SubmitTransactionPackageResponseMessage{
+		TransactionId:          message.TransactionID,
+		AcceptedTransactionIds: message.AcceptedTransactionIDs,
+		Error:                  err,
+	}
+	return nil
+}
+
+func (x *SubmitTransactionPackageResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "SubmitTransactionPackageResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.SubmitTransactionPackageResponseMessage{
+		TransactionID:          x.TransactionId,
+		AcceptedTransactionIDs: x.AcceptedTransactionIds,
+		Error:                  rpcErr,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package protowire
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *CoinsecdMessage_SubmitTransactionReplacementRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CoinsecdMessage_SubmitTransactionReplacementRequest is nil")
+	}
+	return x.SubmitTransactionReplacementRequest.toAppMessage()
+}
+
+func (x *CoinsecdMessage_SubmitTransactionReplacementRequest) fromAppMessage(message *appmessage.SubmitTransactionReplacementRequestMessage) error {
+	x.SubmitTransactionReplacementRequest = &SubmitTransactionReplacementRequestMessage{
+		Transaction: &RpcTransaction{},
+	}
+	x.SubmitTransactionReplacementRequest.Transaction.fromAppMessage(message.Transaction)
+	return nil
+}
+
+func (x *SubmitTransactionReplacementRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "SubmitTransactionReplacementRequestMessage is nil")
+	}
+	rpcTransaction, err := x.Transaction.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.SubmitTransactionReplacementRequestMessage{
+		Transaction: rpcTransaction,
+	}, nil
+}
+
+func (x *CoinsecdMessage_SubmitTransactionReplacementResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CoinsecdMessage_SubmitTransactionReplacementResponse is nil")
+	}
+	return x.SubmitTransactionReplacementResponse.toAppMessage()
+}
+
+func (x *CoinsecdMessage_SubmitTransactionReplacementResponse) fromAppMessage(message *appmessage.SubmitTransactionReplacementResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = rpcErrorFromAppMessage(message.Error)
+	}
+	x.SubmitTransactionReplacementResponse = &SubmitTransactionReplacementResponseMessage{
+		TransactionId:          message.TransactionID,
+		ReplacedTransactionIds: message.ReplacedTransactionIDs,
+		Error:                  err,
+	}
+	return nil
+}
+
+func (x *SubmitTransactionReplacementResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "SubmitTransactionReplacementResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.SubmitTransactionReplacementResponseMessage{
+		TransactionID:          x.TransactionId,
+		ReplacedTransactionIDs: x.ReplacedTransactionIds,
+		Error:                  rpcErr,
+	}, nil
+}
@@ -36,7 +36,7 @@ func (x *CoinsecdMessage_UnbanResponse) toAppMessage() (appmessage.Message, erro
 func (x *CoinsecdMessage_UnbanResponse) fromAppMessage(message *appmessage.UnbanResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = rpcErrorFromAppMessage(message.Error)
 	}
 	x.UnbanResponse = &UnbanResponseMessage{
 		Error: err,
@@ -968,6 +968,139 @@ func toRPCPayload(message appmessage.Message) (isCoinsecdMessage_Payload, error)
 			return nil, err
 		}
 		return payload, nil
+	case *appmessage.GetTransactionRequestMessage:
+		payload := new(CoinsecdMessage_GetTransactionRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetTransactionResponseMessage:
+		payload := new(CoinsecdMessage_GetTransactionResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAddressHistoryRequestMessage:
+		payload := new(CoinsecdMessage_GetAddressHistoryRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetAddressHistoryResponseMessage:
+		payload := new(CoinsecdMessage_GetAddressHistoryResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.SubmitTransactionReplacementRequestMessage:
+		payload := new(CoinsecdMessage_SubmitTransactionReplacementRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.SubmitTransactionReplacementResponseMessage:
+		payload := new(CoinsecdMessage_SubmitTransactionReplacementResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetFeeEstimateRequestMessage:
+		payload := new(CoinsecdMessage_GetFeeEstimateRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetFeeEstimateResponseMessage:
+		payload := new(CoinsecdMessage_GetFeeEstimateResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.SubmitTransactionPackageRequestMessage:
+		payload := new(CoinsecdMessage_SubmitTransactionPackageRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.SubmitTransactionPackageResponseMessage:
+		payload := new(CoinsecdMessage_SubmitTransactionPackageResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetMempoolInfoRequestMessage:
+		payload := new(CoinsecdMessage_GetMempoolInfoRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetMempoolInfoResponseMessage:
+		payload := new(CoinsecdMessage_GetMempoolInfoResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.NotifyMempoolChangedRequestMessage:
+		payload := new(CoinsecdMessage_NotifyMempoolChangedRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.NotifyMempoolChangedResponseMessage:
+		payload := new(CoinsecdMessage_NotifyMempoolChangedResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.MempoolChangedNotificationMessage:
+		payload := new(CoinsecdMessage_MempoolChangedNotification)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetRPCUsageRequestMessage:
+		payload := new(CoinsecdMessage_GetRpcUsageRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetRPCUsageResponseMessage:
+		payload := new(CoinsecdMessage_GetRpcUsageResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.BackupDatabaseRequestMessage:
+		payload := new(CoinsecdMessage_BackupDatabaseRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.BackupDatabaseResponseMessage:
+		payload := new(CoinsecdMessage_BackupDatabaseResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
 	default:
 		return nil, nil
 	}
@@ -1,9 +1,18 @@
 package grpcserver
 
 import (
+	"context"
+	"crypto/tls"
+
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcauth"
 	"github.com/wombatlabs/coinsecd/util/panics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 type rpcServer struct {
@@ -14,9 +23,21 @@ type rpcServer struct {
 // RPCMaxMessageSize is the max message size for the RPC server to send and receive
 const RPCMaxMessageSize = 1024 * 1024 * 1024 // 1 GB
 
-// NewRPCServer creates a new RPCServer
-func NewRPCServer(listeningAddresses []string, rpcMaxInboundConnections int) (server.Server, error) {
-	gRPCServer := newGRPCServer(listeningAddresses, RPCMaxMessageSize, rpcMaxInboundConnections, "RPC")
+// RPCStreamAcceptedMetadataKey is set in the headers that the RPC server sends
+// as soon as it accepts a stream
+const RPCStreamAcceptedMetadataKey = "coinsecd-stream-accepted"
+
+// NewRPCServer creates a new RPCServer. If tlsConfig is not nil, the server only accepts TLS connections.
+// If authenticator is not nil, the server only accepts connections from clients with a valid token
+func NewRPCServer(listeningAddresses []string, rpcMaxInboundConnections int, tlsConfig *tls.Config,
+	authenticator *rpcauth.Authenticator) (server.Server, error) {
+
+	var serverOptions []grpc.ServerOption
+	if authenticator != nil {
+		serverOptions = append(serverOptions, grpc.StreamInterceptor(newAuthenticationInterceptor(authenticator)))
+	}
+	gRPCServer := newGRPCServer(listeningAddresses, RPCMaxMessageSize, rpcMaxInboundConnections, "RPC", tlsConfig,
+		serverOptions...)
 	rpcServer := &rpcServer{gRPCServer: *gRPCServer}
 	protowire.RegisterRPCServer(gRPCServer.server, rpcServer)
 	return rpcServer, nil
@@ -25,5 +46,50 @@ func NewRPCServer(listeningAddresses []string, rpcMaxInboundConnections int) (se
 func (r *rpcServer) MessageStream(stream protowire.RPC_MessageStreamServer) error {
 	defer panics.HandlePanic(log, "rpcServer.MessageStream", nil)
 
+	// Send the headers right away, so that clients can tell that the stream was
+	// accepted before they send their first request
+	err := stream.SendHeader(metadata.Pairs(RPCStreamAcceptedMetadataKey, "true"))
+	if err != nil {
+		return err
+	}
 	return r.handleInboundConnection(stream.Context(), stream)
 }
+
+// newAuthenticationInterceptor returns an interceptor that rejects streams without a valid
+// token in their authorization metadata, and adds the identity of the client to the
+// context of the other streams
+func newAuthenticationInterceptor(authenticator *rpcauth.Authenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+
+		authorization := ""
+		incomingMetadata, ok := metadata.FromIncomingContext(stream.Context())
+		if ok {
+			if values := incomingMetadata.Get(rpcauth.AuthorizationMetadataKey); len(values) > 0 {
+				authorization = values[0]
+			}
+		}
+		identity, err := authenticator.AuthenticateAuthorization(authorization)
+		if err != nil {
+			if peerInfo, ok := peer.FromContext(stream.Context()); ok {
+				log.Warnf("Rejected an RPC connection from %s: %s", peerInfo.Addr, err)
+			}
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		return handler(srv, &authenticatedServerStream{
+			ServerStream: stream,
+			ctx:          rpcauth.NewContext(stream.Context(), identity),
+		})
+	}
+}
+
+// authenticatedServerStream is a grpc.ServerStream whose context carries the identity of the client
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedServerStream) Context() context.Context {
+	return s.ctx
+}
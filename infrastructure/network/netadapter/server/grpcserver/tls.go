@@ -0,0 +1,117 @@
+package grpcserver
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wombatlabs/coinsecd/util"
+)
+
+const autogeneratedCertOrganization = "coinsecd autogenerated cert"
+
+// autogeneratedCertValidity is how long an autogenerated RPC certificate is valid for
+const autogeneratedCertValidity = 10 * 365 * 24 * time.Hour
+
+// NewRPCServerTLSConfig loads the RPC server's certificate pair from certFile and keyFile.
+// If neither of them exists, a self-signed certificate pair is generated and written to them.
+// If clientCAFile is not empty, clients are required to present a certificate signed by one
+// of the certificate authorities it contains
+func NewRPCServerTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	err := generateCertPairIfMissing(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error loading the RPC certificate pair from %s and %s", certFile, keyFile)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if clientCAFile != "" {
+		clientCAs, err := loadCertPool(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}
+
+func generateCertPairIfMissing(certFile, keyFile string) error {
+	certFileExists, err := fileExists(certFile)
+	if err != nil {
+		return err
+	}
+	keyFileExists, err := fileExists(keyFile)
+	if err != nil {
+		return err
+	}
+	if certFileExists && keyFileExists {
+		return nil
+	}
+	if certFileExists || keyFileExists {
+		return errors.Errorf("only one of the RPC certificate file %s and key file %s exists: "+
+			"either provide both or remove the existing one to generate a new pair", certFile, keyFile)
+	}
+
+	log.Infof("Generating a self-signed RPC certificate pair at %s and %s", certFile, keyFile)
+
+	cert, key, err := util.NewTLSCertPair(autogeneratedCertOrganization,
+		time.Now().Add(autogeneratedCertValidity), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range []string{certFile, keyFile} {
+		err := os.MkdirAll(filepath.Dir(file), 0700)
+		if err != nil {
+			return err
+		}
+	}
+	err = os.WriteFile(certFile, cert, 0644)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(keyFile, key, 0600)
+	if err != nil {
+		os.Remove(certFile)
+		return err
+	}
+
+	log.Infof("Done generating the RPC certificate pair")
+	return nil
+}
+
+func loadCertPool(certFile string) (*x509.CertPool, error) {
+	pemCerts, err := os.ReadFile(certFile)
+	if err != nil {
+		return nil, err
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(pemCerts) {
+		return nil, errors.Errorf("no valid PEM certificates found in %s", certFile)
+	}
+	return certPool, nil
+}
+
+func fileExists(path string) (bool, error) {
+	_, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
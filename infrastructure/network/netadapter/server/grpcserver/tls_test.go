@@ -0,0 +1,72 @@
+package grpcserver
+
+import (
+	"bytes"
+	"crypto/tls"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewRPCServerTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "rpc.cert")
+	keyFile := filepath.Join(dir, "rpc.key")
+
+	// The first call is expected to generate a self-signed certificate pair
+	tlsConfig, err := NewRPCServerTLSConfig(certFile, keyFile, "")
+	if err != nil {
+		t.Fatalf("NewRPCServerTLSConfig: %s", err)
+	}
+	if len(tlsConfig.Certificates) != 1 {
+		t.Fatalf("Expected exactly one certificate but got %d", len(tlsConfig.Certificates))
+	}
+	if tlsConfig.ClientAuth != tls.NoClientCert {
+		t.Fatalf("Expected client certificates not to be required without a client CA file")
+	}
+	generatedCert, err := os.ReadFile(certFile)
+	if err != nil {
+		t.Fatalf("ReadFile: %s", err)
+	}
+
+	// The second call is expected to reuse the existing pair, and to
+	// require client certificates signed by the given CA
+	tlsConfig, err = NewRPCServerTLSConfig(certFile, keyFile, certFile)
+	if err != nil {
+		t.Fatalf("NewRPCServerTLSConfig: %s", err)
+	}
+	if tlsConfig.ClientAuth != tls.RequireAndVerifyClientCert || tlsConfig.ClientCAs == nil {
+		t.Fatalf("Expected client certificates to be required and verified")
+	}
+	reloadedCert, err := os.ReadFile(certFile)
+	if err != nil {
+		t.Fatalf("ReadFile: %s", err)
+	}
+	if !bytes.Equal(generatedCert, reloadedCert) {
+		t.Fatalf("Expected the existing certificate not to be regenerated")
+	}
+
+	// A lone certificate file is an error rather than a reason to overwrite it
+	err = os.Remove(keyFile)
+	if err != nil {
+		t.Fatalf("Remove: %s", err)
+	}
+	_, err = NewRPCServerTLSConfig(certFile, keyFile, "")
+	if err == nil {
+		t.Fatalf("Expected an error when only the certificate file exists")
+	}
+}
+
+func TestNewRPCServerTLSConfigInvalidClientCA(t *testing.T) {
+	dir := t.TempDir()
+	clientCAFile := filepath.Join(dir, "ca.cert")
+	err := os.WriteFile(clientCAFile, []byte("not a certificate"), 0600)
+	if err != nil {
+		t.Fatalf("WriteFile: %s", err)
+	}
+
+	_, err = NewRPCServerTLSConfig(filepath.Join(dir, "rpc.cert"), filepath.Join(dir, "rpc.key"), clientCAFile)
+	if err == nil {
+		t.Fatalf("Expected an error for a client CA file without certificates")
+	}
+}
@@ -0,0 +1,268 @@
+package grpcserver
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcauth"
+	"github.com/wombatlabs/coinsecd/util/panics"
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// websocketServer carries the same message stream as the RPC server over WebSocket,
+// with every message encoded in protobuf's JSON encoding in a single frame
+type websocketServer struct {
+	onConnectedHandler server.OnConnectedHandler
+	listeningAddresses []string
+	tlsConfig          *tls.Config
+	httpServer         *http.Server
+	websocketServer    websocket.Server
+
+	// authenticator is nil if RPC authentication is disabled
+	authenticator *rpcauth.Authenticator
+
+	// allowedOrigins are the origins of the web pages, other than the server's own,
+	// that may connect
+	allowedOrigins map[string]struct{}
+
+	maxInboundConnections int
+
+	// connectionsLock protects inboundConnectionCount, which also counts the connections
+	// that are still in the WebSocket handshake, and connections, which holds the open
+	// connections so that they're disconnected when the server stops. WebSocket connections
+	// are hijacked from the HTTP server, so it doesn't close them by itself
+	connectionsLock        sync.Mutex
+	inboundConnectionCount int
+	connections            map[*gRPCConnection]struct{}
+	isStopped              bool
+}
+
+// NewRPCWebsocketServer creates a new RPC server that accepts WebSocket connections.
+// If tlsConfig is not nil, the server only accepts TLS connections. If authenticator is
+// not nil, the server only accepts connections from clients with a valid token. Web pages
+// may only connect if they are of the server's own origin or of one of allowedOrigins
+func NewRPCWebsocketServer(listeningAddresses []string, maxInboundConnections int,
+	tlsConfig *tls.Config, authenticator *rpcauth.Authenticator, allowedOrigins []string) (server.Server, error) {
+
+	s := &websocketServer{
+		listeningAddresses:    listeningAddresses,
+		tlsConfig:             tlsConfig,
+		authenticator:         authenticator,
+		allowedOrigins:        make(map[string]struct{}, len(allowedOrigins)),
+		maxInboundConnections: maxInboundConnections,
+		connections:           make(map[*gRPCConnection]struct{}),
+	}
+	for _, origin := range allowedOrigins {
+		s.allowedOrigins[origin] = struct{}{}
+	}
+	s.websocketServer = websocket.Server{Handler: s.handleInboundConnection, Handshake: s.checkOrigin}
+	s.httpServer = &http.Server{Handler: s}
+	return s, nil
+}
+
+func (s *websocketServer) Start() error {
+	if s.onConnectedHandler == nil {
+		return errors.New("onConnectedHandler is nil")
+	}
+
+	for _, listenAddress := range s.listeningAddresses {
+		err := s.listenOn(listenAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *websocketServer) listenOn(listenAddr string) error {
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return errors.Wrapf(err, "RPC WebSocket error listening on %s", listenAddr)
+	}
+	if s.tlsConfig != nil {
+		listener = tls.NewListener(listener, s.tlsConfig)
+	}
+
+	spawn("websocketServer.listenOn-Serve", func() {
+		err := s.httpServer.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panics.Exit(log, fmt.Sprintf("error serving RPC WebSocket on %s: %+v", listenAddr, err))
+		}
+	})
+
+	log.Infof("RPC WebSocket Server listening on %s", listener.Addr())
+	return nil
+}
+
+func (s *websocketServer) Stop() error {
+	err := s.httpServer.Close()
+
+	s.connectionsLock.Lock()
+	s.isStopped = true
+	connections := make([]*gRPCConnection, 0, len(s.connections))
+	for connection := range s.connections {
+		connections = append(connections, connection)
+	}
+	s.connectionsLock.Unlock()
+
+	for _, connection := range connections {
+		connection.Disconnect()
+	}
+	return err
+}
+
+// SetOnConnectedHandler sets the peer connected handler
+// function for the server
+func (s *websocketServer) SetOnConnectedHandler(onConnectedHandler server.OnConnectedHandler) {
+	s.onConnectedHandler = onConnectedHandler
+}
+
+// ServeHTTP rejects the connection before the WebSocket handshake if the client isn't
+// authenticated or if there are already too many connections, and otherwise serves it
+// until it's disconnected
+func (s *websocketServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if s.authenticator != nil {
+		identity, err := s.authenticate(request)
+		if err != nil {
+			log.Warnf("Rejected an RPC WebSocket connection from %s: %s", request.RemoteAddr, err)
+			http.Error(writer, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		request = request.WithContext(rpcauth.NewContext(request.Context(), identity))
+	}
+
+	err := s.incrementInboundConnectionCountAndLimitIfRequired()
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	defer s.decrementInboundConnectionCount()
+
+	s.websocketServer.ServeHTTP(writer, request)
+}
+
+// authenticate authenticates the token in the request's Authorization header. Since browsers
+// can't set headers on WebSocket requests, the token may also be given in the "token" query
+// parameter
+func (s *websocketServer) authenticate(request *http.Request) (*rpcauth.Identity, error) {
+	if token := request.URL.Query().Get("token"); token != "" {
+		return s.authenticator.Authenticate(token)
+	}
+	return s.authenticator.AuthenticateAuthorization(request.Header.Get(rpcauth.AuthorizationMetadataKey))
+}
+
+// checkOrigin rejects the WebSocket handshake of web pages that are neither of the
+// server's own origin nor of an allowed origin, since browsers let any web page open
+// WebSocket connections to any address, including the node on localhost. Clients that
+// aren't browsers don't have to send an Origin header at all
+func (s *websocketServer) checkOrigin(config *websocket.Config, request *http.Request) error {
+	origin, err := websocket.Origin(config, request)
+	if err != nil {
+		return err
+	}
+	config.Origin = origin
+	if origin == nil || strings.EqualFold(origin.Host, request.Host) {
+		return nil
+	}
+	if _, ok := s.allowedOrigins[request.Header.Get("Origin")]; ok {
+		return nil
+	}
+	log.Warnf("Rejected an RPC WebSocket connection from %s: origin %s is not allowed", request.RemoteAddr, origin)
+	return errors.Errorf("origin %s is not allowed", origin)
+}
+
+func (s *websocketServer) handleInboundConnection(websocketConnection *websocket.Conn) {
+	defer panics.HandlePanic(log, "websocketServer.handleInboundConnection", nil)
+
+	tcpAddress, err := net.ResolveTCPAddr("tcp", websocketConnection.Request().RemoteAddr)
+	if err != nil {
+		log.Warnf("Error resolving the address of an RPC WebSocket connection: %s", err)
+		return
+	}
+	websocketConnection.MaxPayloadBytes = RPCMaxMessageSize
+	connection := newConnection(nil, tcpAddress, &websocketStream{connection: websocketConnection}, nil)
+	connection.identity = rpcauth.IdentityFromContext(websocketConnection.Request().Context())
+
+	err = s.onConnectedHandler(connection)
+	if err != nil {
+		log.Warnf("Error handling RPC WebSocket connection from %s: %s", tcpAddress, err)
+		return
+	}
+
+	s.connectionsLock.Lock()
+	if s.isStopped {
+		s.connectionsLock.Unlock()
+		connection.Disconnect()
+		return
+	}
+	s.connections[connection] = struct{}{}
+	s.connectionsLock.Unlock()
+	defer func() {
+		s.connectionsLock.Lock()
+		defer s.connectionsLock.Unlock()
+
+		delete(s.connections, connection)
+	}()
+
+	log.Infof("RPC WebSocket Incoming connection from %s", tcpAddress)
+
+	// The WebSocket connection is closed once this function returns
+	<-connection.stopChan
+}
+
+func (s *websocketServer) incrementInboundConnectionCountAndLimitIfRequired() error {
+	s.connectionsLock.Lock()
+	defer s.connectionsLock.Unlock()
+
+	if s.maxInboundConnections > 0 && s.inboundConnectionCount == s.maxInboundConnections {
+		log.Warnf("Limit of %d RPC WebSocket inbound connections has been exceeded", s.maxInboundConnections)
+		return errors.Errorf("limit of %d RPC WebSocket inbound connections has been exceeded", s.maxInboundConnections)
+	}
+
+	s.inboundConnectionCount++
+	return nil
+}
+
+func (s *websocketServer) decrementInboundConnectionCount() {
+	s.connectionsLock.Lock()
+	defer s.connectionsLock.Unlock()
+
+	s.inboundConnectionCount--
+}
+
+// websocketStream sends and receives CoinsecdMessages over a WebSocket
+// connection in protobuf's JSON encoding
+type websocketStream struct {
+	connection *websocket.Conn
+}
+
+func (s *websocketStream) Send(message *protowire.CoinsecdMessage) error {
+	messageJSON, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return websocket.Message.Send(s.connection, string(messageJSON))
+}
+
+func (s *websocketStream) Recv() (*protowire.CoinsecdMessage, error) {
+	var messageJSON []byte
+	err := websocket.Message.Receive(s.connection, &messageJSON)
+	if err != nil {
+		return nil, err
+	}
+	message := &protowire.CoinsecdMessage{}
+	err = protojson.Unmarshal(messageJSON, message)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing a message from %s", s.connection.Request().RemoteAddr)
+	}
+	return message, nil
+}
@@ -0,0 +1,237 @@
+package grpcserver
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcauth"
+	"golang.org/x/net/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const testWebsocketAddress = "127.0.0.1:12360"
+
+func dialTestWebsocket() (*websocket.Conn, error) {
+	return websocket.Dial("ws://"+testWebsocketAddress+"/", "", "http://"+testWebsocketAddress+"/")
+}
+
+func TestRPCWebsocketServer(t *testing.T) {
+	websocketServer, err := NewRPCWebsocketServer([]string{testWebsocketAddress}, 1, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("NewRPCWebsocketServer: %s", err)
+	}
+
+	// Answer every GetCurrentNetwork request, like the RPC handlers would
+	websocketServer.SetOnConnectedHandler(func(connection server.Connection) error {
+		connectionRouter := router.NewRouter("test")
+		route, err := connectionRouter.AddIncomingRoute("test",
+			[]appmessage.MessageCommand{appmessage.CmdGetCurrentNetworkRequestMessage})
+		if err != nil {
+			return err
+		}
+		connection.SetOnDisconnectedHandler(connectionRouter.Close)
+		connection.Start(connectionRouter)
+		go func() {
+			for {
+				_, err := route.Dequeue()
+				if err != nil {
+					return
+				}
+				err = connectionRouter.OutgoingRoute().Enqueue(appmessage.NewGetCurrentNetworkResponseMessage("test-net"))
+				if err != nil {
+					return
+				}
+			}
+		}()
+		return nil
+	})
+	err = websocketServer.Start()
+	if err != nil {
+		t.Fatalf("Start: %s", err)
+	}
+	defer websocketServer.Stop()
+
+	connection, err := dialTestWebsocket()
+	if err != nil {
+		t.Fatalf("Dial: %s", err)
+	}
+
+	err = websocket.Message.Send(connection, `{"getCurrentNetworkRequest": {}}`)
+	if err != nil {
+		t.Fatalf("Send: %s", err)
+	}
+	var responseJSON []byte
+	err = websocket.Message.Receive(connection, &responseJSON)
+	if err != nil {
+		t.Fatalf("Receive: %s", err)
+	}
+	response := &protowire.CoinsecdMessage{}
+	err = protojson.Unmarshal(responseJSON, response)
+	if err != nil {
+		t.Fatalf("Unmarshal: %s", err)
+	}
+	if response.GetGetCurrentNetworkResponse().GetCurrentNetwork() != "test-net" {
+		t.Fatalf("Unexpected response %s", responseJSON)
+	}
+
+	// The limit of connections was reached
+	_, err = dialTestWebsocket()
+	if err == nil {
+		t.Fatalf("Expected the second connection to be rejected")
+	}
+
+	// Once the first connection is closed, new ones should be accepted again
+	err = connection.Close()
+	if err != nil {
+		t.Fatalf("Close: %s", err)
+	}
+	for i := 0; ; i++ {
+		connection, err = dialTestWebsocket()
+		if err == nil {
+			break
+		}
+		if i == 100 {
+			t.Fatalf("Expected a connection to be accepted after the first one was closed: %s", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Stopping the server should close the open connections
+	err = websocketServer.Stop()
+	if err != nil {
+		t.Fatalf("Stop: %s", err)
+	}
+	err = connection.SetReadDeadline(time.Now().Add(time.Second))
+	if err != nil {
+		t.Fatalf("SetReadDeadline: %s", err)
+	}
+	err = websocket.Message.Receive(connection, &responseJSON)
+	if err == nil {
+		t.Fatalf("Expected the connection to be closed when the server stopped")
+	}
+}
+
+func TestRPCWebsocketServerAuthentication(t *testing.T) {
+	const address = "127.0.0.1:12361"
+
+	tokensFilePath := filepath.Join(t.TempDir(), "tokens.json")
+	err := os.WriteFile(tokensFilePath, []byte(`{"tokens": [{"name": "explorer", "token": "secret", "role": "readonly"}]}`), 0600)
+	if err != nil {
+		t.Fatalf("WriteFile: %s", err)
+	}
+	authenticator, err := rpcauth.NewAuthenticator(tokensFilePath, "")
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %s", err)
+	}
+	websocketServer, err := NewRPCWebsocketServer([]string{address}, 0, nil, authenticator, nil)
+	if err != nil {
+		t.Fatalf("NewRPCWebsocketServer: %s", err)
+	}
+
+	identityChan := make(chan *rpcauth.Identity, 1)
+	websocketServer.SetOnConnectedHandler(func(connection server.Connection) error {
+		identityChan <- connection.Identity()
+		connection.SetOnDisconnectedHandler(func() {})
+		connection.Start(router.NewRouter("test"))
+		return nil
+	})
+	err = websocketServer.Start()
+	if err != nil {
+		t.Fatalf("Start: %s", err)
+	}
+	defer websocketServer.Stop()
+
+	for _, url := range []string{"ws://" + address + "/", "ws://" + address + "/?token=wrong"} {
+		_, err := websocket.Dial(url, "", "http://"+address+"/")
+		if err == nil {
+			t.Fatalf("Expected the connection to %s to be rejected", url)
+		}
+	}
+
+	connection, err := websocket.Dial("ws://"+address+"/?token=secret", "", "http://"+address+"/")
+	if err != nil {
+		t.Fatalf("Dial: %s", err)
+	}
+	defer connection.Close()
+	identity := <-identityChan
+	if identity == nil || identity.Name != "explorer" {
+		t.Fatalf("Unexpected identity %s", identity)
+	}
+}
+
+func TestRPCWebsocketServerOrigin(t *testing.T) {
+	const address = "127.0.0.1:12362"
+
+	websocketServer, err := NewRPCWebsocketServer([]string{address}, 0, nil, nil,
+		[]string{"https://explorer.example.com"})
+	if err != nil {
+		t.Fatalf("NewRPCWebsocketServer: %s", err)
+	}
+	websocketServer.SetOnConnectedHandler(func(connection server.Connection) error {
+		connection.SetOnDisconnectedHandler(func() {})
+		connection.Start(router.NewRouter("test"))
+		return nil
+	})
+	err = websocketServer.Start()
+	if err != nil {
+		t.Fatalf("Start: %s", err)
+	}
+	defer websocketServer.Stop()
+
+	tests := []struct {
+		origin            string
+		expectedToConnect bool
+	}{
+		{origin: "http://" + address + "/", expectedToConnect: true},
+		{origin: "https://explorer.example.com", expectedToConnect: true},
+		{origin: "http://localhost/", expectedToConnect: false},
+		{origin: "https://attacker.example.com", expectedToConnect: false},
+	}
+	for _, test := range tests {
+		connection, err := websocket.Dial("ws://"+address+"/", "", test.origin)
+		if test.expectedToConnect {
+			if err != nil {
+				t.Fatalf("Origin %s: Dial: %s", test.origin, err)
+			}
+			connection.Close()
+		} else if err == nil {
+			connection.Close()
+			t.Fatalf("Expected the connection from origin %s to be rejected", test.origin)
+		}
+	}
+
+	// Clients that aren't browsers don't send an Origin header
+	client, err := net.Dial("tcp", address)
+	if err != nil {
+		t.Fatalf("Dial: %s", err)
+	}
+	request, err := http.NewRequest(http.MethodGet, "http://"+address+"/", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %s", err)
+	}
+	request.Header.Set("Connection", "Upgrade")
+	request.Header.Set("Upgrade", "websocket")
+	request.Header.Set("Sec-WebSocket-Version", "13")
+	request.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+	err = request.Write(client)
+	if err != nil {
+		t.Fatalf("Write: %s", err)
+	}
+	response, err := http.ReadResponse(bufio.NewReader(client), request)
+	if err != nil {
+		t.Fatalf("ReadResponse: %s", err)
+	}
+	client.Close()
+	if response.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("Expected a connection without an Origin header to be accepted, got status %d", response.StatusCode)
+	}
+}
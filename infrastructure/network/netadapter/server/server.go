@@ -5,6 +5,7 @@ import (
 	"net"
 
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcauth"
 )
 
 // OnConnectedHandler is a function that is to be called
@@ -43,4 +44,8 @@ type Connection interface {
 	SetOnDisconnectedHandler(onDisconnectedHandler OnDisconnectedHandler)
 	SetOnInvalidMessageHandler(onInvalidMessageHandler OnInvalidMessageHandler)
 	Address() *net.TCPAddr
+
+	// Identity returns the identity that an RPC client authenticated as,
+	// or nil if RPC authentication is disabled or this isn't an RPC connection
+	Identity() *rpcauth.Identity
 }
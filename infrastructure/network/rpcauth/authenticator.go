@@ -0,0 +1,234 @@
+package rpcauth
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AuthorizationMetadataKey is the gRPC metadata key, and the HTTP header, that
+// clients send their token in, as "Bearer <token>"
+const AuthorizationMetadataKey = "authorization"
+
+const bearerPrefix = "Bearer "
+
+// ErrUnauthenticated is returned when a client's token is missing or invalid
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// Identity is who an RPC client authenticated as
+type Identity struct {
+	Name string
+	Role *Role
+}
+
+// IsAllowed returns whether the identity's role allows calling the given method
+func (i *Identity) IsAllowed(method string) bool {
+	return i.Role.IsAllowed(method)
+}
+
+func (i *Identity) String() string {
+	return fmt.Sprintf("%s (%s)", i.Name, i.Role.Name)
+}
+
+// Authenticator authenticates RPC clients by their tokens. Tokens are either static
+// tokens listed in a tokens file, or tokens signed with an HMAC key
+type Authenticator struct {
+	// staticTokens is keyed by the SHA-256 of every token, so that looking a token
+	// up doesn't leak its content through timing
+	staticTokens map[[sha256.Size]byte]*Identity
+	roles        map[string]*Role
+	hmacKey      []byte
+}
+
+// tokensFile is the format of the static tokens file
+type tokensFile struct {
+	// Roles defines additional roles, by the methods they allow
+	Roles map[string][]string `json:"roles"`
+
+	Tokens []struct {
+		Name  string `json:"name"`
+		Token string `json:"token"`
+		Role  string `json:"role"`
+	} `json:"tokens"`
+}
+
+// NewAuthenticator creates an Authenticator from the given tokens file and HMAC key file.
+// Either of them may be empty, but not both
+func NewAuthenticator(tokensFilePath string, hmacKeyFilePath string) (*Authenticator, error) {
+	if tokensFilePath == "" && hmacKeyFilePath == "" {
+		return nil, errors.New("either a tokens file or an HMAC key file is required")
+	}
+
+	authenticator := &Authenticator{
+		staticTokens: make(map[[sha256.Size]byte]*Identity),
+		roles:        make(map[string]*Role, len(builtInRoles)),
+	}
+	for name, role := range builtInRoles {
+		authenticator.roles[name] = role
+	}
+
+	if tokensFilePath != "" {
+		err := authenticator.loadTokensFile(tokensFilePath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error loading RPC tokens file %s", tokensFilePath)
+		}
+	}
+
+	if hmacKeyFilePath != "" {
+		hmacKey, err := os.ReadFile(hmacKeyFilePath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading RPC HMAC key file")
+		}
+		authenticator.hmacKey = bytes.TrimSpace(hmacKey)
+		if len(authenticator.hmacKey) == 0 {
+			return nil, errors.Errorf("RPC HMAC key file %s is empty", hmacKeyFilePath)
+		}
+	}
+
+	return authenticator, nil
+}
+
+func (a *Authenticator) loadTokensFile(tokensFilePath string) error {
+	tokensFileContent, err := os.ReadFile(tokensFilePath)
+	if err != nil {
+		return err
+	}
+	var parsedTokensFile tokensFile
+	err = json.Unmarshal(tokensFileContent, &parsedTokensFile)
+	if err != nil {
+		return err
+	}
+
+	for name, allowedMethods := range parsedTokensFile.Roles {
+		role, err := newCustomRole(name, allowedMethods)
+		if err != nil {
+			return err
+		}
+		a.roles[name] = role
+	}
+
+	for i, token := range parsedTokensFile.Tokens {
+		if token.Token == "" {
+			return errors.Errorf("token #%d is empty", i)
+		}
+		role, ok := a.roles[token.Role]
+		if !ok {
+			return errors.Errorf("token #%d has an unknown role %q", i, token.Role)
+		}
+		name := token.Name
+		if name == "" {
+			name = fmt.Sprintf("token #%d", i)
+		}
+
+		tokenHash := sha256.Sum256([]byte(token.Token))
+		if _, ok := a.staticTokens[tokenHash]; ok {
+			return errors.Errorf("token #%d appears more than once", i)
+		}
+		a.staticTokens[tokenHash] = &Identity{Name: name, Role: role}
+	}
+	return nil
+}
+
+// Authenticate returns the identity of the client with the given token
+func (a *Authenticator) Authenticate(token string) (*Identity, error) {
+	if token == "" {
+		return nil, errors.Wrapf(ErrUnauthenticated, "a token is required")
+	}
+
+	if identity, ok := a.staticTokens[sha256.Sum256([]byte(token))]; ok {
+		return identity, nil
+	}
+	if a.hmacKey != nil && strings.Count(token, ".") == signedTokenPartCount-1 {
+		return a.authenticateSignedToken(token)
+	}
+	return nil, errors.Wrapf(ErrUnauthenticated, "invalid token")
+}
+
+// AuthenticateAuthorization authenticates the value of an authorization header
+// or metadata entry, which has the form "Bearer <token>"
+func (a *Authenticator) AuthenticateAuthorization(authorization string) (*Identity, error) {
+	if authorization == "" {
+		return nil, errors.Wrapf(ErrUnauthenticated, "a token is required")
+	}
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return nil, errors.Wrapf(ErrUnauthenticated, "the authorization must have the form \"%s<token>\"", bearerPrefix)
+	}
+	return a.Authenticate(strings.TrimPrefix(authorization, bearerPrefix))
+}
+
+// BearerAuthorization returns the authorization header or metadata value for the given token
+func BearerAuthorization(token string) string {
+	return bearerPrefix + token
+}
+
+// Signed tokens have the form <name>.<role>.<expiry>.<signature>, where expiry is a unix
+// timestamp in seconds (0 for tokens that never expire), and signature is the hex encoded
+// HMAC-SHA256 of <name>.<role>.<expiry> with the HMAC key
+const signedTokenPartCount = 4
+
+// NewSignedToken creates a token for the given name and role, signed with the given HMAC key.
+// If expiry is the zero time, the token never expires
+func NewSignedToken(hmacKey []byte, name string, role string, expiry time.Time) (string, error) {
+	if name == "" || strings.Contains(name, ".") || role == "" || strings.Contains(role, ".") {
+		return "", errors.New("the name and the role must be non-empty and must not contain dots")
+	}
+	expiryUnix := int64(0)
+	if !expiry.IsZero() {
+		expiryUnix = expiry.Unix()
+	}
+	payload := fmt.Sprintf("%s.%s.%d", name, role, expiryUnix)
+	return payload + "." + hex.EncodeToString(signTokenPayload(hmacKey, payload)), nil
+}
+
+func signTokenPayload(hmacKey []byte, payload string) []byte {
+	mac := hmac.New(sha256.New, hmacKey)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+func (a *Authenticator) authenticateSignedToken(token string) (*Identity, error) {
+	signatureIndex := strings.LastIndex(token, ".")
+	payload, signatureHex := token[:signatureIndex], token[signatureIndex+1:]
+	signature, err := hex.DecodeString(signatureHex)
+	if err != nil || !hmac.Equal(signature, signTokenPayload(a.hmacKey, payload)) {
+		return nil, errors.Wrapf(ErrUnauthenticated, "invalid token")
+	}
+
+	parts := strings.Split(payload, ".")
+	name, roleName, expiryString := parts[0], parts[1], parts[2]
+	expiryUnix, err := strconv.ParseInt(expiryString, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(ErrUnauthenticated, "invalid token expiry %s", expiryString)
+	}
+	if expiryUnix != 0 && time.Now().Unix() >= expiryUnix {
+		return nil, errors.Wrapf(ErrUnauthenticated, "the token expired at %s", time.Unix(expiryUnix, 0))
+	}
+	role, ok := a.roles[roleName]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnauthenticated, "the token has an unknown role %s", roleName)
+	}
+	return &Identity{Name: name, Role: role}, nil
+}
+
+type identityContextKey struct{}
+
+// NewContext returns a copy of ctx that carries the given identity
+func NewContext(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityContextKey{}, identity)
+}
+
+// IdentityFromContext returns the identity carried by ctx, or nil if there's none
+func IdentityFromContext(ctx context.Context) *Identity {
+	identity, _ := ctx.Value(identityContextKey{}).(*Identity)
+	return identity
+}
@@ -0,0 +1,180 @@
+package rpcauth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func writeTestFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	err := os.WriteFile(path, []byte(content), 0600)
+	if err != nil {
+		t.Fatalf("WriteFile: %s", err)
+	}
+	return path
+}
+
+func TestBuiltInRoles(t *testing.T) {
+	tests := []struct {
+		role    string
+		method  string
+		allowed bool
+	}{
+		{role: RoleReadOnly, method: "GetBlockDagInfo", allowed: true},
+		{role: RoleReadOnly, method: "GetBlockDAGInfo", allowed: true},
+		{role: RoleReadOnly, method: "NotifyBlockAdded", allowed: true},
+		{role: RoleReadOnly, method: "EstimateNetworkHashesPerSecond", allowed: true},
+		{role: RoleReadOnly, method: "SubmitBlock", allowed: false},
+		{role: RoleReadOnly, method: "Ban", allowed: false},
+		{role: RoleReadOnly, method: "GetRpcUsage", allowed: false},
+		{role: RoleReadOnly, method: "BackupDatabase", allowed: false},
+		{role: RoleMiner, method: "SubmitBlock", allowed: true},
+		{role: RoleMiner, method: "GetBlockTemplate", allowed: true},
+		{role: RoleMiner, method: "SubmitTransaction", allowed: false},
+		{role: RoleMiner, method: "ShutDown", allowed: false},
+		{role: RoleWallet, method: "SubmitTransaction", allowed: true},
+		{role: RoleWallet, method: "SubmitTransactionPackage", allowed: true},
+		{role: RoleWallet, method: "SubmitBlock", allowed: false},
+		{role: RoleWallet, method: "AddPeer", allowed: false},
+		{role: RoleAdmin, method: "Ban", allowed: true},
+		{role: RoleAdmin, method: "ShutDown", allowed: true},
+		{role: RoleAdmin, method: "AddPeer", allowed: true},
+		{role: RoleAdmin, method: "GetRpcUsage", allowed: true},
+		{role: RoleAdmin, method: "BackupDatabase", allowed: true},
+	}
+	for _, test := range tests {
+		allowed := builtInRoles[test.role].IsAllowed(test.method)
+		if allowed != test.allowed {
+			t.Errorf("Role %s, method %s: want allowed: %t, got: %t", test.role, test.method, test.allowed, allowed)
+		}
+	}
+}
+
+func TestStaticTokens(t *testing.T) {
+	tokensFilePath := writeTestFile(t, "tokens.json", `{
+		"roles": {"monitor": ["GetInfo", "GetPeer*", "GetRpcUsage"], "reader": ["Get*"]},
+		"tokens": [
+			{"name": "explorer", "token": "secret-1", "role": "readonly"},
+			{"name": "dashboard", "token": "secret-2", "role": "monitor"},
+			{"name": "reporter", "token": "secret-3", "role": "reader"}
+		]
+	}`)
+	authenticator, err := NewAuthenticator(tokensFilePath, "")
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %s", err)
+	}
+
+	identity, err := authenticator.Authenticate("secret-1")
+	if err != nil {
+		t.Fatalf("Authenticate: %s", err)
+	}
+	if identity.Name != "explorer" || identity.Role.Name != RoleReadOnly {
+		t.Fatalf("Unexpected identity %s", identity)
+	}
+
+	identity, err = authenticator.AuthenticateAuthorization(BearerAuthorization("secret-2"))
+	if err != nil {
+		t.Fatalf("AuthenticateAuthorization: %s", err)
+	}
+	if !identity.IsAllowed("GetPeerAddresses") || !identity.IsAllowed("GetInfo") || identity.IsAllowed("GetBlock") {
+		t.Fatalf("The monitor role doesn't allow the expected methods")
+	}
+	if !identity.IsAllowed("GetRpcUsage") {
+		t.Fatalf("Admin-only methods that are listed explicitly should be allowed")
+	}
+
+	identity, err = authenticator.Authenticate("secret-3")
+	if err != nil {
+		t.Fatalf("Authenticate: %s", err)
+	}
+	if !identity.IsAllowed("GetBlock") || identity.IsAllowed("GetRpcUsage") {
+		t.Fatalf("Admin-only methods should not be matched by wildcards")
+	}
+
+	for _, authorization := range []string{"", "secret-1", "Bearer ", "Bearer secret-4", "Basic secret-1"} {
+		_, err := authenticator.AuthenticateAuthorization(authorization)
+		if !errors.Is(err, ErrUnauthenticated) {
+			t.Fatalf("Authorization %q: expected ErrUnauthenticated, got: %v", authorization, err)
+		}
+	}
+}
+
+func TestInvalidTokensFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "malformed", content: `{"tokens": [`},
+		{name: "unknown role", content: `{"tokens": [{"token": "a", "role": "nothing"}]}`},
+		{name: "empty token", content: `{"tokens": [{"token": "", "role": "admin"}]}`},
+		{name: "duplicate token", content: `{"tokens": [{"token": "a", "role": "admin"}, {"token": "a", "role": "miner"}]}`},
+		{name: "redefined built-in role", content: `{"roles": {"readonly": ["*"]}}`},
+		{name: "wildcard in the middle", content: `{"roles": {"custom": ["Get*Info"]}}`},
+	}
+	for _, test := range tests {
+		_, err := NewAuthenticator(writeTestFile(t, "tokens.json", test.content), "")
+		if err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+	}
+}
+
+func TestSignedTokens(t *testing.T) {
+	hmacKey := []byte("test-key")
+	authenticator, err := NewAuthenticator("", writeTestFile(t, "hmac.key", "test-key\n"))
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %s", err)
+	}
+
+	token, err := NewSignedToken(hmacKey, "pool", RoleMiner, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("NewSignedToken: %s", err)
+	}
+	identity, err := authenticator.Authenticate(token)
+	if err != nil {
+		t.Fatalf("Authenticate: %s", err)
+	}
+	if identity.Name != "pool" || identity.Role.Name != RoleMiner {
+		t.Fatalf("Unexpected identity %s", identity)
+	}
+
+	neverExpiringToken, err := NewSignedToken(hmacKey, "pool", RoleMiner, time.Time{})
+	if err != nil {
+		t.Fatalf("NewSignedToken: %s", err)
+	}
+	_, err = authenticator.Authenticate(neverExpiringToken)
+	if err != nil {
+		t.Fatalf("Authenticate: %s", err)
+	}
+
+	expiredToken, err := NewSignedToken(hmacKey, "pool", RoleMiner, time.Now().Add(-time.Second))
+	if err != nil {
+		t.Fatalf("NewSignedToken: %s", err)
+	}
+	otherKeyToken, err := NewSignedToken([]byte("other-key"), "pool", RoleMiner, time.Time{})
+	if err != nil {
+		t.Fatalf("NewSignedToken: %s", err)
+	}
+	unknownRoleToken, err := NewSignedToken(hmacKey, "pool", "nothing", time.Time{})
+	if err != nil {
+		t.Fatalf("NewSignedToken: %s", err)
+	}
+	// Changing the role of a signed token invalidates its signature
+	tamperedToken := "pool.admin" + token[len("pool.miner"):]
+
+	for _, invalidToken := range []string{expiredToken, otherKeyToken, unknownRoleToken, tamperedToken} {
+		_, err := authenticator.Authenticate(invalidToken)
+		if !errors.Is(err, ErrUnauthenticated) {
+			t.Fatalf("Token %s: expected ErrUnauthenticated, got: %v", invalidToken, err)
+		}
+	}
+
+	_, err = NewSignedToken(hmacKey, "with.dot", RoleMiner, time.Time{})
+	if err == nil {
+		t.Fatalf("Expected names with dots to be rejected")
+	}
+}
@@ -0,0 +1,100 @@
+package rpcauth
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Role is a set of RPC methods that the clients who were given it are allowed to call.
+// Methods are named by their request without the "Request" suffix, such as GetBlock
+type Role struct {
+	Name string
+
+	// allowedMethods are method names, or prefixes of method names ending with "*"
+	allowedMethods []string
+}
+
+// The built-in roles
+const (
+	RoleReadOnly = "readonly"
+	RoleMiner    = "miner"
+	RoleWallet   = "wallet"
+	RoleAdmin    = "admin"
+)
+
+// readOnlyMethods are the methods that don't change the state of the node. Notification
+// subscriptions are included, since they only affect the subscribing connection
+var readOnlyMethods = []string{"Get*", "EstimateNetworkHashesPerSecond", "Notify*", "StopNotifying*"}
+
+// adminOnlyMethods expose information about other clients or write to the node's disk,
+// so they aren't matched by prefixes such as "Get*". Only roles that allow every method,
+// or that list them explicitly, may call them
+var adminOnlyMethods = []string{"GetRpcUsage", "BackupDatabase"}
+
+var builtInRoles = map[string]*Role{
+	RoleReadOnly: newRole(RoleReadOnly, readOnlyMethods),
+	RoleMiner:    newRole(RoleMiner, readOnlyMethods, "SubmitBlock"),
+	RoleWallet: newRole(RoleWallet, readOnlyMethods,
+		"SubmitTransaction", "SubmitTransactionReplacement", "SubmitTransactionPackage"),
+	// admin can also call Ban, Unban, AddPeer, ResolveFinalityConflict, ShutDown, GetRpcUsage
+	// and BackupDatabase
+	RoleAdmin: newRole(RoleAdmin, nil, "*"),
+}
+
+func newRole(name string, baseMethods []string, additionalMethods ...string) *Role {
+	allowedMethods := make([]string, 0, len(baseMethods)+len(additionalMethods))
+	allowedMethods = append(allowedMethods, baseMethods...)
+	allowedMethods = append(allowedMethods, additionalMethods...)
+	return &Role{Name: name, allowedMethods: allowedMethods}
+}
+
+func newCustomRole(name string, allowedMethods []string) (*Role, error) {
+	if _, ok := builtInRoles[name]; ok {
+		return nil, errors.Errorf("role %s is built-in and can't be redefined", name)
+	}
+	if name == "" || strings.Contains(name, ".") {
+		return nil, errors.Errorf("role name %q must be non-empty and must not contain dots", name)
+	}
+	for _, method := range allowedMethods {
+		if method == "" || strings.Contains(strings.TrimSuffix(method, "*"), "*") {
+			return nil, errors.Errorf("role %s has an invalid method %q. Methods may only end with a "+
+				"single \"*\" wildcard", name, method)
+		}
+	}
+	return newRole(name, allowedMethods), nil
+}
+
+// IsAllowed returns whether the role allows calling the given method.
+// Method names are compared case-insensitively
+func (r *Role) IsAllowed(method string) bool {
+	isAdminOnly := isAdminOnlyMethod(method)
+	for _, allowedMethod := range r.allowedMethods {
+		if allowedMethod == "*" {
+			return true
+		}
+		if strings.HasSuffix(allowedMethod, "*") {
+			if isAdminOnly {
+				continue
+			}
+			prefix := strings.TrimSuffix(allowedMethod, "*")
+			if len(method) >= len(prefix) && strings.EqualFold(method[:len(prefix)], prefix) {
+				return true
+			}
+			continue
+		}
+		if strings.EqualFold(allowedMethod, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func isAdminOnlyMethod(method string) bool {
+	for _, adminOnlyMethod := range adminOnlyMethods {
+		if strings.EqualFold(adminOnlyMethod, method) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package rpcclient
+
+// ConnectionState is the state of an RPC client's connection to the node
+type ConnectionState uint8
+
+const (
+	// ConnectionStateConnected means the client reconnected after the connection was lost,
+	// and all of its notification subscriptions were resumed
+	ConnectionStateConnected ConnectionState = iota
+
+	// ConnectionStateDisconnected means the connection was lost, and the client is trying
+	// to reconnect
+	ConnectionStateDisconnected
+
+	// ConnectionStateClosed means the client was closed
+	ConnectionStateClosed
+)
+
+var connectionStateToString = map[ConnectionState]string{
+	ConnectionStateConnected:    "Connected",
+	ConnectionStateDisconnected: "Disconnected",
+	ConnectionStateClosed:       "Closed",
+}
+
+func (state ConnectionState) String() string {
+	return connectionStateToString[state]
+}
+
+// SetOnConnectionStateChangedHandler sets the handler that's called whenever the client's
+// connection state changes. The handler is called synchronously, so it must not block
+func (c *RPCClient) SetOnConnectionStateChangedHandler(onConnectionStateChanged func(state ConnectionState)) {
+	c.connectionLock.Lock()
+	defer c.connectionLock.Unlock()
+
+	c.onConnectionStateChanged = onConnectionStateChanged
+}
+
+func (c *RPCClient) handleConnectionStateChanged(state ConnectionState) {
+	log.Debugf("Connection state of %s changed to %s", c.rpcAddress, state)
+
+	c.connectionLock.RLock()
+	onConnectionStateChanged := c.onConnectionStateChanged
+	c.connectionLock.RUnlock()
+
+	if onConnectionStateChanged != nil {
+		onConnectionStateChanged(state)
+	}
+}
@@ -2,13 +2,17 @@ package grpcclient
 
 import (
 	"context"
+	"crypto/tls"
 	"github.com/wombatlabs/coinsecd/app/appmessage"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server/grpcserver"
 	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcauth"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
 	"io"
 	"time"
 )
@@ -29,24 +33,83 @@ type GRPCClient struct {
 
 // Connect connects to the RPC server with the given address
 func Connect(address string) (*GRPCClient, error) {
+	return ConnectWithTLS(address, nil)
+}
+
+// ConnectWithTLS connects to the RPC server with the given address.
+// If tlsConfig is nil, the connection is not encrypted
+func ConnectWithTLS(address string, tlsConfig *tls.Config) (*GRPCClient, error) {
+	return ConnectWithAuth(address, tlsConfig, "")
+}
+
+// ConnectWithAuth connects to the RPC server with the given address, and authenticates
+// with the given token. If tlsConfig is nil, the connection is not encrypted, and if
+// token is empty, no token is sent
+func ConnectWithAuth(address string, tlsConfig *tls.Config, token string) (*GRPCClient, error) {
 	const dialTimeout = 5 * time.Second
 	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
 	defer cancel()
 
-	gRPCConnection, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock())
+	transportCredentials := grpc.WithInsecure()
+	if tlsConfig != nil {
+		transportCredentials = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+	}
+	gRPCConnection, err := grpc.DialContext(ctx, address, transportCredentials, grpc.WithBlock())
 	if err != nil {
 		return nil, errors.Wrapf(err, "error connecting to %s", address)
 	}
 
+	streamContext := context.Background()
+	if token != "" {
+		streamContext = metadata.AppendToOutgoingContext(streamContext,
+			rpcauth.AuthorizationMetadataKey, rpcauth.BearerAuthorization(token))
+	}
 	grpcClient := protowire.NewRPCClient(gRPCConnection)
-	stream, err := grpcClient.MessageStream(context.Background(), grpc.UseCompressor(gzip.Name),
+	stream, err := grpcClient.MessageStream(streamContext, grpc.UseCompressor(gzip.Name),
 		grpc.MaxCallRecvMsgSize(grpcserver.RPCMaxMessageSize), grpc.MaxCallSendMsgSize(grpcserver.RPCMaxMessageSize))
 	if err != nil {
 		return nil, errors.Wrapf(err, "error getting client stream for %s", address)
 	}
+
+	// The server only reports that it rejected the token once the stream is used, so wait
+	// for it to accept the stream in order to fail here instead
+	if token != "" {
+		err = waitForStreamAcceptance(stream, dialTimeout)
+		if err != nil {
+			closeErr := gRPCConnection.Close()
+			if closeErr != nil {
+				log.Warnf("Error closing the connection to %s: %s", address, closeErr)
+			}
+			return nil, errors.Wrapf(err, "error authenticating to %s", address)
+		}
+	}
 	return &GRPCClient{stream: stream, connection: gRPCConnection}, nil
 }
 
+// waitForStreamAcceptance waits for the headers that the RPC server sends once it
+// accepts a stream, or for the error it rejected the stream with
+func waitForStreamAcceptance(stream protowire.RPC_MessageStreamClient, timeout time.Duration) error {
+	headerErrChan := make(chan error, 1)
+	spawn("waitForStreamAcceptance-Header", func() {
+		header, err := stream.Header()
+		if err == nil && len(header.Get(grpcserver.RPCStreamAcceptedMetadataKey)) == 0 {
+			// The stream was rejected without headers of its own, and the
+			// reason it was rejected for is only returned by Recv
+			_, err = stream.Recv()
+			if err == nil {
+				err = errors.New("the server sent a message before accepting the stream")
+			}
+		}
+		headerErrChan <- err
+	})
+	select {
+	case err := <-headerErrChan:
+		return err
+	case <-time.After(timeout):
+		return errors.Errorf("the server did not accept the stream within %s", timeout)
+	}
+}
+
 // Close closes the underlying grpc connection
 func (c *GRPCClient) Close() error {
 	return c.connection.Close()
@@ -79,7 +142,11 @@ func (c *GRPCClient) AttachRouter(router *router.Router) {
 			}
 			err = c.send(message)
 			if err != nil {
-				c.handleError(err)
+				// io.EOF means that the server ended the stream, and the
+				// receive loop reports the reason it was ended for
+				if !errors.Is(err, io.EOF) {
+					c.handleError(err)
+				}
 				return
 			}
 		}
@@ -0,0 +1,72 @@
+package rpcclient
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+// resubscribeFunc renews a notification subscription on a new connection
+type resubscribeFunc func(isNodeRestarted bool) error
+
+// ErrNotificationSequenceGap is passed to the notification sequence gap handler when notifications
+// were missed while the client was disconnected, and the node could not replay them. Any state
+// derived from those notifications should be resynced
+var ErrNotificationSequenceGap = errors.New("notification sequence gap")
+
+// SetOnNotificationSequenceGapHandler sets the handler that's called when the client reconnects
+// and resumes its notification subscriptions, but some of the notifications it missed are lost.
+// The error passed to the handler wraps ErrNotificationSequenceGap. If no handler is set, the gap
+// is only logged
+func (c *RPCClient) SetOnNotificationSequenceGapHandler(onNotificationSequenceGap func(err error)) {
+	c.notificationSubscriptionsLock.Lock()
+	defer c.notificationSubscriptionsLock.Unlock()
+
+	c.onNotificationSequenceGap = onNotificationSequenceGap
+}
+
+func (c *RPCClient) handleNotificationSequenceGap(err error) {
+	c.notificationSubscriptionsLock.Lock()
+	onNotificationSequenceGap := c.onNotificationSequenceGap
+	c.notificationSubscriptionsLock.Unlock()
+
+	if onNotificationSequenceGap == nil {
+		log.Warnf("%s", err)
+		return
+	}
+	onNotificationSequenceGap(err)
+}
+
+// subscribe registers for a notification, and keeps the registration so that it's
+// renewed whenever the client reconnects. Registering for the same notification
+// again replaces the kept registration
+func (c *RPCClient) subscribe(requestCommand appmessage.MessageCommand, register func() error) error {
+	err := register()
+	if err != nil {
+		return err
+	}
+
+	c.notificationSubscriptionsLock.Lock()
+	defer c.notificationSubscriptionsLock.Unlock()
+
+	c.notificationSubscriptions[requestCommand] = func(_ bool) error { return register() }
+	return nil
+}
+
+// resumeNotifications renews all the notification subscriptions after a reconnect. Resumable
+// subscriptions also pass the notifications that were missed in the meantime to their handlers
+func (c *RPCClient) resumeNotifications(isNodeRestarted bool) error {
+	c.notificationSubscriptionsLock.Lock()
+	resubscribeFuncs := make([]resubscribeFunc, 0, len(c.notificationSubscriptions))
+	for _, resubscribe := range c.notificationSubscriptions {
+		resubscribeFuncs = append(resubscribeFuncs, resubscribe)
+	}
+	c.notificationSubscriptionsLock.Unlock()
+
+	for _, resubscribe := range resubscribeFuncs {
+		err := resubscribe(isNodeRestarted)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
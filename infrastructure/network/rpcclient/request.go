@@ -0,0 +1,100 @@
+package rpcclient
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	routerpkg "github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// maxRequestRetries is the number of times an idempotent request is resent after
+// the connection was lost while it was in flight
+const maxRequestRetries = 3
+
+// idempotentRequestCommands are the requests that don't change the node's state,
+// so resending them after the connection was lost is harmless
+var idempotentRequestCommands = map[appmessage.MessageCommand]struct{}{
+	appmessage.CmdGetCurrentNetworkRequestMessage:                      {},
+	appmessage.CmdGetBlockTemplateRequestMessage:                       {},
+	appmessage.CmdGetPeerAddressesRequestMessage:                       {},
+	appmessage.CmdGetSelectedTipHashRequestMessage:                     {},
+	appmessage.CmdGetMempoolEntryRequestMessage:                        {},
+	appmessage.CmdGetMempoolEntriesRequestMessage:                      {},
+	appmessage.CmdGetMempoolEntriesByAddressesRequestMessage:           {},
+	appmessage.CmdGetMempoolInfoRequestMessage:                         {},
+	appmessage.CmdGetConnectedPeerInfoRequestMessage:                   {},
+	appmessage.CmdGetBlockRequestMessage:                               {},
+	appmessage.CmdGetSubnetworkRequestMessage:                          {},
+	appmessage.CmdGetVirtualSelectedParentChainFromBlockRequestMessage: {},
+	appmessage.CmdGetBlocksRequestMessage:                              {},
+	appmessage.CmdGetBlockCountRequestMessage:                          {},
+	appmessage.CmdGetBlockDAGInfoRequestMessage:                        {},
+	appmessage.CmdGetHeadersRequestMessage:                             {},
+	appmessage.CmdGetUTXOsByAddressesRequestMessage:                    {},
+	appmessage.CmdGetBalanceByAddressRequestMessage:                    {},
+	appmessage.CmdGetBalancesByAddressesRequestMessage:                 {},
+	appmessage.CmdGetVirtualSelectedParentBlueScoreRequestMessage:      {},
+	appmessage.CmdGetInfoRequestMessage:                                {},
+	appmessage.CmdEstimateNetworkHashesPerSecondRequestMessage:         {},
+	appmessage.CmdGetCoinSupplyRequestMessage:                          {},
+	appmessage.CmdGetTransactionRequestMessage:                         {},
+	appmessage.CmdGetAddressHistoryRequestMessage:                      {},
+	appmessage.CmdGetFeeEstimateRequestMessage:                         {},
+}
+
+// ErrClientClosed is returned by requests made after the client was closed
+var ErrClientClosed = errors.New("the RPC client is closed")
+
+// call sends the given request and waits for the response with the given command.
+// While the client is reconnecting, the request waits for the connection to be
+// reestablished. Idempotent requests are resent if the connection is lost before
+// their response arrives
+func (c *RPCClient) call(request appmessage.Message, responseCommand appmessage.MessageCommand) (appmessage.Message, error) {
+	_, isIdempotent := idempotentRequestCommands[request.Command()]
+	for retries := 0; ; retries++ {
+		rpcRouter, err := c.waitForConnection()
+		if err != nil {
+			return nil, err
+		}
+
+		err = rpcRouter.outgoingRoute().Enqueue(request)
+		if err == nil {
+			var response appmessage.Message
+			response, err = rpcRouter.routes[responseCommand].DequeueWithTimeout(c.timeout)
+			if err == nil {
+				return response, nil
+			}
+		}
+
+		if !errors.Is(err, routerpkg.ErrRouteClosed) || !isIdempotent || retries == maxRequestRetries {
+			return nil, err
+		}
+		log.Debugf("The connection to %s was lost during a %s, retrying", c.rpcAddress, request.Command())
+	}
+}
+
+// waitForConnection returns the router of the current connection, waiting up to
+// the client's timeout for it to be reestablished if needed
+func (c *RPCClient) waitForConnection() (*rpcRouter, error) {
+	c.connectionLock.RLock()
+	rpcRouter := c.rpcRouter
+	connectedChan := c.connectedChan
+	isConnected := atomic.LoadUint32(&c.isConnected) == 1
+	c.connectionLock.RUnlock()
+
+	if atomic.LoadUint32(&c.isClosed) == 1 {
+		return nil, errors.WithStack(ErrClientClosed)
+	}
+	if isConnected {
+		return rpcRouter, nil
+	}
+
+	select {
+	case <-connectedChan:
+	case <-time.After(c.timeout):
+		return nil, errors.Wrapf(routerpkg.ErrTimeout, "could not reconnect to %s after %s", c.rpcAddress, c.timeout)
+	}
+	return c.waitForConnection()
+}
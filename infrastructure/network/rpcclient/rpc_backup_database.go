@@ -0,0 +1,17 @@
+package rpcclient
+
+import "github.com/wombatlabs/coinsecd/app/appmessage"
+
+// BackupDatabase sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) BackupDatabase(targetDirectory string) (*appmessage.BackupDatabaseResponseMessage, error) {
+	response, err := c.call(appmessage.NewBackupDatabaseRequestMessage(targetDirectory),
+		appmessage.CmdBackupDatabaseResponseMessage)
+	if err != nil {
+		return nil, err
+	}
+	backupDatabaseResponse := response.(*appmessage.BackupDatabaseResponseMessage)
+	if backupDatabaseResponse.Error != nil {
+		return nil, c.convertRPCError(backupDatabaseResponse.Error)
+	}
+	return backupDatabaseResponse, nil
+}
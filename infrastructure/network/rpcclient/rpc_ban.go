@@ -4,11 +4,7 @@ import "github.com/wombatlabs/coinsecd/app/appmessage"
 
 // Ban sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) Ban(ip string) (*appmessage.BanResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewBanRequestMessage(ip))
-	if err != nil {
-		return nil, err
-	}
-	response, err := c.route(appmessage.CmdBanRequestMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(appmessage.NewBanRequestMessage(ip), appmessage.CmdBanRequestMessage)
 	if err != nil {
 		return nil, err
 	}
@@ -4,11 +4,7 @@ import "github.com/wombatlabs/coinsecd/app/appmessage"
 
 // AddPeer sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) AddPeer(address string, isPermanent bool) error {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewAddPeerRequestMessage(address, isPermanent))
-	if err != nil {
-		return err
-	}
-	response, err := c.route(appmessage.CmdAddPeerResponseMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(appmessage.NewAddPeerRequestMessage(address, isPermanent), appmessage.CmdAddPeerResponseMessage)
 	if err != nil {
 		return err
 	}
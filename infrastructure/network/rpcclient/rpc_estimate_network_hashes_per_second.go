@@ -4,11 +4,7 @@ import "github.com/wombatlabs/coinsecd/app/appmessage"
 
 // EstimateNetworkHashesPerSecond sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) EstimateNetworkHashesPerSecond(startHash string, windowSize uint32) (*appmessage.EstimateNetworkHashesPerSecondResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewEstimateNetworkHashesPerSecondRequestMessage(startHash, windowSize))
-	if err != nil {
-		return nil, err
-	}
-	response, err := c.route(appmessage.CmdEstimateNetworkHashesPerSecondResponseMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(appmessage.NewEstimateNetworkHashesPerSecondRequestMessage(startHash, windowSize), appmessage.CmdEstimateNetworkHashesPerSecondResponseMessage)
 	if err != nil {
 		return nil, err
 	}
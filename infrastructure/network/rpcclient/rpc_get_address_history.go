@@ -0,0 +1,16 @@
+package rpcclient
+
+import "github.com/wombatlabs/coinsecd/app/appmessage"
+
+// GetAddressHistory sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetAddressHistory(address string, cursor string, limit uint32) (*appmessage.GetAddressHistoryResponseMessage, error) {
+	response, err := c.call(appmessage.NewGetAddressHistoryRequestMessage(address, cursor, limit), appmessage.CmdGetAddressHistoryResponseMessage)
+	if err != nil {
+		return nil, err
+	}
+	getAddressHistoryResponse := response.(*appmessage.GetAddressHistoryResponseMessage)
+	if getAddressHistoryResponse.Error != nil {
+		return nil, c.convertRPCError(getAddressHistoryResponse.Error)
+	}
+	return getAddressHistoryResponse, nil
+}
@@ -6,12 +6,8 @@ import "github.com/wombatlabs/coinsecd/app/appmessage"
 func (c *RPCClient) GetBlock(hash string, includeTransactions bool) (
 	*appmessage.GetBlockResponseMessage, error) {
 
-	err := c.rpcRouter.outgoingRoute().Enqueue(
-		appmessage.NewGetBlockRequestMessage(hash, includeTransactions))
-	if err != nil {
-		return nil, err
-	}
-	response, err := c.route(appmessage.CmdGetBlockResponseMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(
+		appmessage.NewGetBlockRequestMessage(hash, includeTransactions), appmessage.CmdGetBlockResponseMessage)
 	if err != nil {
 		return nil, err
 	}
@@ -4,11 +4,7 @@ import "github.com/wombatlabs/coinsecd/app/appmessage"
 
 // GetBlockCount sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) GetBlockCount() (*appmessage.GetBlockCountResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetBlockCountRequestMessage())
-	if err != nil {
-		return nil, err
-	}
-	response, err := c.route(appmessage.CmdGetBlockCountResponseMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(appmessage.NewGetBlockCountRequestMessage(), appmessage.CmdGetBlockCountResponseMessage)
 	if err != nil {
 		return nil, err
 	}
@@ -6,12 +6,8 @@ import "github.com/wombatlabs/coinsecd/app/appmessage"
 func (c *RPCClient) GetBlocks(lowHash string, includeBlocks bool,
 	includeTransactions bool) (*appmessage.GetBlocksResponseMessage, error) {
 
-	err := c.rpcRouter.outgoingRoute().Enqueue(
-		appmessage.NewGetBlocksRequestMessage(lowHash, includeBlocks, includeTransactions))
-	if err != nil {
-		return nil, err
-	}
-	response, err := c.route(appmessage.CmdGetBlocksResponseMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(
+		appmessage.NewGetBlocksRequestMessage(lowHash, includeBlocks, includeTransactions), appmessage.CmdGetBlocksResponseMessage)
 	if err != nil {
 		return nil, err
 	}
@@ -5,12 +5,8 @@ import "github.com/wombatlabs/coinsecd/app/appmessage"
 // GetVirtualSelectedParentChainFromBlock sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) GetVirtualSelectedParentChainFromBlock(startHash string, includeAcceptedTransactionIDs bool) (
 	*appmessage.GetVirtualSelectedParentChainFromBlockResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(
-		appmessage.NewGetVirtualSelectedParentChainFromBlockRequestMessage(startHash, includeAcceptedTransactionIDs))
-	if err != nil {
-		return nil, err
-	}
-	response, err := c.route(appmessage.CmdGetVirtualSelectedParentChainFromBlockResponseMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(
+		appmessage.NewGetVirtualSelectedParentChainFromBlockRequestMessage(startHash, includeAcceptedTransactionIDs), appmessage.CmdGetVirtualSelectedParentChainFromBlockResponseMessage)
 	if err != nil {
 		return nil, err
 	}
@@ -4,11 +4,7 @@ import "github.com/wombatlabs/coinsecd/app/appmessage"
 
 // GetCoinSupply sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) GetCoinSupply() (*appmessage.GetCoinSupplyResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetCoinSupplyRequestMessage())
-	if err != nil {
-		return nil, err
-	}
-	response, err := c.route(appmessage.CmdGetCoinSupplyResponseMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(appmessage.NewGetCoinSupplyRequestMessage(), appmessage.CmdGetCoinSupplyResponseMessage)
 	if err != nil {
 		return nil, err
 	}
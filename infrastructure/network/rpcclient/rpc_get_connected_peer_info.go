@@ -4,11 +4,7 @@ import "github.com/wombatlabs/coinsecd/app/appmessage"
 
 // GetConnectedPeerInfo sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) GetConnectedPeerInfo() (*appmessage.GetConnectedPeerInfoResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetConnectedPeerInfoRequestMessage())
-	if err != nil {
-		return nil, err
-	}
-	response, err := c.route(appmessage.CmdGetConnectedPeerInfoResponseMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(appmessage.NewGetConnectedPeerInfoRequestMessage(), appmessage.CmdGetConnectedPeerInfoResponseMessage)
 	if err != nil {
 		return nil, err
 	}
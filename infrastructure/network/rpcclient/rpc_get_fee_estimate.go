@@ -0,0 +1,16 @@
+package rpcclient
+
+import "github.com/wombatlabs/coinsecd/app/appmessage"
+
+// GetFeeEstimate sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetFeeEstimate() (*appmessage.GetFeeEstimateResponseMessage, error) {
+	response, err := c.call(appmessage.NewGetFeeEstimateRequestMessage(), appmessage.CmdGetFeeEstimateResponseMessage)
+	if err != nil {
+		return nil, err
+	}
+	getFeeEstimateResponse := response.(*appmessage.GetFeeEstimateResponseMessage)
+	if getFeeEstimateResponse.Error != nil {
+		return nil, c.convertRPCError(getFeeEstimateResponse.Error)
+	}
+	return getFeeEstimateResponse, nil
+}
@@ -4,11 +4,7 @@ import "github.com/wombatlabs/coinsecd/app/appmessage"
 
 // GetInfo sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) GetInfo() (*appmessage.GetInfoResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetInfoRequestMessage())
-	if err != nil {
-		return nil, err
-	}
-	response, err := c.route(appmessage.CmdGetInfoResponseMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(appmessage.NewGetInfoRequestMessage(), appmessage.CmdGetInfoResponseMessage)
 	if err != nil {
 		return nil, err
 	}
@@ -4,11 +4,7 @@ import "github.com/wombatlabs/coinsecd/app/appmessage"
 
 // GetMempoolEntry sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) GetMempoolEntry(txID string, includeOrphanPool bool, filterTransactionPool bool) (*appmessage.GetMempoolEntryResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetMempoolEntryRequestMessage(txID, includeOrphanPool, filterTransactionPool))
-	if err != nil {
-		return nil, err
-	}
-	response, err := c.route(appmessage.CmdGetMempoolEntryResponseMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(appmessage.NewGetMempoolEntryRequestMessage(txID, includeOrphanPool, filterTransactionPool), appmessage.CmdGetMempoolEntryResponseMessage)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,16 @@
+package rpcclient
+
+import "github.com/wombatlabs/coinsecd/app/appmessage"
+
+// GetMempoolInfo sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetMempoolInfo() (*appmessage.GetMempoolInfoResponseMessage, error) {
+	response, err := c.call(appmessage.NewGetMempoolInfoRequestMessage(), appmessage.CmdGetMempoolInfoResponseMessage)
+	if err != nil {
+		return nil, err
+	}
+	getMempoolInfoResponse := response.(*appmessage.GetMempoolInfoResponseMessage)
+	if getMempoolInfoResponse.Error != nil {
+		return nil, c.convertRPCError(getMempoolInfoResponse.Error)
+	}
+	return getMempoolInfoResponse, nil
+}
@@ -0,0 +1,16 @@
+package rpcclient
+
+import "github.com/wombatlabs/coinsecd/app/appmessage"
+
+// GetRPCUsage sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetRPCUsage() (*appmessage.GetRPCUsageResponseMessage, error) {
+	response, err := c.call(appmessage.NewGetRPCUsageRequestMessage(), appmessage.CmdGetRPCUsageResponseMessage)
+	if err != nil {
+		return nil, err
+	}
+	getRPCUsageResponse := response.(*appmessage.GetRPCUsageResponseMessage)
+	if getRPCUsageResponse.Error != nil {
+		return nil, c.convertRPCError(getRPCUsageResponse.Error)
+	}
+	return getRPCUsageResponse, nil
+}
@@ -4,11 +4,7 @@ import "github.com/wombatlabs/coinsecd/app/appmessage"
 
 // GetSelectedTipHash sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) GetSelectedTipHash() (*appmessage.GetSelectedTipHashResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetSelectedTipHashRequestMessage())
-	if err != nil {
-		return nil, err
-	}
-	response, err := c.route(appmessage.CmdGetSelectedTipHashResponseMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(appmessage.NewGetSelectedTipHashRequestMessage(), appmessage.CmdGetSelectedTipHashResponseMessage)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,16 @@
+package rpcclient
+
+import "github.com/wombatlabs/coinsecd/app/appmessage"
+
+// GetTransaction sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetTransaction(transactionID string) (*appmessage.GetTransactionResponseMessage, error) {
+	response, err := c.call(appmessage.NewGetTransactionRequestMessage(transactionID), appmessage.CmdGetTransactionResponseMessage)
+	if err != nil {
+		return nil, err
+	}
+	getTransactionResponse := response.(*appmessage.GetTransactionResponseMessage)
+	if getTransactionResponse.Error != nil {
+		return nil, c.convertRPCError(getTransactionResponse.Error)
+	}
+	return getTransactionResponse, nil
+}
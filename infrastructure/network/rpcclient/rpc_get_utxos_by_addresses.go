@@ -4,11 +4,7 @@ import "github.com/wombatlabs/coinsecd/app/appmessage"
 
 // GetUTXOsByAddresses sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) GetUTXOsByAddresses(addresses []string) (*appmessage.GetUTXOsByAddressesResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetUTXOsByAddressesRequestMessage(addresses))
-	if err != nil {
-		return nil, err
-	}
-	response, err := c.route(appmessage.CmdGetUTXOsByAddressesResponseMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(appmessage.NewGetUTXOsByAddressesRequestMessage(addresses), appmessage.CmdGetUTXOsByAddressesResponseMessage)
 	if err != nil {
 		return nil, err
 	}
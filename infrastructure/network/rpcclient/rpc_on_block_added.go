@@ -7,13 +7,17 @@ import (
 )
 
 // RegisterForBlockAddedNotifications sends an RPC request respective to the function's name and returns the RPC server's response.
-// Additionally, it starts listening for the appropriate notification using the given handler function
+// Additionally, it starts listening for the appropriate notification using the given handler function.
+// The subscription is renewed automatically when the client reconnects
 func (c *RPCClient) RegisterForBlockAddedNotifications(onBlockAdded func(notification *appmessage.BlockAddedNotificationMessage)) error {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewNotifyBlockAddedRequestMessage())
-	if err != nil {
-		return err
-	}
-	response, err := c.route(appmessage.CmdNotifyBlockAddedResponseMessage).DequeueWithTimeout(c.timeout)
+
+	return c.subscribe(appmessage.CmdNotifyBlockAddedRequestMessage, func() error {
+		return c.registerForBlockAddedNotifications(onBlockAdded)
+	})
+}
+
+func (c *RPCClient) registerForBlockAddedNotifications(onBlockAdded func(notification *appmessage.BlockAddedNotificationMessage)) error {
+	response, err := c.call(appmessage.NewNotifyBlockAddedRequestMessage(), appmessage.CmdNotifyBlockAddedResponseMessage)
 	if err != nil {
 		return err
 	}
@@ -21,9 +25,10 @@ func (c *RPCClient) RegisterForBlockAddedNotifications(onBlockAdded func(notific
 	if notifyBlockAddedResponse.Error != nil {
 		return c.convertRPCError(notifyBlockAddedResponse.Error)
 	}
+	route := c.route(appmessage.CmdBlockAddedNotificationMessage)
 	spawn("RegisterForBlockAddedNotifications", func() {
 		for {
-			notification, err := c.route(appmessage.CmdBlockAddedNotificationMessage).Dequeue()
+			notification, err := route.Dequeue()
 			if err != nil {
 				if errors.Is(err, routerpkg.ErrRouteClosed) {
 					break
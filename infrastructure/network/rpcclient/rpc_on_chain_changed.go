@@ -6,27 +6,65 @@ import (
 	"github.com/pkg/errors"
 )
 
+type virtualSelectedParentChainChangedSubscription struct {
+	includeAcceptedTransactionIDs bool
+	onChainChanged                func(notification *appmessage.VirtualSelectedParentChainChangedNotificationMessage)
+	lastSequence                  uint64
+}
+
 // RegisterForVirtualSelectedParentChainChangedNotifications sends an RPC request respective to the function's name and returns the RPC server's response.
-// Additionally, it starts listening for the appropriate notification using the given handler function
+// Additionally, it starts listening for the appropriate notification using the given handler function.
+// Subsequent calls replace the handler.
+// The subscription is resumed automatically when the client reconnects
 func (c *RPCClient) RegisterForVirtualSelectedParentChainChangedNotifications(includeAcceptedTransactionIDs bool,
 	onChainChanged func(notification *appmessage.VirtualSelectedParentChainChangedNotificationMessage)) error {
 
-	err := c.rpcRouter.outgoingRoute().Enqueue(
+	notifyChainChangedResponse, err := c.notifyVirtualSelectedParentChainChanged(
 		appmessage.NewNotifyVirtualSelectedParentChainChangedRequestMessage(includeAcceptedTransactionIDs))
 	if err != nil {
 		return err
 	}
-	response, err := c.route(appmessage.CmdNotifyVirtualSelectedParentChainChangedResponseMessage).DequeueWithTimeout(c.timeout)
+
+	c.notificationSubscriptionsLock.Lock()
+	defer c.notificationSubscriptionsLock.Unlock()
+
+	if c.virtualSelectedParentChainChangedSubscription != nil {
+		c.virtualSelectedParentChainChangedSubscription.includeAcceptedTransactionIDs = includeAcceptedTransactionIDs
+		c.virtualSelectedParentChainChangedSubscription.onChainChanged = onChainChanged
+		return nil
+	}
+	c.virtualSelectedParentChainChangedSubscription = &virtualSelectedParentChainChangedSubscription{
+		includeAcceptedTransactionIDs: includeAcceptedTransactionIDs,
+		onChainChanged:                onChainChanged,
+		lastSequence:                  notifyChainChangedResponse.Sequence,
+	}
+	c.notificationSubscriptions[appmessage.CmdNotifyVirtualSelectedParentChainChangedRequestMessage] =
+		c.resumeVirtualSelectedParentChainChangedNotifications
+	c.listenToVirtualSelectedParentChainChangedNotifications()
+	return nil
+}
+
+func (c *RPCClient) notifyVirtualSelectedParentChainChanged(
+	request *appmessage.NotifyVirtualSelectedParentChainChangedRequestMessage) (
+	*appmessage.NotifyVirtualSelectedParentChainChangedResponseMessage, error) {
+
+	response, err := c.call(request, appmessage.CmdNotifyVirtualSelectedParentChainChangedResponseMessage)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	notifyChainChangedResponse := response.(*appmessage.NotifyVirtualSelectedParentChainChangedResponseMessage)
 	if notifyChainChangedResponse.Error != nil {
-		return c.convertRPCError(notifyChainChangedResponse.Error)
+		return nil, c.convertRPCError(notifyChainChangedResponse.Error)
 	}
+	return notifyChainChangedResponse, nil
+}
+
+func (c *RPCClient) listenToVirtualSelectedParentChainChangedNotifications() {
+	// The route is taken once, so that this goroutine ends along with the connection
+	route := c.route(appmessage.CmdVirtualSelectedParentChainChangedNotificationMessage)
 	spawn("RegisterForVirtualSelectedParentChainChangedNotifications", func() {
 		for {
-			notification, err := c.route(appmessage.CmdVirtualSelectedParentChainChangedNotificationMessage).Dequeue()
+			notification, err := route.Dequeue()
 			if err != nil {
 				if errors.Is(err, routerpkg.ErrRouteClosed) {
 					break
@@ -34,8 +72,54 @@ func (c *RPCClient) RegisterForVirtualSelectedParentChainChangedNotifications(in
 				panic(err)
 			}
 			ChainChangedNotification := notification.(*appmessage.VirtualSelectedParentChainChangedNotificationMessage)
-			onChainChanged(ChainChangedNotification)
+			c.handleVirtualSelectedParentChainChangedNotification(ChainChangedNotification)
 		}
 	})
+}
+
+func (c *RPCClient) handleVirtualSelectedParentChainChangedNotification(
+	notification *appmessage.VirtualSelectedParentChainChangedNotificationMessage) {
+
+	c.notificationSubscriptionsLock.Lock()
+	if notification.Sequence > c.virtualSelectedParentChainChangedSubscription.lastSequence {
+		c.virtualSelectedParentChainChangedSubscription.lastSequence = notification.Sequence
+	}
+	onChainChanged := c.virtualSelectedParentChainChangedSubscription.onChainChanged
+	c.notificationSubscriptionsLock.Unlock()
+
+	onChainChanged(notification)
+}
+
+func (c *RPCClient) resumeVirtualSelectedParentChainChangedNotifications(isNodeRestarted bool) error {
+	c.notificationSubscriptionsLock.Lock()
+	subscription := c.virtualSelectedParentChainChangedSubscription
+	if subscription == nil {
+		c.notificationSubscriptionsLock.Unlock()
+		return nil
+	}
+	request := appmessage.NewNotifyVirtualSelectedParentChainChangedRequestMessage(subscription.includeAcceptedTransactionIDs)
+	// Sequences start over when the node restarts, so there's nothing to resume from
+	request.Resume = !isNodeRestarted
+	request.ResumeAfterSequence = subscription.lastSequence
+	c.notificationSubscriptionsLock.Unlock()
+
+	notifyChainChangedResponse, err := c.notifyVirtualSelectedParentChainChanged(request)
+	if err != nil {
+		return err
+	}
+	for _, notification := range notifyChainChangedResponse.MissedNotifications {
+		c.handleVirtualSelectedParentChainChangedNotification(notification)
+	}
+
+	c.notificationSubscriptionsLock.Lock()
+	subscription.lastSequence = notifyChainChangedResponse.Sequence
+	c.notificationSubscriptionsLock.Unlock()
+
+	if isNodeRestarted || notifyChainChangedResponse.SequenceGap {
+		c.handleNotificationSequenceGap(errors.Wrapf(ErrNotificationSequenceGap,
+			"virtual selected parent chain changed notifications after sequence %d were lost",
+			request.ResumeAfterSequence))
+	}
+	c.listenToVirtualSelectedParentChainChangedNotifications()
 	return nil
 }
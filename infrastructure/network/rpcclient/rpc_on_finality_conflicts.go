@@ -7,16 +7,22 @@ import (
 )
 
 // RegisterForFinalityConflictsNotifications sends an RPC request respective to the function's name and returns the RPC server's response.
-// Additionally, it starts listening for the appropriate notification using the given handler function
+// Additionally, it starts listening for the appropriate notification using the given handler function.
+// The subscription is renewed automatically when the client reconnects
 func (c *RPCClient) RegisterForFinalityConflictsNotifications(
 	onFinalityConflict func(notification *appmessage.FinalityConflictNotificationMessage),
 	onFinalityConflictResolved func(notification *appmessage.FinalityConflictResolvedNotificationMessage)) error {
 
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewNotifyFinalityConflictsRequestMessage())
-	if err != nil {
-		return err
-	}
-	response, err := c.route(appmessage.CmdNotifyFinalityConflictsResponseMessage).DequeueWithTimeout(c.timeout)
+	return c.subscribe(appmessage.CmdNotifyFinalityConflictsRequestMessage, func() error {
+		return c.registerForFinalityConflictsNotifications(onFinalityConflict, onFinalityConflictResolved)
+	})
+}
+
+func (c *RPCClient) registerForFinalityConflictsNotifications(
+	onFinalityConflict func(notification *appmessage.FinalityConflictNotificationMessage),
+	onFinalityConflictResolved func(notification *appmessage.FinalityConflictResolvedNotificationMessage)) error {
+
+	response, err := c.call(appmessage.NewNotifyFinalityConflictsRequestMessage(), appmessage.CmdNotifyFinalityConflictsResponseMessage)
 	if err != nil {
 		return err
 	}
@@ -24,9 +30,10 @@ func (c *RPCClient) RegisterForFinalityConflictsNotifications(
 	if notifyFinalityConflictsResponse.Error != nil {
 		return c.convertRPCError(notifyFinalityConflictsResponse.Error)
 	}
+	finalityConflictRoute := c.route(appmessage.CmdFinalityConflictNotificationMessage)
 	spawn("RegisterForFinalityConflictsNotifications-finalityConflict", func() {
 		for {
-			notification, err := c.route(appmessage.CmdFinalityConflictNotificationMessage).Dequeue()
+			notification, err := finalityConflictRoute.Dequeue()
 			if err != nil {
 				if errors.Is(err, routerpkg.ErrRouteClosed) {
 					break
@@ -37,9 +44,10 @@ func (c *RPCClient) RegisterForFinalityConflictsNotifications(
 			onFinalityConflict(finalityConflictNotification)
 		}
 	})
+	finalityConflictResolvedRoute := c.route(appmessage.CmdFinalityConflictResolvedNotificationMessage)
 	spawn("RegisterForFinalityConflictsNotifications-finalityConflictResolved", func() {
 		for {
-			notification, err := c.route(appmessage.CmdFinalityConflictResolvedNotificationMessage).Dequeue()
+			notification, err := finalityConflictResolvedRoute.Dequeue()
 			if err != nil {
 				if errors.Is(err, routerpkg.ErrRouteClosed) {
 					break
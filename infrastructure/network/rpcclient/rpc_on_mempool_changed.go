@@ -0,0 +1,46 @@
+package rpcclient
+
+import (
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	routerpkg "github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// RegisterForMempoolChangedNotifications sends an RPC request respective to the function's name and returns the RPC server's response.
+// Additionally, it starts listening for the appropriate notification using the given handler function.
+// The subscription is renewed automatically when the client reconnects
+func (c *RPCClient) RegisterForMempoolChangedNotifications(addresses []string,
+	onMempoolChanged func(notification *appmessage.MempoolChangedNotificationMessage)) error {
+
+	return c.subscribe(appmessage.CmdNotifyMempoolChangedRequestMessage, func() error {
+		return c.registerForMempoolChangedNotifications(addresses, onMempoolChanged)
+	})
+}
+
+func (c *RPCClient) registerForMempoolChangedNotifications(addresses []string,
+	onMempoolChanged func(notification *appmessage.MempoolChangedNotificationMessage)) error {
+
+	response, err := c.call(appmessage.NewNotifyMempoolChangedRequestMessage(addresses), appmessage.CmdNotifyMempoolChangedResponseMessage)
+	if err != nil {
+		return err
+	}
+	notifyMempoolChangedResponse := response.(*appmessage.NotifyMempoolChangedResponseMessage)
+	if notifyMempoolChangedResponse.Error != nil {
+		return c.convertRPCError(notifyMempoolChangedResponse.Error)
+	}
+	route := c.route(appmessage.CmdMempoolChangedNotificationMessage)
+	spawn("RegisterForMempoolChangedNotifications", func() {
+		for {
+			notification, err := route.Dequeue()
+			if err != nil {
+				if errors.Is(err, routerpkg.ErrRouteClosed) {
+					break
+				}
+				panic(err)
+			}
+			mempoolChangedNotification := notification.(*appmessage.MempoolChangedNotificationMessage)
+			onMempoolChanged(mempoolChangedNotification)
+		}
+	})
+	return nil
+}
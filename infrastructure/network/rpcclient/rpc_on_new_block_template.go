@@ -7,13 +7,17 @@ import (
 )
 
 // RegisterForNewBlockTemplateNotifications sends an RPC request respective to the function's name and returns the RPC server's response.
-// Additionally, it starts listening for the appropriate notification using the given handler function
+// Additionally, it starts listening for the appropriate notification using the given handler function.
+// The subscription is renewed automatically when the client reconnects
 func (c *RPCClient) RegisterForNewBlockTemplateNotifications(onNewBlockTemplate func(notification *appmessage.NewBlockTemplateNotificationMessage)) error {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewNotifyNewBlockTemplateRequestMessage())
-	if err != nil {
-		return err
-	}
-	response, err := c.route(appmessage.CmdNotifyNewBlockTemplateResponseMessage).DequeueWithTimeout(c.timeout)
+
+	return c.subscribe(appmessage.CmdNotifyNewBlockTemplateRequestMessage, func() error {
+		return c.registerForNewBlockTemplateNotifications(onNewBlockTemplate)
+	})
+}
+
+func (c *RPCClient) registerForNewBlockTemplateNotifications(onNewBlockTemplate func(notification *appmessage.NewBlockTemplateNotificationMessage)) error {
+	response, err := c.call(appmessage.NewNotifyNewBlockTemplateRequestMessage(), appmessage.CmdNotifyNewBlockTemplateResponseMessage)
 	if err != nil {
 		return err
 	}
@@ -21,9 +25,10 @@ func (c *RPCClient) RegisterForNewBlockTemplateNotifications(onNewBlockTemplate
 	if notifyNewBlockTemplateResponse.Error != nil {
 		return c.convertRPCError(notifyNewBlockTemplateResponse.Error)
 	}
+	route := c.route(appmessage.CmdNewBlockTemplateNotificationMessage)
 	spawn("RegisterForNewBlockTemplateNotifications", func() {
 		for {
-			notification, err := c.route(appmessage.CmdNewBlockTemplateNotificationMessage).Dequeue()
+			notification, err := route.Dequeue()
 			if err != nil {
 				if errors.Is(err, routerpkg.ErrRouteClosed) {
 					break
@@ -7,14 +7,18 @@ import (
 )
 
 // RegisterPruningPointUTXOSetNotifications sends an RPC request respective to the function's name and returns the RPC server's response.
-// Additionally, it starts listening for the appropriate notification using the given handler function
+// Additionally, it starts listening for the appropriate notification using the given handler function.
+// The subscription is renewed automatically when the client reconnects
 func (c *RPCClient) RegisterPruningPointUTXOSetNotifications(onPruningPointUTXOSetNotifications func()) error {
 
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewNotifyPruningPointUTXOSetOverrideRequestMessage())
-	if err != nil {
-		return err
-	}
-	response, err := c.route(appmessage.CmdNotifyPruningPointUTXOSetOverrideResponseMessage).DequeueWithTimeout(c.timeout)
+	return c.subscribe(appmessage.CmdNotifyPruningPointUTXOSetOverrideRequestMessage, func() error {
+		return c.registerPruningPointUTXOSetNotifications(onPruningPointUTXOSetNotifications)
+	})
+}
+
+func (c *RPCClient) registerPruningPointUTXOSetNotifications(onPruningPointUTXOSetNotifications func()) error {
+
+	response, err := c.call(appmessage.NewNotifyPruningPointUTXOSetOverrideRequestMessage(), appmessage.CmdNotifyPruningPointUTXOSetOverrideResponseMessage)
 	if err != nil {
 		return err
 	}
@@ -22,9 +26,10 @@ func (c *RPCClient) RegisterPruningPointUTXOSetNotifications(onPruningPointUTXOS
 	if notifyPruningPointUTXOSetOverrideResponse.Error != nil {
 		return c.convertRPCError(notifyPruningPointUTXOSetOverrideResponse.Error)
 	}
+	route := c.route(appmessage.CmdPruningPointUTXOSetOverrideNotificationMessage)
 	spawn("RegisterPruningPointUTXOSetNotifications", func() {
 		for {
-			notification, err := c.route(appmessage.CmdPruningPointUTXOSetOverrideNotificationMessage).Dequeue()
+			notification, err := route.Dequeue()
 			if err != nil {
 				if errors.Is(err, routerpkg.ErrRouteClosed) {
 					break
@@ -39,14 +44,11 @@ func (c *RPCClient) RegisterPruningPointUTXOSetNotifications(onPruningPointUTXOS
 }
 
 // UnregisterPruningPointUTXOSetNotifications sends an RPC request respective to the function's name and returns the RPC server's response.
-// Additionally, it stops listening for the appropriate notification using the given handler function
+// Additionally, it stops listening for the appropriate notification using the given handler function,
+// and stops renewing the subscription when the client reconnects
 func (c *RPCClient) UnregisterPruningPointUTXOSetNotifications() error {
 
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewStopNotifyingPruningPointUTXOSetOverrideRequestMessage())
-	if err != nil {
-		return err
-	}
-	response, err := c.route(appmessage.CmdStopNotifyingPruningPointUTXOSetOverrideResponseMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(appmessage.NewStopNotifyingPruningPointUTXOSetOverrideRequestMessage(), appmessage.CmdStopNotifyingPruningPointUTXOSetOverrideResponseMessage)
 	if err != nil {
 		return err
 	}
@@ -54,5 +56,10 @@ func (c *RPCClient) UnregisterPruningPointUTXOSetNotifications() error {
 	if stopNotifyPruningPointUTXOSetOverrideResponse.Error != nil {
 		return c.convertRPCError(stopNotifyPruningPointUTXOSetOverrideResponse.Error)
 	}
+
+	c.notificationSubscriptionsLock.Lock()
+	defer c.notificationSubscriptionsLock.Unlock()
+
+	delete(c.notificationSubscriptions, appmessage.CmdNotifyPruningPointUTXOSetOverrideRequestMessage)
 	return nil
 }
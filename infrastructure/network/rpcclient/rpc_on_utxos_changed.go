@@ -6,26 +6,62 @@ import (
 	"github.com/pkg/errors"
 )
 
+type utxosChangedSubscription struct {
+	addresses      []string
+	onUTXOsChanged func(notification *appmessage.UTXOsChangedNotificationMessage)
+	lastSequence   uint64
+}
+
 // RegisterForUTXOsChangedNotifications sends an RPC request respective to the function's name and returns the RPC server's response.
-// Additionally, it starts listening for the appropriate notification using the given handler function
+// Additionally, it starts listening for the appropriate notification using the given handler function.
+// Subsequent calls add the given addresses to the old ones and replace the handler.
+// The subscription is resumed automatically when the client reconnects
 func (c *RPCClient) RegisterForUTXOsChangedNotifications(addresses []string,
 	onUTXOsChanged func(notification *appmessage.UTXOsChangedNotificationMessage)) error {
 
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewNotifyUTXOsChangedRequestMessage(addresses))
+	notifyUTXOsChangedResponse, err := c.notifyUTXOsChanged(appmessage.NewNotifyUTXOsChangedRequestMessage(addresses))
 	if err != nil {
 		return err
 	}
-	response, err := c.route(appmessage.CmdNotifyUTXOsChangedResponseMessage).DequeueWithTimeout(c.timeout)
+
+	c.notificationSubscriptionsLock.Lock()
+	defer c.notificationSubscriptionsLock.Unlock()
+
+	if c.utxosChangedSubscription != nil {
+		c.utxosChangedSubscription.addresses = append(c.utxosChangedSubscription.addresses, addresses...)
+		c.utxosChangedSubscription.onUTXOsChanged = onUTXOsChanged
+		return nil
+	}
+	c.utxosChangedSubscription = &utxosChangedSubscription{
+		addresses:      addresses,
+		onUTXOsChanged: onUTXOsChanged,
+		lastSequence:   notifyUTXOsChangedResponse.Sequence,
+	}
+	c.notificationSubscriptions[appmessage.CmdNotifyUTXOsChangedRequestMessage] = c.resumeUTXOsChangedNotifications
+	c.listenToUTXOsChangedNotifications()
+	return nil
+}
+
+func (c *RPCClient) notifyUTXOsChanged(request *appmessage.NotifyUTXOsChangedRequestMessage) (
+	*appmessage.NotifyUTXOsChangedResponseMessage, error) {
+
+	response, err := c.call(request, appmessage.CmdNotifyUTXOsChangedResponseMessage)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	notifyUTXOsChangedResponse := response.(*appmessage.NotifyUTXOsChangedResponseMessage)
 	if notifyUTXOsChangedResponse.Error != nil {
-		return c.convertRPCError(notifyUTXOsChangedResponse.Error)
+		return nil, c.convertRPCError(notifyUTXOsChangedResponse.Error)
 	}
+	return notifyUTXOsChangedResponse, nil
+}
+
+func (c *RPCClient) listenToUTXOsChangedNotifications() {
+	// The route is taken once, so that this goroutine ends along with the connection
+	route := c.route(appmessage.CmdUTXOsChangedNotificationMessage)
 	spawn("RegisterForUTXOsChangedNotifications", func() {
 		for {
-			notification, err := c.route(appmessage.CmdUTXOsChangedNotificationMessage).Dequeue()
+			notification, err := route.Dequeue()
 			if err != nil {
 				if errors.Is(err, routerpkg.ErrRouteClosed) {
 					break
@@ -33,8 +69,51 @@ func (c *RPCClient) RegisterForUTXOsChangedNotifications(addresses []string,
 				panic(err)
 			}
 			UTXOsChangedNotification := notification.(*appmessage.UTXOsChangedNotificationMessage)
-			onUTXOsChanged(UTXOsChangedNotification)
+			c.handleUTXOsChangedNotification(UTXOsChangedNotification)
 		}
 	})
+}
+
+func (c *RPCClient) handleUTXOsChangedNotification(notification *appmessage.UTXOsChangedNotificationMessage) {
+	c.notificationSubscriptionsLock.Lock()
+	if notification.Sequence > c.utxosChangedSubscription.lastSequence {
+		c.utxosChangedSubscription.lastSequence = notification.Sequence
+	}
+	onUTXOsChanged := c.utxosChangedSubscription.onUTXOsChanged
+	c.notificationSubscriptionsLock.Unlock()
+
+	onUTXOsChanged(notification)
+}
+
+func (c *RPCClient) resumeUTXOsChangedNotifications(isNodeRestarted bool) error {
+	c.notificationSubscriptionsLock.Lock()
+	subscription := c.utxosChangedSubscription
+	if subscription == nil {
+		c.notificationSubscriptionsLock.Unlock()
+		return nil
+	}
+	request := appmessage.NewNotifyUTXOsChangedRequestMessage(subscription.addresses)
+	// Sequences start over when the node restarts, so there's nothing to resume from
+	request.Resume = !isNodeRestarted
+	request.ResumeAfterSequence = subscription.lastSequence
+	c.notificationSubscriptionsLock.Unlock()
+
+	notifyUTXOsChangedResponse, err := c.notifyUTXOsChanged(request)
+	if err != nil {
+		return err
+	}
+	for _, notification := range notifyUTXOsChangedResponse.MissedNotifications {
+		c.handleUTXOsChangedNotification(notification)
+	}
+
+	c.notificationSubscriptionsLock.Lock()
+	subscription.lastSequence = notifyUTXOsChangedResponse.Sequence
+	c.notificationSubscriptionsLock.Unlock()
+
+	if isNodeRestarted || notifyUTXOsChangedResponse.SequenceGap {
+		c.handleNotificationSequenceGap(errors.Wrapf(ErrNotificationSequenceGap,
+			"UTXOs changed notifications after sequence %d were lost", request.ResumeAfterSequence))
+	}
+	c.listenToUTXOsChangedNotifications()
 	return nil
 }
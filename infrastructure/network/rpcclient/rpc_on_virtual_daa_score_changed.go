@@ -8,15 +8,20 @@ import (
 
 // RegisterForVirtualDaaScoreChangedNotifications sends an RPC request respective to the function's
 // name and returns the RPC server's response. Additionally, it starts listening for the appropriate notification
-// using the given handler function
+// using the given handler function.
+// The subscription is renewed automatically when the client reconnects
 func (c *RPCClient) RegisterForVirtualDaaScoreChangedNotifications(
 	onVirtualDaaScoreChanged func(notification *appmessage.VirtualDaaScoreChangedNotificationMessage)) error {
 
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewNotifyVirtualDaaScoreChangedRequestMessage())
-	if err != nil {
-		return err
-	}
-	response, err := c.route(appmessage.CmdNotifyVirtualDaaScoreChangedResponseMessage).DequeueWithTimeout(c.timeout)
+	return c.subscribe(appmessage.CmdNotifyVirtualDaaScoreChangedRequestMessage, func() error {
+		return c.registerForVirtualDaaScoreChangedNotifications(onVirtualDaaScoreChanged)
+	})
+}
+
+func (c *RPCClient) registerForVirtualDaaScoreChangedNotifications(
+	onVirtualDaaScoreChanged func(notification *appmessage.VirtualDaaScoreChangedNotificationMessage)) error {
+
+	response, err := c.call(appmessage.NewNotifyVirtualDaaScoreChangedRequestMessage(), appmessage.CmdNotifyVirtualDaaScoreChangedResponseMessage)
 	if err != nil {
 		return err
 	}
@@ -24,9 +29,10 @@ func (c *RPCClient) RegisterForVirtualDaaScoreChangedNotifications(
 	if notifyVirtualDaaScoreChangedResponse.Error != nil {
 		return c.convertRPCError(notifyVirtualDaaScoreChangedResponse.Error)
 	}
+	route := c.route(appmessage.CmdVirtualDaaScoreChangedNotificationMessage)
 	spawn("RegisterForVirtualDaaScoreChangedNotifications", func() {
 		for {
-			notification, err := c.route(appmessage.CmdVirtualDaaScoreChangedNotificationMessage).Dequeue()
+			notification, err := route.Dequeue()
 			if err != nil {
 				if errors.Is(err, routerpkg.ErrRouteClosed) {
 					break
@@ -8,15 +8,20 @@ import (
 
 // RegisterForVirtualSelectedParentBlueScoreChangedNotifications sends an RPC request respective to the function's
 // name and returns the RPC server's response. Additionally, it starts listening for the appropriate notification
-// using the given handler function
+// using the given handler function.
+// The subscription is renewed automatically when the client reconnects
 func (c *RPCClient) RegisterForVirtualSelectedParentBlueScoreChangedNotifications(
 	onVirtualSelectedParentBlueScoreChanged func(notification *appmessage.VirtualSelectedParentBlueScoreChangedNotificationMessage)) error {
 
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewNotifyVirtualSelectedParentBlueScoreChangedRequestMessage())
-	if err != nil {
-		return err
-	}
-	response, err := c.route(appmessage.CmdNotifyVirtualSelectedParentBlueScoreChangedResponseMessage).DequeueWithTimeout(c.timeout)
+	return c.subscribe(appmessage.CmdNotifyVirtualSelectedParentBlueScoreChangedRequestMessage, func() error {
+		return c.registerForVirtualSelectedParentBlueScoreChangedNotifications(onVirtualSelectedParentBlueScoreChanged)
+	})
+}
+
+func (c *RPCClient) registerForVirtualSelectedParentBlueScoreChangedNotifications(
+	onVirtualSelectedParentBlueScoreChanged func(notification *appmessage.VirtualSelectedParentBlueScoreChangedNotificationMessage)) error {
+
+	response, err := c.call(appmessage.NewNotifyVirtualSelectedParentBlueScoreChangedRequestMessage(), appmessage.CmdNotifyVirtualSelectedParentBlueScoreChangedResponseMessage)
 	if err != nil {
 		return err
 	}
@@ -24,9 +29,10 @@ func (c *RPCClient) RegisterForVirtualSelectedParentBlueScoreChangedNotification
 	if notifyVirtualSelectedParentBlueScoreChangedResponse.Error != nil {
 		return c.convertRPCError(notifyVirtualSelectedParentBlueScoreChangedResponse.Error)
 	}
+	route := c.route(appmessage.CmdVirtualSelectedParentBlueScoreChangedNotificationMessage)
 	spawn("RegisterForVirtualSelectedParentBlueScoreChangedNotifications", func() {
 		for {
-			notification, err := c.route(appmessage.CmdVirtualSelectedParentBlueScoreChangedNotificationMessage).Dequeue()
+			notification, err := route.Dequeue()
 			if err != nil {
 				if errors.Is(err, routerpkg.ErrRouteClosed) {
 					break
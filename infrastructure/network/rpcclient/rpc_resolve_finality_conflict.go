@@ -4,11 +4,7 @@ import "github.com/wombatlabs/coinsecd/app/appmessage"
 
 // ResolveFinalityConflict sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) ResolveFinalityConflict(finalityBlockHash string) (*appmessage.ResolveFinalityConflictResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewResolveFinalityConflictRequestMessage(finalityBlockHash))
-	if err != nil {
-		return nil, err
-	}
-	response, err := c.route(appmessage.CmdResolveFinalityConflictResponseMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(appmessage.NewResolveFinalityConflictRequestMessage(finalityBlockHash), appmessage.CmdResolveFinalityConflictResponseMessage)
 	if err != nil {
 		return nil, err
 	}
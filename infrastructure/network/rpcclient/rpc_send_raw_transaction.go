@@ -8,12 +8,17 @@ import (
 
 // SubmitTransaction sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) SubmitTransaction(transaction *appmessage.RPCTransaction, transactionID string, allowOrphan bool) (*appmessage.SubmitTransactionResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewSubmitTransactionRequestMessage(transaction, allowOrphan))
+	// Not retried if the connection is lost, since the node might have already accepted the transaction
+	rpcRouter, err := c.waitForConnection()
+	if err != nil {
+		return nil, err
+	}
+	err = rpcRouter.outgoingRoute().Enqueue(appmessage.NewSubmitTransactionRequestMessage(transaction, allowOrphan))
 	if err != nil {
 		return nil, err
 	}
 	for {
-		response, err := c.route(appmessage.CmdSubmitTransactionResponseMessage).DequeueWithTimeout(c.timeout)
+		response, err := rpcRouter.routes[appmessage.CmdSubmitTransactionResponseMessage].DequeueWithTimeout(c.timeout)
 		if err != nil {
 			return nil, err
 		}
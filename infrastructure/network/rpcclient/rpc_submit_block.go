@@ -6,12 +6,8 @@ import (
 )
 
 func (c *RPCClient) submitBlock(block *externalapi.DomainBlock, allowNonDAABlocks bool) (appmessage.RejectReason, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(
-		appmessage.NewSubmitBlockRequestMessage(appmessage.DomainBlockToRPCBlock(block), allowNonDAABlocks))
-	if err != nil {
-		return appmessage.RejectReasonNone, err
-	}
-	response, err := c.route(appmessage.CmdSubmitBlockResponseMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(
+		appmessage.NewSubmitBlockRequestMessage(appmessage.DomainBlockToRPCBlock(block), allowNonDAABlocks), appmessage.CmdSubmitBlockResponseMessage)
 	if err != nil {
 		return appmessage.RejectReasonNone, err
 	}
@@ -0,0 +1,54 @@
+package rpcclient
+
+import (
+	"strings"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+)
+
+// SubmitTransactionPackage sends an RPC request respective to the function's name and returns the RPC server's response.
+// transactionID is the ID of the last transaction in the package
+func (c *RPCClient) SubmitTransactionPackage(transactions []*appmessage.RPCTransaction, transactionID string) (
+	*appmessage.SubmitTransactionPackageResponseMessage, error) {
+
+	// Not retried if the connection is lost, since the node might have already accepted the transaction
+	rpcRouter, err := c.waitForConnection()
+	if err != nil {
+		return nil, err
+	}
+	err = rpcRouter.outgoingRoute().Enqueue(appmessage.NewSubmitTransactionPackageRequestMessage(transactions))
+	if err != nil {
+		return nil, err
+	}
+	for {
+		response, err := rpcRouter.routes[appmessage.CmdSubmitTransactionPackageResponseMessage].DequeueWithTimeout(c.timeout)
+		if err != nil {
+			return nil, err
+		}
+		submitTransactionPackageResponse := response.(*appmessage.SubmitTransactionPackageResponseMessage)
+		// Match the response to the expected ID. If they are different it means we got an old response which we
+		// previously timed-out on, so we log and continue waiting for the correct current response.
+		if submitTransactionPackageResponse.TransactionID != transactionID {
+			if submitTransactionPackageResponse.Error != nil {
+				// A parse error is returned with an empty ID, so in such a case we fallback to
+				// checking if the error contains the expected ID
+				if submitTransactionPackageResponse.TransactionID != "" ||
+					!strings.Contains(submitTransactionPackageResponse.Error.Message, transactionID) {
+					log.Warnf("SubmitTransactionPackage: received an error response for previous request: %s",
+						submitTransactionPackageResponse.Error)
+					continue
+				}
+
+			} else {
+				log.Warnf("SubmitTransactionPackage: received a successful response for previous request with ID %s",
+					submitTransactionPackageResponse.TransactionID)
+				continue
+			}
+		}
+		if submitTransactionPackageResponse.Error != nil {
+			return nil, c.convertRPCError(submitTransactionPackageResponse.Error)
+		}
+
+		return submitTransactionPackageResponse, nil
+	}
+}
@@ -4,11 +4,7 @@ import "github.com/wombatlabs/coinsecd/app/appmessage"
 
 // Unban sends an RPC request respective to the function's name and returns the RPC server's response
 func (c *RPCClient) Unban(ip string) (*appmessage.UnbanResponseMessage, error) {
-	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewUnbanRequestMessage(ip))
-	if err != nil {
-		return nil, err
-	}
-	response, err := c.route(appmessage.CmdUnbanRequestMessage).DequeueWithTimeout(c.timeout)
+	response, err := c.call(appmessage.NewUnbanRequestMessage(ip), appmessage.CmdUnbanRequestMessage)
 	if err != nil {
 		return nil, err
 	}
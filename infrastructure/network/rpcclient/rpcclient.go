@@ -1,6 +1,8 @@
 package rpcclient
 
 import (
+	"crypto/tls"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -11,29 +13,73 @@ import (
 	"github.com/wombatlabs/coinsecd/util/panics"
 	"github.com/wombatlabs/coinsecd/version"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-const defaultTimeout = 30 * time.Second
+const (
+	defaultTimeout           = 30 * time.Second
+	defaultMinReconnectDelay = 500 * time.Millisecond
+	defaultMaxReconnectDelay = 30 * time.Second
+)
 
 // RPCClient is an RPC client
 type RPCClient struct {
 	*grpcclient.GRPCClient
 
-	rpcAddress           string
-	rpcRouter            *rpcRouter
-	isConnected          uint32
-	isClosed             uint32
-	isReconnecting       uint32
-	lastDisconnectedTime time.Time
+	rpcAddress     string
+	tlsConfig      *tls.Config
+	token          string
+	rpcRouter      *rpcRouter
+	isConnected    uint32
+	isClosed       uint32
+	isReconnecting uint32
 
 	timeout time.Duration
+
+	// The P2P ID of the node, which changes when it restarts
+	nodeP2PID string
+
+	notificationSubscriptionsLock                 sync.Mutex
+	notificationSubscriptions                     map[appmessage.MessageCommand]resubscribeFunc
+	utxosChangedSubscription                      *utxosChangedSubscription
+	virtualSelectedParentChainChangedSubscription *virtualSelectedParentChainChangedSubscription
+	onNotificationSequenceGap                     func(err error)
+
+	// connectionLock protects the fields that are replaced on every connection. connectedChan
+	// is closed while the client is connected, and is waited on by requests sent while it isn't
+	connectionLock           sync.RWMutex
+	connectedChan            chan struct{}
+	minReconnectDelay        time.Duration
+	maxReconnectDelay        time.Duration
+	onConnectionStateChanged func(state ConnectionState)
 }
 
 // NewRPCClient сreates a new RPC client with a default call timeout value
 func NewRPCClient(rpcAddress string) (*RPCClient, error) {
+	return NewRPCClientWithTLS(rpcAddress, nil)
+}
+
+// NewRPCClientWithTLS creates a new RPC client that connects over TLS with a
+// default call timeout value. If tlsConfig is nil, the connection is not encrypted
+func NewRPCClientWithTLS(rpcAddress string, tlsConfig *tls.Config) (*RPCClient, error) {
+	return NewRPCClientWithAuth(rpcAddress, tlsConfig, "")
+}
+
+// NewRPCClientWithAuth creates a new RPC client that connects over TLS and authenticates
+// with the given token, with a default call timeout value. If tlsConfig is nil, the
+// connection is not encrypted, and if token is empty, no token is sent
+func NewRPCClientWithAuth(rpcAddress string, tlsConfig *tls.Config, token string) (*RPCClient, error) {
 	rpcClient := &RPCClient{
 		rpcAddress: rpcAddress,
+		tlsConfig:  tlsConfig,
+		token:      token,
 		timeout:    defaultTimeout,
+
+		notificationSubscriptions: make(map[appmessage.MessageCommand]resubscribeFunc),
+		connectedChan:             make(chan struct{}),
+		minReconnectDelay:         defaultMinReconnectDelay,
+		maxReconnectDelay:         defaultMaxReconnectDelay,
 	}
 	err := rpcClient.connect()
 	if err != nil {
@@ -44,29 +90,43 @@ func NewRPCClient(rpcAddress string) (*RPCClient, error) {
 }
 
 func (c *RPCClient) connect() error {
-	rpcClient, err := grpcclient.Connect(c.rpcAddress)
+	rpcClient, err := grpcclient.ConnectWithAuth(c.rpcAddress, c.tlsConfig, c.token)
 	if err != nil {
 		return errors.Wrapf(err, "error connecting to address %s", c.rpcAddress)
 	}
-	rpcClient.SetOnDisconnectedHandler(c.handleClientDisconnected)
-	rpcClient.SetOnErrorHandler(c.handleClientError)
+	// The handlers ignore the errors of connections that were already replaced
+	rpcClient.SetOnDisconnectedHandler(func() { c.handleClientDisconnected(rpcClient) })
+	rpcClient.SetOnErrorHandler(func(err error) { c.handleClientError(rpcClient, err) })
 	rpcRouter, err := buildRPCRouter()
 	if err != nil {
 		return errors.Wrapf(err, "error creating the RPC router")
 	}
 
-	atomic.StoreUint32(&c.isConnected, 1)
 	rpcClient.AttachRouter(rpcRouter.router)
 
+	c.connectionLock.Lock()
+	if atomic.LoadUint32(&c.isClosed) == 1 {
+		c.connectionLock.Unlock()
+		rpcRouter.close()
+		return rpcClient.Close()
+	}
 	c.GRPCClient = rpcClient
 	c.rpcRouter = rpcRouter
+	atomic.StoreUint32(&c.isConnected, 1)
+	close(c.connectedChan)
+	c.connectionLock.Unlock()
 
 	log.Infof("Connected to %s", c.rpcAddress)
 
 	getInfoResponse, err := c.GetInfo()
 	if err != nil {
+		disconnectErr := c.disconnect()
+		if disconnectErr != nil {
+			log.Warnf("Error disconnecting from %s: %s", c.rpcAddress, disconnectErr)
+		}
 		return errors.Wrapf(err, "error making GetInfo request")
 	}
+	c.nodeP2PID = getInfoResponse.P2PID
 
 	localVersion := version.Version()
 	remoteVersion := getInfoResponse.ServerVersion
@@ -78,8 +138,21 @@ func (c *RPCClient) connect() error {
 	return nil
 }
 
+// disconnect closes the current connection, if it's still open. Requests that wait for a
+// response on it fail with routerpkg.ErrRouteClosed
 func (c *RPCClient) disconnect() error {
-	err := c.GRPCClient.Disconnect()
+	c.connectionLock.Lock()
+	defer c.connectionLock.Unlock()
+
+	if atomic.LoadUint32(&c.isConnected) == 0 {
+		return nil
+	}
+	atomic.StoreUint32(&c.isConnected, 0)
+	c.connectedChan = make(chan struct{})
+
+	// Closing the router also closes the stream, once the client's send loop notices it
+	c.rpcRouter.close()
+	err := c.GRPCClient.Close()
 	if err != nil {
 		return err
 	}
@@ -88,7 +161,9 @@ func (c *RPCClient) disconnect() error {
 }
 
 // Reconnect forces the client to attempt to reconnect to the address
-// this client initially was connected to
+// this client initially was connected to. It retries with an exponential backoff
+// until it succeeds or the client is closed, and then resubscribes to all the
+// notifications the client was subscribed to
 func (c *RPCClient) Reconnect() error {
 	if atomic.LoadUint32(&c.isClosed) == 1 {
 		return errors.Errorf("Cannot reconnect from a closed client")
@@ -110,31 +185,61 @@ func (c *RPCClient) Reconnect() error {
 		if err != nil {
 			return err
 		}
+		c.handleConnectionStateChanged(ConnectionStateDisconnected)
 	}
 
 	// Attempt to connect until we succeed
+	previousNodeP2PID := c.nodeP2PID
+	retryDelay := c.minReconnectDelay
 	for {
-		const retryDelay = 10 * time.Second
-		if time.Since(c.lastDisconnectedTime) > retryDelay {
-			err := c.connect()
+		time.Sleep(retryDelay)
+		if atomic.LoadUint32(&c.isClosed) == 1 {
+			return nil
+		}
+
+		err := c.connect()
+		if err == nil {
+			err = c.resumeNotifications(c.nodeP2PID != previousNodeP2PID)
 			if err == nil {
+				c.handleConnectionStateChanged(ConnectionStateConnected)
 				return nil
 			}
-			log.Warnf("Could not automatically reconnect to %s: %s", c.rpcAddress, err)
-			log.Warnf("Retrying in %s", retryDelay)
+			disconnectErr := c.disconnect()
+			if disconnectErr != nil {
+				return disconnectErr
+			}
 		}
-		time.Sleep(retryDelay)
+
+		retryDelay *= 2
+		if retryDelay > c.maxReconnectDelay {
+			retryDelay = c.maxReconnectDelay
+		}
+		log.Warnf("Could not automatically reconnect to %s: %s", c.rpcAddress, err)
+		log.Warnf("Retrying in %s", retryDelay)
 	}
 }
 
-func (c *RPCClient) handleClientDisconnected() {
-	atomic.StoreUint32(&c.isConnected, 0)
+// isCurrentConnection returns whether the given client is the one the RPC client is
+// connected through. Connections that were already closed keep reporting errors for a
+// while, and those are ignored
+func (c *RPCClient) isCurrentConnection(client *grpcclient.GRPCClient) bool {
+	c.connectionLock.RLock()
+	defer c.connectionLock.RUnlock()
+
+	return client == c.GRPCClient && atomic.LoadUint32(&c.isConnected) == 1
+}
+
+func (c *RPCClient) handleClientDisconnected(client *grpcclient.GRPCClient) {
+	if !c.isCurrentConnection(client) {
+		return
+	}
+
 	if atomic.LoadUint32(&c.isClosed) == 0 {
 		err := c.disconnect()
 		if err != nil {
 			panic(err)
 		}
-		c.lastDisconnectedTime = time.Now()
+		c.handleConnectionStateChanged(ConnectionStateDisconnected)
 		err = c.Reconnect()
 		if err != nil {
 			panic(err)
@@ -142,12 +247,21 @@ func (c *RPCClient) handleClientDisconnected() {
 	}
 }
 
-func (c *RPCClient) handleClientError(err error) {
-	if atomic.LoadUint32(&c.isClosed) == 1 {
+func (c *RPCClient) handleClientError(client *grpcclient.GRPCClient, err error) {
+	if atomic.LoadUint32(&c.isClosed) == 1 || !c.isCurrentConnection(client) {
+		return
+	}
+	// Reconnecting with a token that was rejected would be rejected again
+	if status.Code(err) == codes.Unauthenticated {
+		log.Errorf("The RPC server at %s rejected the connection: %s", c.rpcAddress, status.Convert(err).Message())
+		closeErr := c.Close()
+		if closeErr != nil {
+			log.Warnf("Error closing the RPC client: %s", closeErr)
+		}
 		return
 	}
 	log.Warnf("Received error from client: %s", err)
-	c.handleClientDisconnected()
+	c.handleClientDisconnected(client)
 }
 
 // SetTimeout sets the timeout by which to wait for RPC responses
@@ -155,13 +269,32 @@ func (c *RPCClient) SetTimeout(timeout time.Duration) {
 	c.timeout = timeout
 }
 
+// SetReconnectBackoff sets the delays between reconnection attempts. The first attempt is
+// made after minDelay, and the delay doubles after every failed attempt up to maxDelay
+func (c *RPCClient) SetReconnectBackoff(minDelay, maxDelay time.Duration) {
+	c.minReconnectDelay = minDelay
+	c.maxReconnectDelay = maxDelay
+}
+
 // Close closes the RPC client
 func (c *RPCClient) Close() error {
 	swapped := atomic.CompareAndSwapUint32(&c.isClosed, 0, 1)
 	if !swapped {
 		return errors.Errorf("Cannot close a client that had already been closed")
 	}
-	c.rpcRouter.router.Close()
+	defer c.handleConnectionStateChanged(ConnectionStateClosed)
+
+	c.connectionLock.Lock()
+	defer c.connectionLock.Unlock()
+
+	// A client that isn't connected was already closed when the connection was lost.
+	// Wake up the requests that wait for it to reconnect, so that they fail with ErrClientClosed
+	if atomic.LoadUint32(&c.isConnected) == 0 {
+		close(c.connectedChan)
+		return nil
+	}
+	atomic.StoreUint32(&c.isConnected, 0)
+	c.rpcRouter.close()
 	return c.GRPCClient.Close()
 }
 
@@ -171,6 +304,9 @@ func (c *RPCClient) Address() string {
 }
 
 func (c *RPCClient) route(command appmessage.MessageCommand) *routerpkg.Route {
+	c.connectionLock.RLock()
+	defer c.connectionLock.RUnlock()
+
 	return c.rpcRouter.routes[command]
 }
 
@@ -178,7 +314,30 @@ func (c *RPCClient) route(command appmessage.MessageCommand) *routerpkg.Route {
 var ErrRPC = errors.New("rpc error")
 
 func (c *RPCClient) convertRPCError(rpcError *appmessage.RPCError) error {
-	return errors.Wrap(ErrRPC, rpcError.Message)
+	return errors.WithStack(&responseError{rpcError: rpcError})
+}
+
+// responseError is returned when a response carries an RPC error. It matches ErrRPC
+// with errors.Is, and the RPC error itself, along with its code, may be retrieved with
+// errors.As into an *appmessage.RPCError
+type responseError struct {
+	rpcError *appmessage.RPCError
+}
+
+func (e *responseError) Error() string {
+	return e.rpcError.Message + ": " + ErrRPC.Error()
+}
+
+func (e *responseError) Is(target error) bool {
+	return target == ErrRPC
+}
+
+func (e *responseError) As(target interface{}) bool {
+	rpcError, ok := target.(**appmessage.RPCError)
+	if ok {
+		*rpcError = e.rpcError
+	}
+	return ok
 }
 
 // SetLogger uses a specified Logger to output package logging info
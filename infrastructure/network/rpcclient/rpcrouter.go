@@ -1,13 +1,16 @@
 package rpcclient
 
 import (
+	"sync"
+
 	"github.com/wombatlabs/coinsecd/app/appmessage"
 	routerpkg "github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/router"
 )
 
 type rpcRouter struct {
-	router *routerpkg.Router
-	routes map[appmessage.MessageCommand]*routerpkg.Route
+	router    *routerpkg.Router
+	routes    map[appmessage.MessageCommand]*routerpkg.Route
+	closeOnce sync.Once
 }
 
 func buildRPCRouter() (*rpcRouter, error) {
@@ -30,3 +33,8 @@ func buildRPCRouter() (*rpcRouter, error) {
 func (r *rpcRouter) outgoingRoute() *routerpkg.Route {
 	return r.router.OutgoingRoute()
 }
+
+// close closes the router's routes. It's safe to call more than once
+func (r *rpcRouter) close() {
+	r.closeOnce.Do(r.router.Close)
+}
@@ -0,0 +1,105 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+)
+
+func TestAddressHistoryIndex(t *testing.T) {
+	// Setup a single coinsecd instance
+	harnessParams := &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		utxoIndex:               true,
+		addressHistoryIndex:     true,
+	}
+	coinsecd, teardown := setupHarness(t, harnessParams)
+	defer teardown()
+
+	// skip the first block because it's paying to genesis script,
+	// which contains no outputs
+	mineNextBlock(t, coinsecd)
+
+	// Mine enough blocks for the coinbase of the first one to mature
+	const blockAmountToMine = 100
+	for i := 0; i < blockAmountToMine; i++ {
+		mineNextBlock(t, coinsecd)
+	}
+
+	utxosByAddressesResponse, err := coinsecd.rpcClient.GetUTXOsByAddresses([]string{miningAddress1})
+	if err != nil {
+		t.Fatalf("Failed to get UTXOs: %s", err)
+	}
+	var matureEntry *appmessage.UTXOsByAddressesEntry
+	for _, entry := range utxosByAddressesResponse.Entries {
+		if matureEntry == nil || entry.UTXOEntry.BlockDAAScore < matureEntry.UTXOEntry.BlockDAAScore {
+			matureEntry = entry
+		}
+	}
+	if matureEntry == nil {
+		t.Fatalf("Expected at least one UTXO to spend")
+	}
+
+	rpcTransaction, transactionID := buildTransactionForUTXOIndexTest(t, matureEntry)
+	_, err = coinsecd.rpcClient.SubmitTransaction(rpcTransaction, transactionID, false)
+	if err != nil {
+		t.Fatalf("Error submitting transaction: %s", err)
+	}
+
+	// Mine a block to include the transaction and another one to accept it
+	mineNextBlock(t, coinsecd)
+	mineNextBlock(t, coinsecd)
+
+	// Page through the whole history and make sure it's ordered newest first
+	var entries []*appmessage.AddressHistoryEntry
+	cursor := ""
+	for {
+		const limit = 10
+		getAddressHistoryResponse, err := coinsecd.rpcClient.GetAddressHistory(miningAddress1, cursor, limit)
+		if err != nil {
+			t.Fatalf("Error getting address history: %s", err)
+		}
+		if len(getAddressHistoryResponse.Entries) > limit {
+			t.Fatalf("Unexpected amount of entries. Want at most: %d, got: %d",
+				limit, len(getAddressHistoryResponse.Entries))
+		}
+		entries = append(entries, getAddressHistoryResponse.Entries...)
+		if getAddressHistoryResponse.NextCursor == "" {
+			break
+		}
+		cursor = getAddressHistoryResponse.NextCursor
+	}
+
+	seenTransactionIDs := make(map[string]struct{})
+	for i, entry := range entries {
+		if _, ok := seenTransactionIDs[entry.TransactionID]; ok {
+			t.Fatalf("Transaction %s appears more than once in the history", entry.TransactionID)
+		}
+		seenTransactionIDs[entry.TransactionID] = struct{}{}
+
+		if i > 0 && entry.AcceptingBlockDAAScore > entries[i-1].AcceptingBlockDAAScore {
+			t.Fatalf("Address history is not ordered newest first: DAA score %d comes after %d",
+				entry.AcceptingBlockDAAScore, entries[i-1].AcceptingBlockDAAScore)
+		}
+	}
+
+	var spendingEntry *appmessage.AddressHistoryEntry
+	for _, entry := range entries {
+		if entry.TransactionID == transactionID {
+			spendingEntry = entry
+			break
+		}
+	}
+	if spendingEntry == nil {
+		t.Fatalf("Missing transaction %s in the address history", transactionID)
+	}
+	if !spendingEntry.IsSpendingFromAddress || !spendingEntry.IsPayingToAddress {
+		t.Fatalf("Expected transaction %s to both spend from and pay to the address", transactionID)
+	}
+	if spendingEntry.AcceptingBlockHash == "" {
+		t.Fatalf("Expected transaction %s to have an accepting block", transactionID)
+	}
+}
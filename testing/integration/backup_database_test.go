@@ -0,0 +1,92 @@
+package integration
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcclient"
+	"github.com/pkg/errors"
+)
+
+func TestBackupDatabase(t *testing.T) {
+	// BackupDatabase is only served when RPC authentication is enabled
+	tokensFilePath, adminToken := writeAdminRPCAuthTokens(t)
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:    p2pAddress1,
+		rpcAddress:    rpcAddress1,
+		miningAddress: newTestMiningAddress(t),
+		utxoIndex:     true,
+		rpcAuthTokens: tokensFilePath,
+		rpcToken:      adminToken,
+	})
+
+	const blockCount = 5
+	for i := 0; i < blockCount; i++ {
+		mineNextBlock(t, harness)
+	}
+	dagInfo, err := harness.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		t.Fatalf("GetBlockDAGInfo: %+v", err)
+	}
+	utxos, err := harness.rpcClient.GetUTXOsByAddresses([]string{harness.miningAddress})
+	if err != nil {
+		t.Fatalf("GetUTXOsByAddresses: %+v", err)
+	}
+
+	backupDirectory := filepath.Join(randomDirectory(t), "backup")
+	backupResponse, err := harness.rpcClient.BackupDatabase(backupDirectory)
+	if err != nil {
+		t.Fatalf("BackupDatabase: %+v", err)
+	}
+	if backupResponse.EntryCount == 0 {
+		t.Fatalf("The backup is empty")
+	}
+	_, err = harness.rpcClient.BackupDatabase(backupDirectory)
+	if err == nil {
+		t.Fatalf("A backup into a non-empty directory unexpectedly succeeded")
+	}
+	teardown()
+
+	restoredHarness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:      p2pAddress2,
+		rpcAddress:      rpcAddress2,
+		miningAddress:   harness.miningAddress,
+		utxoIndex:       true,
+		restoreDatabase: backupDirectory,
+	})
+	defer teardown()
+
+	restoredDAGInfo, err := restoredHarness.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		t.Fatalf("GetBlockDAGInfo: %+v", err)
+	}
+	if restoredDAGInfo.BlockCount != dagInfo.BlockCount || restoredDAGInfo.VirtualDAAScore != dagInfo.VirtualDAAScore {
+		t.Fatalf("The restored node has %d blocks with virtual DAA score %d, instead of %d blocks with "+
+			"virtual DAA score %d", restoredDAGInfo.BlockCount, restoredDAGInfo.VirtualDAAScore,
+			dagInfo.BlockCount, dagInfo.VirtualDAAScore)
+	}
+	restoredUTXOs, err := restoredHarness.rpcClient.GetUTXOsByAddresses([]string{harness.miningAddress})
+	if err != nil {
+		t.Fatalf("GetUTXOsByAddresses: %+v", err)
+	}
+	if len(restoredUTXOs.Entries) != len(utxos.Entries) {
+		t.Fatalf("The restored UTXO index has %d UTXOs instead of %d", len(restoredUTXOs.Entries), len(utxos.Entries))
+	}
+
+	// The restored node keeps extending the restored DAG
+	mineNextBlock(t, restoredHarness)
+}
+
+func TestBackupDatabaseRequiresAuthentication(t *testing.T) {
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:    p2pAddress1,
+		rpcAddress:    rpcAddress1,
+		miningAddress: newTestMiningAddress(t),
+	})
+	defer teardown()
+
+	_, err := harness.rpcClient.BackupDatabase(filepath.Join(randomDirectory(t), "backup"))
+	if !errors.Is(err, rpcclient.ErrRPC) {
+		t.Fatalf("Expected BackupDatabase to be refused without RPC authentication, got: %v", err)
+	}
+}
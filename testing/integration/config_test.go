@@ -22,6 +22,11 @@ const (
 	rpcAddress4 = "127.0.0.1:12348"
 	rpcAddress5 = "127.0.0.1:12349"
 
+	rpcWebsocketAddress1 = "127.0.0.1:12355"
+
+	metricsAddress1 = "127.0.0.1:12365"
+	healthAddress1  = "127.0.0.1:12375"
+
 	miningAddress1           = "coinsecsim:qqqqnc0pxg7qw3qkc7l6sge8kfhsvvyt7mkw8uamtndqup27ftnd6c769gn66"
 	miningAddress1PrivateKey = "0d81045b0deb2af36a25403c2154c87aa82d89dd337b575bae27ce7f5de53cee"
 
@@ -39,7 +44,28 @@ func setConfig(t *testing.T, harness *appHarness, protocolVersion uint32) {
 	harness.config.AppDir = randomDirectory(t)
 	harness.config.Listeners = []string{harness.p2pAddress}
 	harness.config.RPCListeners = []string{harness.rpcAddress}
+	if harness.rpcWebsocketAddress != "" {
+		harness.config.RPCWebsocketListeners = []string{harness.rpcWebsocketAddress}
+	}
 	harness.config.UTXOIndex = harness.utxoIndex
+	harness.config.TXIndex = harness.txIndex
+	harness.config.AddressHistoryIndex = harness.addressHistoryIndex
+	harness.config.RPCAuthTokens = harness.rpcAuthTokens
+	if harness.rpcRateLimit != 0 {
+		harness.config.RPCRateLimit = harness.rpcRateLimit
+		harness.config.RPCRateBurst = harness.rpcRateBurst
+	}
+	if harness.metricsAddress != "" {
+		harness.config.MetricsListeners = []string{harness.metricsAddress}
+	}
+	if harness.healthAddress != "" {
+		harness.config.HealthListeners = []string{harness.healthAddress}
+	}
+	harness.config.RestoreDatabase = harness.restoreDatabase
+	harness.config.ImportSnapshot = harness.importSnapshot
+	if harness.dbType != "" {
+		harness.config.DBType = harness.dbType
+	}
 	harness.config.AllowSubmitBlockWhenNotSynced = true
 	if protocolVersion != 0 {
 		harness.config.ProtocolVersion = protocolVersion
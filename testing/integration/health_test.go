@@ -0,0 +1,72 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/infrastructure/health"
+)
+
+func TestHealth(t *testing.T) {
+	harnesses, teardown := setupHarnesses(t, []*harnessParams{
+		{
+			p2pAddress:    p2pAddress1,
+			rpcAddress:    rpcAddress1,
+			miningAddress: newTestMiningAddress(t),
+			utxoIndex:     true,
+			healthAddress: healthAddress1,
+		},
+		{
+			p2pAddress:    p2pAddress2,
+			rpcAddress:    rpcAddress2,
+			miningAddress: newTestMiningAddress(t),
+		},
+	})
+	defer teardown()
+	healthHarness, otherHarness := harnesses[0], harnesses[1]
+
+	getReport := func(path string) (int, *health.Report) {
+		response, err := http.Get("http://" + healthAddress1 + path)
+		if err != nil {
+			t.Fatalf("Error getting %s: %+v", path, err)
+		}
+		defer response.Body.Close()
+		report := &health.Report{}
+		err = json.NewDecoder(response.Body).Decode(report)
+		if err != nil {
+			t.Fatalf("Error parsing the report of %s: %+v", path, err)
+		}
+		return response.StatusCode, report
+	}
+
+	statusCode, report := getReport(health.LivenessPath)
+	if statusCode != http.StatusOK || !report.Healthy {
+		t.Fatalf("Unexpected liveness response %d: %+v", statusCode, report)
+	}
+	if report.Checks["database"] == nil || !report.Checks["database"].Healthy {
+		t.Fatalf("Unexpected database check: %+v", report.Checks["database"])
+	}
+
+	// The node has neither peers nor blocks other than genesis, so it isn't ready
+	statusCode, report = getReport(health.ReadinessPath)
+	if statusCode != http.StatusServiceUnavailable || report.Healthy {
+		t.Fatalf("Unexpected readiness response %d: %+v", statusCode, report)
+	}
+	if report.Checks["peers"].Healthy || report.Checks["synced"].Healthy {
+		t.Fatalf("Unexpected readiness checks: %+v", report.Checks)
+	}
+
+	connect(t, healthHarness, otherHarness)
+	mineNextBlock(t, healthHarness)
+
+	statusCode, report = getReport(health.ReadinessPath)
+	if statusCode != http.StatusOK || !report.Healthy {
+		t.Fatalf("Unexpected readiness response %d: %+v", statusCode, report)
+	}
+	for _, checkName := range []string{"synced", "peers", "virtual", "utxoindex"} {
+		if report.Checks[checkName] == nil || !report.Checks[checkName].Healthy {
+			t.Fatalf("Unexpected %s check: %+v", checkName, report.Checks[checkName])
+		}
+	}
+}
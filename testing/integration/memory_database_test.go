@@ -0,0 +1,41 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/infrastructure/config"
+)
+
+func TestMemoryDatabase(t *testing.T) {
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:    p2pAddress1,
+		rpcAddress:    rpcAddress1,
+		miningAddress: newTestMiningAddress(t),
+		utxoIndex:     true,
+		dbType:        config.DBTypeMemory,
+	})
+	defer teardown()
+
+	const blockCount = 5
+	for i := 0; i < blockCount; i++ {
+		mineNextBlock(t, harness)
+	}
+
+	dagInfo, err := harness.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		t.Fatalf("GetBlockDAGInfo: %+v", err)
+	}
+	// The genesis block is counted as well
+	if dagInfo.BlockCount != blockCount+1 {
+		t.Fatalf("Unexpected block count. Want: %d, got: %d", blockCount+1, dagInfo.BlockCount)
+	}
+
+	// The UTXO index is stored in the same database, and should have the mined coinbase outputs
+	utxos, err := harness.rpcClient.GetUTXOsByAddresses([]string{harness.miningAddress})
+	if err != nil {
+		t.Fatalf("GetUTXOsByAddresses: %+v", err)
+	}
+	if len(utxos.Entries) == 0 {
+		t.Fatalf("Expected the UTXO index to have the mined coinbase outputs")
+	}
+}
@@ -0,0 +1,66 @@
+package integration
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestMetrics(t *testing.T) {
+	harnesses, teardown := setupHarnesses(t, []*harnessParams{
+		{
+			p2pAddress:     p2pAddress1,
+			rpcAddress:     rpcAddress1,
+			miningAddress:  newTestMiningAddress(t),
+			metricsAddress: metricsAddress1,
+		},
+		{
+			p2pAddress:    p2pAddress2,
+			rpcAddress:    rpcAddress2,
+			miningAddress: newTestMiningAddress(t),
+		},
+	})
+	defer teardown()
+	metricsHarness, otherHarness := harnesses[0], harnesses[1]
+
+	connect(t, metricsHarness, otherHarness)
+	mineNextBlock(t, metricsHarness)
+	_, err := metricsHarness.rpcClient.GetInfo()
+	if err != nil {
+		t.Fatalf("GetInfo: %+v", err)
+	}
+
+	response, err := http.Get("http://" + metricsAddress1 + "/metrics")
+	if err != nil {
+		t.Fatalf("Error scraping the metrics: %+v", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("Unexpected status code %d", response.StatusCode)
+	}
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("Error reading the metrics: %+v", err)
+	}
+	exposition := string(body)
+
+	expectedLines := []string{
+		"# TYPE coinsecd_blocks gauge",
+		`coinsecd_peers{direction="inbound"} 1`,
+		`coinsecd_peers{direction="outbound"} 0`,
+		"coinsecd_ibd_running 0",
+		"coinsecd_mempool_transactions 0",
+		`coinsecd_rpc_requests_total{command="GetInfo"}`,
+		`coinsecd_rpc_request_duration_seconds_count{command="GetInfo"}`,
+		`coinsecd_p2p_received_bytes_total{command="Version"}`,
+		`coinsecd_p2p_sent_messages_total{command="Version"} 1`,
+		"coinsecd_utxo_cache_hits_total",
+		"coinsecd_leveldb_read_bytes_total",
+	}
+	for _, expectedLine := range expectedLines {
+		if !strings.Contains(exposition, expectedLine) {
+			t.Errorf("The metrics are missing %s:\n%s", expectedLine, exposition)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/wombatlabs/coinsecd/app/appmessage"
 	"github.com/wombatlabs/coinsecd/domain/consensus/model/externalapi"
 	"github.com/wombatlabs/coinsecd/domain/consensus/utils/mining"
+	"github.com/wombatlabs/coinsecd/util"
 )
 
 func mineNextBlock(t *testing.T, harness *appHarness) *externalapi.DomainBlock {
@@ -31,3 +32,13 @@ func mineNextBlock(t *testing.T, harness *appHarness) *externalapi.DomainBlock {
 
 	return block
 }
+
+// newTestMiningAddress returns an address to mine to. The mined coins are never
+// spent, so any public key does
+func newTestMiningAddress(t *testing.T) string {
+	miningAddress, err := util.NewAddressPublicKey(make([]byte, 32), util.Bech32PrefixCoinsecSim)
+	if err != nil {
+		t.Fatalf("Error creating mining address: %+v", err)
+	}
+	return miningAddress.String()
+}
@@ -0,0 +1,81 @@
+package integration
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRPCAuthentication(t *testing.T) {
+	const adminToken = "admin-token"
+	const readOnlyToken = "readonly-token"
+
+	tokensFilePath := filepath.Join(randomDirectory(t), "rpc-tokens.json")
+	err := os.WriteFile(tokensFilePath, []byte(`{"tokens": [
+		{"name": "operator", "token": "`+adminToken+`", "role": "admin"},
+		{"name": "explorer", "token": "`+readOnlyToken+`", "role": "readonly"}
+	]}`), 0600)
+	if err != nil {
+		t.Fatalf("Error writing the tokens file: %+v", err)
+	}
+
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:    p2pAddress1,
+		rpcAddress:    rpcAddress1,
+		miningAddress: newTestMiningAddress(t),
+		rpcAuthTokens: tokensFilePath,
+		rpcToken:      adminToken,
+	})
+	defer teardown()
+
+	// The harness's client authenticated as an admin, which may call everything
+	err = harness.rpcClient.AddPeer(p2pAddress2, false)
+	if err != nil {
+		t.Fatalf("AddPeer as admin: %+v", err)
+	}
+
+	readOnlyClient, err := newTestRPCClientWithAuth(rpcAddress1, readOnlyToken)
+	if err != nil {
+		t.Fatalf("Error connecting with the read-only token: %+v", err)
+	}
+	defer readOnlyClient.Close()
+
+	_, err = readOnlyClient.GetInfo()
+	if err != nil {
+		t.Fatalf("GetInfo as read-only: %+v", err)
+	}
+	err = readOnlyClient.AddPeer(p2pAddress2, false)
+	if err == nil || !strings.Contains(err.Error(), "not allowed to call AddPeer") {
+		t.Fatalf("Expected AddPeer to be rejected for the read-only token, got: %v", err)
+	}
+
+	// The rejected request doesn't affect the connection
+	_, err = readOnlyClient.GetInfo()
+	if err != nil {
+		t.Fatalf("GetInfo after a rejected request: %+v", err)
+	}
+
+	_, err = newTestRPCClientWithAuth(rpcAddress1, "wrong-token")
+	if err == nil {
+		t.Fatalf("Expected the connection with a wrong token to be rejected")
+	}
+	_, err = newTestRPCClient(rpcAddress1)
+	if err == nil {
+		t.Fatalf("Expected the connection without a token to be rejected")
+	}
+}
+
+// writeAdminRPCAuthTokens writes a tokens file with a single admin token, for tests
+// of RPC commands that are refused unless RPC authentication is enabled
+func writeAdminRPCAuthTokens(t *testing.T) (tokensFilePath string, adminToken string) {
+	adminToken = "admin-token"
+	tokensFilePath = filepath.Join(randomDirectory(t), "rpc-tokens.json")
+	err := os.WriteFile(tokensFilePath, []byte(`{"tokens": [
+		{"name": "operator", "token": "`+adminToken+`", "role": "admin"}
+	]}`), 0600)
+	if err != nil {
+		t.Fatalf("Error writing the tokens file: %+v", err)
+	}
+	return tokensFilePath, adminToken
+}
@@ -0,0 +1,76 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcclient"
+	"github.com/pkg/errors"
+)
+
+func TestRPCRateLimit(t *testing.T) {
+	// GetRPCUsage is only served when RPC authentication is enabled
+	tokensFilePath, adminToken := writeAdminRPCAuthTokens(t)
+
+	// The rate is low enough for no weight to be regained during the test
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:    p2pAddress1,
+		rpcAddress:    rpcAddress1,
+		miningAddress: newTestMiningAddress(t),
+		rpcAuthTokens: tokensFilePath,
+		rpcToken:      adminToken,
+		rpcRateLimit:  0.001,
+		rpcRateBurst:  50,
+	})
+	defer teardown()
+
+	// GetBlocks weighs 20, so only two of them fit in the burst
+	for i := 0; i < 2; i++ {
+		_, err := harness.rpcClient.GetBlocks("", false, false)
+		if err != nil {
+			t.Fatalf("GetBlocks #%d: %+v", i, err)
+		}
+	}
+	_, err := harness.rpcClient.GetBlocks("", false, false)
+	if !errors.Is(err, rpcclient.ErrRPC) {
+		t.Fatalf("Expected GetBlocks to be rejected with an RPC error, got: %v", err)
+	}
+	var rpcError *appmessage.RPCError
+	if !errors.As(err, &rpcError) {
+		t.Fatalf("Expected the error to carry an RPCError, got: %v", err)
+	}
+	if rpcError.Code != appmessage.RPCErrorCodeRateLimited || rpcError.RetryAfterMilliseconds == 0 {
+		t.Fatalf("Unexpected RPC error: %+v", rpcError)
+	}
+
+	// Cheaper requests still fit in what's left of the burst
+	usage, err := harness.rpcClient.GetRPCUsage()
+	if err != nil {
+		t.Fatalf("GetRPCUsage: %+v", err)
+	}
+	if !usage.IsRateLimitEnabled || usage.RatePerSecond != 0.001 || usage.Burst != 50 {
+		t.Fatalf("Unexpected rate limit settings: %+v", usage)
+	}
+	if len(usage.Clients) != 1 || usage.Clients[0].Client != "token:operator" {
+		t.Fatalf("Unexpected clients: %+v", usage.Clients)
+	}
+	if usage.Clients[0].RejectedRequestCount != 1 || usage.Clients[0].AvailableWeight >= 10 {
+		t.Fatalf("Unexpected usage: %+v", usage.Clients[0])
+	}
+}
+
+func TestGetRPCUsageRequiresAuthentication(t *testing.T) {
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:    p2pAddress1,
+		rpcAddress:    rpcAddress1,
+		miningAddress: newTestMiningAddress(t),
+		rpcRateLimit:  1,
+		rpcRateBurst:  50,
+	})
+	defer teardown()
+
+	_, err := harness.rpcClient.GetRPCUsage()
+	if !errors.Is(err, rpcclient.ErrRPC) {
+		t.Fatalf("Expected GetRPCUsage to be refused without RPC authentication, got: %v", err)
+	}
+}
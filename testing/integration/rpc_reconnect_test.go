@@ -0,0 +1,96 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/rpcclient"
+)
+
+func TestRPCClientReconnect(t *testing.T) {
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:    p2pAddress1,
+		rpcAddress:    rpcAddress1,
+		miningAddress: newTestMiningAddress(t),
+	})
+	defer teardown()
+
+	rpcClient, err := rpcclient.NewRPCClient(rpcAddress1)
+	if err != nil {
+		t.Fatalf("Error creating RPC client: %+v", err)
+	}
+	defer rpcClient.Close()
+	rpcClient.SetTimeout(rpcTimeout)
+	rpcClient.SetReconnectBackoff(10*time.Millisecond, 100*time.Millisecond)
+
+	connectionStateChan := make(chan rpcclient.ConnectionState, 10)
+	rpcClient.SetOnConnectionStateChangedHandler(func(state rpcclient.ConnectionState) {
+		connectionStateChan <- state
+	})
+
+	blockAddedChan := make(chan *appmessage.BlockAddedNotificationMessage, 10)
+	err = rpcClient.RegisterForBlockAddedNotifications(func(notification *appmessage.BlockAddedNotificationMessage) {
+		blockAddedChan <- notification
+	})
+	if err != nil {
+		t.Fatalf("Error from RegisterForBlockAddedNotifications: %+v", err)
+	}
+
+	// Stop the node, and make sure the client notices
+	harness.app.Stop()
+	err = harness.database.Close()
+	if err != nil {
+		t.Fatalf("Error closing database: %+v", err)
+	}
+	waitForConnectionState(t, connectionStateChan, rpcclient.ConnectionStateDisconnected)
+
+	// Requests sent while the node is down should wait for the client to reconnect
+	getInfoErrChan := make(chan error)
+	go func() {
+		_, err := rpcClient.GetInfo()
+		getInfoErrChan <- err
+	}()
+
+	// Start the node again
+	setDatabaseContext(t, harness)
+	setApp(t, harness)
+	harness.app.Start()
+	waitForConnectionState(t, connectionStateChan, rpcclient.ConnectionStateConnected)
+
+	select {
+	case err := <-getInfoErrChan:
+		if err != nil {
+			t.Fatalf("Error from GetInfo sent while disconnected: %+v", err)
+		}
+	case <-time.After(rpcTimeout):
+		t.Fatalf("Timed out waiting for GetInfo sent while disconnected")
+	}
+
+	// The block added subscription should have been renewed
+	block := mineNextBlock(t, harness)
+	select {
+	case notification := <-blockAddedChan:
+		blockHash := consensushashing.BlockHash(block).String()
+		if notification.Block.VerboseData.Hash != blockHash {
+			t.Fatalf("Unexpected block in block added notification. Want: %s, got: %s",
+				blockHash, notification.Block.VerboseData.Hash)
+		}
+	case <-time.After(rpcTimeout):
+		t.Fatalf("Timed out waiting for a block added notification after reconnecting")
+	}
+}
+
+func waitForConnectionState(t *testing.T, connectionStateChan chan rpcclient.ConnectionState,
+	expectedState rpcclient.ConnectionState) {
+
+	select {
+	case state := <-connectionStateChan:
+		if state != expectedState {
+			t.Fatalf("Unexpected connection state. Want: %s, got: %s", expectedState, state)
+		}
+	case <-time.After(rpcTimeout):
+		t.Fatalf("Timed out waiting for connection state %s", expectedState)
+	}
+}
@@ -16,7 +16,11 @@ type testRPCClient struct {
 }
 
 func newTestRPCClient(rpcAddress string) (*testRPCClient, error) {
-	rpcClient, err := rpcclient.NewRPCClient(rpcAddress)
+	return newTestRPCClientWithAuth(rpcAddress, "")
+}
+
+func newTestRPCClientWithAuth(rpcAddress string, token string) (*testRPCClient, error) {
+	rpcClient, err := rpcclient.NewRPCClientWithAuth(rpcAddress, nil, token)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,74 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/domain/consensus/utils/consensushashing"
+	"github.com/wombatlabs/coinsecd/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"golang.org/x/net/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestRPCWebsocket(t *testing.T) {
+	harness, teardown := setupHarness(t, &harnessParams{
+		p2pAddress:          p2pAddress1,
+		rpcAddress:          rpcAddress1,
+		rpcWebsocketAddress: rpcWebsocketAddress1,
+		miningAddress:       newTestMiningAddress(t),
+	})
+	defer teardown()
+
+	connection, err := websocket.Dial("ws://"+rpcWebsocketAddress1+"/", "", "http://"+rpcWebsocketAddress1+"/")
+	if err != nil {
+		t.Fatalf("Error connecting over WebSocket: %+v", err)
+	}
+	defer connection.Close()
+
+	receive := func() *protowire.CoinsecdMessage {
+		err := connection.SetReadDeadline(time.Now().Add(rpcTimeout))
+		if err != nil {
+			t.Fatalf("Error setting read deadline: %+v", err)
+		}
+		var messageJSON []byte
+		err = websocket.Message.Receive(connection, &messageJSON)
+		if err != nil {
+			t.Fatalf("Error receiving over WebSocket: %+v", err)
+		}
+		message := &protowire.CoinsecdMessage{}
+		err = protojson.Unmarshal(messageJSON, message)
+		if err != nil {
+			t.Fatalf("Error parsing %s: %+v", messageJSON, err)
+		}
+		return message
+	}
+
+	err = websocket.Message.Send(connection, `{"getInfoRequest": {}}`)
+	if err != nil {
+		t.Fatalf("Error sending GetInfo: %+v", err)
+	}
+	getInfoResponse := receive().GetGetInfoResponse()
+	if getInfoResponse == nil || getInfoResponse.Error != nil {
+		t.Fatalf("Unexpected GetInfo response: %v", getInfoResponse)
+	}
+
+	err = websocket.Message.Send(connection, `{"notifyBlockAddedRequest": {}}`)
+	if err != nil {
+		t.Fatalf("Error sending NotifyBlockAdded: %+v", err)
+	}
+	notifyBlockAddedResponse := receive().GetNotifyBlockAddedResponse()
+	if notifyBlockAddedResponse == nil || notifyBlockAddedResponse.Error != nil {
+		t.Fatalf("Unexpected NotifyBlockAdded response: %v", notifyBlockAddedResponse)
+	}
+
+	block := mineNextBlock(t, harness)
+	blockAddedNotification := receive().GetBlockAddedNotification()
+	if blockAddedNotification == nil {
+		t.Fatalf("Expected a block added notification")
+	}
+	blockHash := consensushashing.BlockHash(block).String()
+	if blockAddedNotification.Block.VerboseData.Hash != blockHash {
+		t.Fatalf("Unexpected block in block added notification. Want: %s, got: %s",
+			blockHash, blockAddedNotification.Block.VerboseData.Hash)
+	}
+}
@@ -1,16 +1,19 @@
 package integration
 
 import (
+	"math"
 	"path/filepath"
 	"testing"
 
 	"github.com/wombatlabs/coinsecd/domain/dagconfig"
 
 	"github.com/wombatlabs/coinsecd/infrastructure/db/database/ldb"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database/memdb"
 
 	"github.com/wombatlabs/coinsecd/infrastructure/db/database"
 
 	"github.com/wombatlabs/coinsecd/app"
+	"github.com/wombatlabs/coinsecd/app/dbbackup"
 	"github.com/wombatlabs/coinsecd/infrastructure/config"
 )
 
@@ -19,22 +22,59 @@ type appHarness struct {
 	rpcClient               *testRPCClient
 	p2pAddress              string
 	rpcAddress              string
+	rpcWebsocketAddress     string
 	miningAddress           string
 	miningAddressPrivateKey string
 	config                  *config.Config
 	database                database.Database
 	utxoIndex               bool
+	txIndex                 bool
+	addressHistoryIndex     bool
 	overrideDAGParams       *dagconfig.Params
+	rpcAuthTokens           string
+	rpcToken                string
+	rpcRateLimit            float64
+	rpcRateBurst            float64
+	metricsAddress          string
+	healthAddress           string
+	dbType                  string
+	restoreDatabase         string
+	importSnapshot          string
 }
 
 type harnessParams struct {
 	p2pAddress              string
 	rpcAddress              string
+	rpcWebsocketAddress     string
 	miningAddress           string
 	miningAddressPrivateKey string
 	utxoIndex               bool
+	txIndex                 bool
+	addressHistoryIndex     bool
 	overrideDAGParams       *dagconfig.Params
 	protocolVersion         uint32
+
+	// rpcAuthTokens is the path of the RPC tokens file, and rpcToken is the token
+	// that the harness's RPC client authenticates with
+	rpcAuthTokens string
+	rpcToken      string
+
+	// rpcRateLimit and rpcRateBurst set the RPC rate limit if rpcRateLimit isn't 0
+	rpcRateLimit float64
+	rpcRateBurst float64
+
+	// metricsAddress and healthAddress enable the metrics and health servers if they aren't empty
+	metricsAddress string
+	healthAddress  string
+
+	// dbType overrides the default database type if it isn't empty
+	dbType string
+
+	// restoreDatabase is the directory of a database backup to start the harness from
+	restoreDatabase string
+
+	// importSnapshot is the UTXO snapshot to bootstrap the harness from
+	importSnapshot string
 }
 
 // setupHarness creates a single appHarness with given parameters
@@ -42,10 +82,22 @@ func setupHarness(t *testing.T, params *harnessParams) (harness *appHarness, tea
 	harness = &appHarness{
 		p2pAddress:              params.p2pAddress,
 		rpcAddress:              params.rpcAddress,
+		rpcWebsocketAddress:     params.rpcWebsocketAddress,
 		miningAddress:           params.miningAddress,
 		miningAddressPrivateKey: params.miningAddressPrivateKey,
 		utxoIndex:               params.utxoIndex,
+		txIndex:                 params.txIndex,
+		addressHistoryIndex:     params.addressHistoryIndex,
 		overrideDAGParams:       params.overrideDAGParams,
+		rpcAuthTokens:           params.rpcAuthTokens,
+		rpcToken:                params.rpcToken,
+		rpcRateLimit:            params.rpcRateLimit,
+		rpcRateBurst:            params.rpcRateBurst,
+		metricsAddress:          params.metricsAddress,
+		healthAddress:           params.healthAddress,
+		dbType:                  params.dbType,
+		restoreDatabase:         params.restoreDatabase,
+		importSnapshot:          params.importSnapshot,
 	}
 
 	setConfig(t, harness, params.protocolVersion)
@@ -102,7 +154,7 @@ func standardSetup(t *testing.T) (appHarness1, appHarness2, appHarness3 *appHarn
 
 func setRPCClient(t *testing.T, harness *appHarness) {
 	var err error
-	harness.rpcClient, err = newTestRPCClient(harness.rpcAddress)
+	harness.rpcClient, err = newTestRPCClientWithAuth(harness.rpcAddress, harness.rpcToken)
 	if err != nil {
 		t.Fatalf("Error getting RPC client %+v", err)
 	}
@@ -135,6 +187,16 @@ func setDatabaseContext(t *testing.T, harness *appHarness) {
 }
 
 func openDB(cfg *config.Config) (database.Database, error) {
+	if cfg.DBType == config.DBTypeMemory {
+		return memdb.NewMemoryDB(), nil
+	}
 	dbPath := filepath.Join(cfg.AppDir, "db")
+	if cfg.RestoreDatabase != "" {
+		// The harness doesn't migrate databases, so backups of any version are restored as is
+		_, err := dbbackup.Restore(cfg.RestoreDatabase, dbPath, math.MaxInt32)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return ldb.NewLevelDB(dbPath, 8)
 }
@@ -0,0 +1,87 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/wombatlabs/coinsecd/app/appmessage"
+)
+
+func TestTXIndex(t *testing.T) {
+	// Setup a single coinsecd instance
+	harnessParams := &harnessParams{
+		p2pAddress:              p2pAddress1,
+		rpcAddress:              rpcAddress1,
+		miningAddress:           miningAddress1,
+		miningAddressPrivateKey: miningAddress1PrivateKey,
+		utxoIndex:               true,
+		txIndex:                 true,
+	}
+	coinsecd, teardown := setupHarness(t, harnessParams)
+	defer teardown()
+
+	// skip the first block because it's paying to genesis script,
+	// which contains no outputs
+	mineNextBlock(t, coinsecd)
+
+	// Mine enough blocks for the coinbase of the first one to mature
+	const blockAmountToMine = 100
+	for i := 0; i < blockAmountToMine; i++ {
+		mineNextBlock(t, coinsecd)
+	}
+
+	utxosByAddressesResponse, err := coinsecd.rpcClient.GetUTXOsByAddresses([]string{miningAddress1})
+	if err != nil {
+		t.Fatalf("Failed to get UTXOs: %s", err)
+	}
+	var matureEntry *appmessage.UTXOsByAddressesEntry
+	for _, entry := range utxosByAddressesResponse.Entries {
+		if matureEntry == nil || entry.UTXOEntry.BlockDAAScore < matureEntry.UTXOEntry.BlockDAAScore {
+			matureEntry = entry
+		}
+	}
+	if matureEntry == nil {
+		t.Fatalf("Expected at least one UTXO to spend")
+	}
+
+	rpcTransaction, transactionID := buildTransactionForUTXOIndexTest(t, matureEntry)
+	_, err = coinsecd.rpcClient.SubmitTransaction(rpcTransaction, transactionID, false)
+	if err != nil {
+		t.Fatalf("Error submitting transaction: %s", err)
+	}
+
+	// The transaction should be found in the mempool
+	getTransactionResponse, err := coinsecd.rpcClient.GetTransaction(transactionID)
+	if err != nil {
+		t.Fatalf("Error getting transaction from the mempool: %s", err)
+	}
+	if !getTransactionResponse.IsInMempool {
+		t.Fatalf("Expected transaction %s to be in the mempool", transactionID)
+	}
+
+	// Mine a block to include the transaction and another one to accept it
+	mineNextBlock(t, coinsecd)
+	mineNextBlock(t, coinsecd)
+
+	getTransactionResponse, err = coinsecd.rpcClient.GetTransaction(transactionID)
+	if err != nil {
+		t.Fatalf("Error getting transaction from the TX index: %s", err)
+	}
+	if getTransactionResponse.IsInMempool {
+		t.Fatalf("Expected transaction %s to no longer be in the mempool", transactionID)
+	}
+	if getTransactionResponse.AcceptingBlockHash == "" {
+		t.Fatalf("Expected transaction %s to be accepted", transactionID)
+	}
+	if len(getTransactionResponse.IncludingBlockHashes) != 1 {
+		t.Fatalf("Unexpected amount of including blocks. Want: 1, got: %d",
+			len(getTransactionResponse.IncludingBlockHashes))
+	}
+	if getTransactionResponse.Transaction.VerboseData.TransactionID != transactionID {
+		t.Fatalf("Unexpected transaction ID. Want: %s, got: %s",
+			transactionID, getTransactionResponse.Transaction.VerboseData.TransactionID)
+	}
+	if getTransactionResponse.Transaction.VerboseData.BlockHash != getTransactionResponse.IncludingBlockHashes[0] {
+		t.Fatalf("Unexpected block hash in the transaction verbose data. Want: %s, got: %s",
+			getTransactionResponse.IncludingBlockHashes[0], getTransactionResponse.Transaction.VerboseData.BlockHash)
+	}
+}
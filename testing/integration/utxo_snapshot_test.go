@@ -0,0 +1,126 @@
+package integration
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wombatlabs/coinsecd/app/utxosnapshot"
+	"github.com/wombatlabs/coinsecd/domain"
+	"github.com/wombatlabs/coinsecd/domain/consensus"
+	"github.com/wombatlabs/coinsecd/domain/dagconfig"
+	"github.com/wombatlabs/coinsecd/domain/miningmanager/mempool"
+	"github.com/wombatlabs/coinsecd/infrastructure/db/database/ldb"
+)
+
+func TestUTXOSnapshot(t *testing.T) {
+	overrideDAGParams := dagconfig.SimnetParams
+
+	// This makes a pruning depth of 6 blocks, so that the pruning point moves
+	// after a few blocks
+	overrideDAGParams.TargetTimePerBlock = time.Minute
+	overrideDAGParams.FinalityDuration = 2 * overrideDAGParams.TargetTimePerBlock
+	overrideDAGParams.K = 0
+	overrideDAGParams.PruningProofM = 20
+
+	miningAddress := newTestMiningAddress(t)
+	miner, teardownMiner := setupHarness(t, &harnessParams{
+		p2pAddress:        p2pAddress1,
+		rpcAddress:        rpcAddress1,
+		miningAddress:     miningAddress,
+		overrideDAGParams: &overrideDAGParams,
+	})
+	defer teardownMiner()
+	exporter, teardownExporter := setupHarness(t, &harnessParams{
+		p2pAddress:        p2pAddress2,
+		rpcAddress:        rpcAddress2,
+		miningAddress:     miningAddress,
+		overrideDAGParams: &overrideDAGParams,
+	})
+	connect(t, miner, exporter)
+
+	const blockCount = 30
+	for i := 0; i < blockCount; i++ {
+		mineNextBlock(t, miner)
+	}
+	waitForSameSelectedTip(t, miner, exporter)
+	teardownExporter()
+
+	// The snapshot is exported while the exporting node is down, as the
+	// export-utxo-snapshot command does
+	snapshotPath := filepath.Join(randomDirectory(t), "snapshot.dat")
+	summary := exportUTXOSnapshot(t, exporter, snapshotPath)
+
+	importer, teardownImporter := setupHarness(t, &harnessParams{
+		p2pAddress:        p2pAddress3,
+		rpcAddress:        rpcAddress3,
+		miningAddress:     miningAddress,
+		overrideDAGParams: &overrideDAGParams,
+		utxoIndex:         true,
+		importSnapshot:    snapshotPath,
+	})
+	defer teardownImporter()
+
+	dagInfo, err := importer.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		t.Fatalf("GetBlockDAGInfo: %+v", err)
+	}
+	if dagInfo.PruningPointHash != summary.PruningPoint.String() {
+		t.Fatalf("Expected the pruning point of the importing node to be %s, but got %s",
+			summary.PruningPoint, dagInfo.PruningPointHash)
+	}
+	utxos, err := importer.rpcClient.GetUTXOsByAddresses([]string{miningAddress})
+	if err != nil {
+		t.Fatalf("GetUTXOsByAddresses: %+v", err)
+	}
+	if len(utxos.Entries) == 0 {
+		t.Fatalf("The UTXO index of the importing node is empty")
+	}
+
+	// The importing node syncs the blocks above the pruning point from its peers
+	connect(t, miner, importer)
+	mineNextBlock(t, miner)
+	waitForSameSelectedTip(t, miner, importer)
+}
+
+func exportUTXOSnapshot(t *testing.T, harness *appHarness, path string) *utxosnapshot.Summary {
+	db, err := ldb.NewLevelDB(filepath.Join(harness.config.AppDir, "db"), 8)
+	if err != nil {
+		t.Fatalf("NewLevelDB: %+v", err)
+	}
+	defer db.Close()
+
+	consensusConfig := &consensus.Config{Params: *harness.config.ActiveNetParams}
+	domainInstance, err := domain.New(consensusConfig, mempool.DefaultConfig(&consensusConfig.Params), db)
+	if err != nil {
+		t.Fatalf("New: %+v", err)
+	}
+	summary, err := utxosnapshot.Export(domainInstance.Consensus(), harness.config.ActiveNetParams, path)
+	if err != nil {
+		t.Fatalf("Export: %+v", err)
+	}
+	return summary
+}
+
+func waitForSameSelectedTip(t *testing.T, expected, actual *appHarness) {
+	expectedSelectedTip, err := expected.rpcClient.GetSelectedTipHash()
+	if err != nil {
+		t.Fatalf("GetSelectedTipHash: %+v", err)
+	}
+
+	start := time.Now()
+	for {
+		actualSelectedTip, err := actual.rpcClient.GetSelectedTipHash()
+		if err != nil {
+			t.Fatalf("GetSelectedTipHash: %+v", err)
+		}
+		if actualSelectedTip.SelectedTipHash == expectedSelectedTip.SelectedTipHash {
+			return
+		}
+		if time.Since(start) > defaultTimeout {
+			t.Fatalf("Timeout waiting for the selected tip %s, which is %s instead",
+				expectedSelectedTip.SelectedTipHash, actualSelectedTip.SelectedTipHash)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}